@@ -17,14 +17,22 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/google/shlex"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/version"
 	"knative.dev/client/pkg/kn/config"
 	pluginpkg "knative.dev/client/pkg/kn/plugin"
 	"knative.dev/client/pkg/kn/root"
+	"knative.dev/client/pkg/printers"
 )
 
 func main() {
@@ -55,18 +63,19 @@ func run(args []string) error {
 	// Parse config & plugin flags early to read in configuration file
 	// and bind to viper. After that you can access all configuration and
 	// global options via methods on config.GlobalConfig
-	err := config.BootstrapConfig()
-	if err != nil {
-		return err
-	}
+	bootstrapErr := config.BootstrapConfig()
 
 	pluginManager := pluginpkg.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+	// An invalid signature configuration is reported when a plugin is executed, until then
+	// the manager refuses to run plugins for their manifests or completions
+	_ = pluginManager.ConfigureSignatures(config.GlobalConfig.PluginSignatures())
 
 	// Create kn root command and all sub-commands
 	rootCmd, err := root.NewRootCommand(pluginManager.HelpTemplateFuncs())
 	if err != nil {
 		return err
 	}
+	pluginManager.RegisterCompletions(rootCmd)
 
 	// temporary setting to parse all flags
 	rootCmd.FParseErrWhitelist = cobra.FParseErrWhitelist{UnknownFlags: true} // wokeignore:rule=whitelist // TODO(#1031)
@@ -75,9 +84,47 @@ func run(args []string) error {
 	if err != nil {
 		return err
 	}
+	// Replace a user-defined alias with the command line it stands for
+	if len(commands) > 0 {
+		if expansion, ok := config.GlobalConfig.Aliases()[commands[0]]; ok {
+			// Built-in commands win, so that an alias, e.g. of a project, can't break kn
+			if root.IsBuiltinCommand(rootCmd, commands[0]) {
+				fmt.Fprintf(os.Stderr, "WARNING: alias '%s' is ignored as it is shadowing built-in command '%s'\n", commands[0], commands[0])
+			} else {
+				if err := validateAlias(commands[0]); err != nil {
+					return err
+				}
+				args, err = expandAlias(args, firstArgIndex(rootCmd, args), expansion)
+				if err != nil {
+					return err
+				}
+				commands, err = stripFlags(rootCmd, args)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
 	// reset the temporary setting
 	rootCmd.FParseErrWhitelist = cobra.FParseErrWhitelist{UnknownFlags: false} // wokeignore:rule=whitelist // TODO(#1031)
 
+	// An invalid configuration file can still be inspected and repaired with 'kn config'
+	if bootstrapErr != nil && (len(commands) == 0 || commands[0] != "config") {
+		return bootstrapErr
+	}
+
+	// Forward shell completion requests for plugin commands to the plugin
+	if isCompletionRequest(args) {
+		plugin, err := pluginManager.FindPlugin(commands[1:])
+		// The plugin's own name is completed by kn, the plugin only completes what follows
+		if err == nil && plugin != nil && !completesPluginName(commands[1:], args, plugin) && validatePlugin(rootCmd, plugin) == nil {
+			if err := pluginEnvironment(rootCmd, args).Export(); err != nil {
+				return err
+			}
+			return completePlugin(os.Stdout, pluginManager, plugin, args[0], argsWithoutCommands(args[1:], plugin.CommandParts()))
+		}
+	}
+
 	// Find plugin with the commands arguments
 	plugin, err := pluginManager.FindPlugin(commands)
 	if err != nil {
@@ -105,28 +152,32 @@ func run(args []string) error {
 		if err != nil {
 			return err
 		}
+		err = verifyPluginSignature(plugin)
+		if err != nil {
+			return &runError{err: err}
+		}
+		// Export the global state resolved by kn to the plugin
+		err = pluginEnvironment(rootCmd, args).Export()
+		if err != nil {
+			return err
+		}
 		if config.GlobalConfig.ContextSharing() {
-			if pwm, ok := plugin.(pluginpkg.PluginWithManifest); ok {
-				data, _ := ctxManager.FetchContextData()
-				err := pwm.ExecuteWithContext(data, argsWithoutCommands(args, plugin.CommandParts()))
-				if err != nil {
-					return &runError{err: err}
-				}
-			}
-			return nil
+			err = ctxManager.Execute(plugin, argsWithoutCommands(args, plugin.CommandParts()))
+		} else {
+			err = plugin.Execute(argsWithoutCommands(args, plugin.CommandParts()))
 		}
-		err := plugin.Execute(argsWithoutCommands(args, plugin.CommandParts()))
 		if err != nil {
 			return &runError{err: err}
 		}
 		return nil
 	} else {
 		// Validate args for root command
-		err = validateRootCommand(rootCmd)
+		err = validateRootCommand(rootCmd, args)
 		if err != nil {
 			return err
 		}
-		// Execute kn root command, args are taken from os.Args directly
+		// Execute kn root command with the args, which might have been expanded from an alias
+		rootCmd.SetArgs(args)
 		return rootCmd.Execute()
 	}
 }
@@ -177,11 +228,191 @@ func validatePlugin(root *cobra.Command, plugin pluginpkg.Plugin) error {
 	return nil
 }
 
+// Verify the signature of the plugin according to the configured policy, printing a
+// warning for a plugin without a valid signature if the policy is 'warn'
+func verifyPluginSignature(plugin pluginpkg.Plugin) error {
+	verifier, err := pluginpkg.NewSignatureVerifier(config.GlobalConfig.PluginSignatures())
+	if err != nil {
+		return err
+	}
+	warning, err := verifier.Check(plugin)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		fmt.Fprintf(os.Stderr, "WARNING: %s\n", warning)
+	}
+	return nil
+}
+
+// pluginEnvironment resolves the global state exported to plugins. The global flags have been
+// parsed with the root command already, --namespace and --output are picked up from the args.
+// Settings which depend on kubeconfig are resolved on a best effort basis, as a plugin might
+// not need a cluster at all.
+func pluginEnvironment(rootCmd *cobra.Command, args []string) pluginpkg.Environment {
+	globalFlags := rootCmd.PersistentFlags()
+	params := &commands.KnParams{}
+	params.KubeCfgPath, _ = globalFlags.GetString("kubeconfig")
+	params.KubeContext, _ = globalFlags.GetString("context")
+	params.KubeCluster, _ = globalFlags.GetString("cluster")
+	params.KubeAsUser, _ = globalFlags.GetString("as")
+	params.KubeAsUID, _ = globalFlags.GetString("as-uid")
+	params.KubeAsGroup, _ = globalFlags.GetStringArray("as-group")
+	logHTTP, _ := globalFlags.GetBool("log-http")
+
+	pluginFlags := pflag.NewFlagSet("plugin", pflag.ContinueOnError)
+	pluginFlags.ParseErrorsWhitelist.UnknownFlags = true // wokeignore:rule=whitelist
+	pluginFlags.Usage = func() {}
+	commands.AddNamespaceFlags(pluginFlags, false)
+	pluginFlags.StringP("output", "o", "", "Output format.")
+	// Errors are ignored, the plugin reports invalid args on its own
+	_ = pluginFlags.Parse(filterHelpOptions(args))
+	namespace, _ := pluginFlags.GetString("namespace")
+	output, _ := pluginFlags.GetString("output")
+
+	env := pluginpkg.Environment{
+		Namespace:  namespace,
+		Kubeconfig: params.KubeCfgPath,
+		As:         params.KubeAsUser,
+		ConfigFile: config.GlobalConfig.ConfigFile(),
+		Output:     output,
+		LogHTTP:    logHTTP,
+		Version:    version.Version,
+	}
+	if env.Kubeconfig == "" {
+		env.Kubeconfig = os.Getenv(clientcmd.RecommendedConfigPathEnvVar)
+	}
+	if env.Kubeconfig == "" {
+		env.Kubeconfig = clientcmd.RecommendedHomeFile
+	}
+	env.Context, env.Cluster, _ = params.CurrentContext()
+	if env.Namespace == "" {
+		var err error
+		env.Namespace, err = params.CurrentNamespace()
+		if err != nil && clientcmd.IsEmptyConfig(err) {
+			// Like for the built-in commands
+			env.Namespace = "default"
+		}
+	}
+	return env
+}
+
+// Check whether the args are a shell completion request of the completion scripts
+func isCompletionRequest(args []string) bool {
+	return len(args) > 0 && (args[0] == cobra.ShellCompRequestCmd || args[0] == cobra.ShellCompNoDescRequestCmd)
+}
+
+// Check whether a completion request is about the last command part of the plugin itself,
+// like for 'kn __complete func'
+func completesPluginName(commands []string, args []string, plugin pluginpkg.Plugin) bool {
+	parts := plugin.CommandParts()
+	return len(commands) == len(parts) && args[len(args)-1] == parts[len(parts)-1]
+}
+
+// completePlugin forwards a shell completion request to the plugin and prints the completions
+// in the format expected by the completion scripts, i.e. one completion per line followed by
+// the directive
+func completePlugin(out io.Writer, manager *pluginpkg.Manager, plugin pluginpkg.Plugin, request string, args []string) error {
+	if plugin.Path() == "" {
+		// Inlined plugins are cobra commands which answer the request on their own
+		return plugin.Execute(append([]string{request}, args...))
+	}
+	completions, directive := manager.Complete(plugin, args)
+	for _, completion := range completions {
+		if request == cobra.ShellCompNoDescRequestCmd {
+			completion = strings.Split(completion, "\t")[0]
+		}
+		fmt.Fprintln(out, completion)
+	}
+	fmt.Fprintf(out, ":%d\n", directive)
+	return nil
+}
+
+// Check if the name of an alias is valid
+func validateAlias(name string) error {
+	if strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t") {
+		return fmt.Errorf("invalid alias name '%s'", name)
+	}
+	return nil
+}
+
+// aliasArgRegexp matches the placeholders of positional arguments in an alias
+var aliasArgRegexp = regexp.MustCompile(`\$(\d+)`)
+
+// firstArgIndex returns the index of the first argument which is neither a flag nor the value
+// of a flag, i.e. the first of the args stripFlags returns, or -1 if there is none. The flags
+// are parsed without setting their values.
+func firstArgIndex(rootCmd *cobra.Command, args []string) int {
+	ignoreValue := func(*pflag.Flag, string) error { return nil }
+	prefix := make([]string, 0, len(args))
+	for i, arg := range args {
+		// Help options are filtered like in stripFlags
+		if arg == "-h" || arg == "--help" {
+			continue
+		}
+		prefix = append(prefix, arg)
+		if err := rootCmd.Flags().ParseAll(prefix, ignoreValue); err == nil && rootCmd.Flags().NArg() == 1 {
+			return i
+		}
+	}
+	return -1
+}
+
+// expandAlias replaces the alias at the given index of the args with the command line it
+// expands to. The placeholders $1, $2, ... are replaced by the arguments following the alias
+// and $@ by all of them. Arguments which are not referenced by a placeholder are appended.
+func expandAlias(args []string, index int, expansion string) ([]string, error) {
+	if index < 0 || index >= len(args) {
+		return args, nil
+	}
+	name := args[index]
+	words, err := shlex.Split(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse alias '%s': %w", name, err)
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("alias '%s' is empty", name)
+	}
+
+	aliasArgs := args[index+1:]
+
+	used := make([]bool, len(aliasArgs))
+	allUsed := false
+	expanded := make([]string, 0, len(args)+len(words))
+	expanded = append(expanded, args[:index]...)
+	for _, word := range words {
+		if word == "$@" {
+			expanded = append(expanded, aliasArgs...)
+			allUsed = true
+			continue
+		}
+		for _, match := range aliasArgRegexp.FindAllStringSubmatch(word, -1) {
+			position, _ := strconv.Atoi(match[1])
+			if position == 0 || position > len(aliasArgs) {
+				return nil, fmt.Errorf("alias '%s' requires argument %s, but only %d arguments given", name, match[0], len(aliasArgs))
+			}
+			used[position-1] = true
+		}
+		expanded = append(expanded, aliasArgRegexp.ReplaceAllStringFunc(word, func(placeholder string) string {
+			position, _ := strconv.Atoi(placeholder[1:])
+			return aliasArgs[position-1]
+		}))
+	}
+	if !allUsed {
+		for i, arg := range aliasArgs {
+			if !used[i] {
+				expanded = append(expanded, arg)
+			}
+		}
+	}
+	return expanded, nil
+}
+
 // Check whether an unknown sub-command is addressed and return an error if this is the case
 // Needs to be called after the plugin has been extracted (as a plugin name can also lead to
 // an unknown sub command error otherwise)
-func validateRootCommand(cmd *cobra.Command) error {
-	foundCmd, innerArgs, err := cmd.Find(os.Args[1:])
+func validateRootCommand(cmd *cobra.Command, args []string) error {
+	foundCmd, innerArgs, err := cmd.Find(args)
 	if err == nil && foundCmd.HasSubCommands() && len(innerArgs) > 0 {
 		argsWithoutFlags, err := stripFlags(cmd, innerArgs)
 		if len(argsWithoutFlags) > 0 || err != nil {
@@ -195,7 +426,11 @@ func validateRootCommand(cmd *cobra.Command) error {
 
 // printError prints out any given error
 func printError(err error) {
-	fmt.Fprintf(os.Stderr, "Error: %s\n", cleanupErrorMessage(err.Error()))
+	label := "Error:"
+	if config.ColorEnabled(config.GlobalConfig.Color(), os.Stderr) {
+		label = printers.ColorRed.Apply(label)
+	}
+	fmt.Fprintf(os.Stderr, "%s %s\n", label, cleanupErrorMessage(err.Error()))
 	var runError *runError
 	if !errors.As(err, &runError) {
 		// Print help hint only if its not a runError occurred when executing a command
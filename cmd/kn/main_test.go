@@ -18,6 +18,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -95,6 +97,350 @@ func TestValidatePlugin(t *testing.T) {
 
 }
 
+func TestValidateAlias(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		expectedError []string
+	}{
+		{"deploy", nil},
+		{"--two", []string{"invalid alias name '--two'"}},
+		{"my alias", []string{"invalid alias name 'my alias'"}},
+	} {
+		err := validateAlias(tc.name)
+		if len(tc.expectedError) == 0 {
+			assert.NilError(t, err, tc.name)
+		} else {
+			assert.Assert(t, util.ContainsAll(err.Error(), tc.expectedError...), tc.name)
+		}
+	}
+}
+
+func TestExpandAlias(t *testing.T) {
+	for _, tc := range []struct {
+		args          []string
+		index         int
+		expansion     string
+		expected      []string
+		expectedError string
+	}{
+		{
+			[]string{"deploy", "myapp", "--image", "nginx"},
+			0, "service apply --wait --profile prod",
+			[]string{"service", "apply", "--wait", "--profile", "prod", "myapp", "--image", "nginx"},
+			"",
+		},
+		{
+			[]string{"-n", "test", "logs", "myapp"},
+			2, "service describe $1 -o yaml",
+			[]string{"-n", "test", "service", "describe", "myapp", "-o", "yaml"},
+			"",
+		},
+		{
+			[]string{"-n", "deploy", "deploy", "hello"},
+			2, "service apply --wait",
+			[]string{"-n", "deploy", "service", "apply", "--wait", "hello"},
+			"",
+		},
+		{
+			[]string{"scale", "myapp", "3", "--wait"},
+			0, "service update $1 --scale=$2",
+			[]string{"service", "update", "myapp", "--scale=3", "--wait"},
+			"",
+		},
+		{
+			[]string{"tag", "a", "b"},
+			0, "service update $@ --annotation 'team=blue green'",
+			[]string{"service", "update", "a", "b", "--annotation", "team=blue green"},
+			"",
+		},
+		{
+			[]string{"scale", "myapp"},
+			0, "service update $1 --scale=$2",
+			nil,
+			"alias 'scale' requires argument $2, but only 1 arguments given",
+		},
+		{
+			[]string{"broken"},
+			0, "service 'list",
+			nil,
+			"cannot parse alias 'broken'",
+		},
+		{
+			[]string{"empty"},
+			0, " ",
+			nil,
+			"alias 'empty' is empty",
+		},
+	} {
+		expanded, err := expandAlias(tc.args, tc.index, tc.expansion)
+		if tc.expectedError != "" {
+			assert.ErrorContains(t, err, tc.expectedError)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expanded, tc.expected)
+	}
+}
+
+func TestFirstArgIndex(t *testing.T) {
+	rootCmd, err := root.NewRootCommand(nil)
+	assert.NilError(t, err)
+	rootCmd.FParseErrWhitelist = cobra.FParseErrWhitelist{UnknownFlags: true} // wokeignore:rule=whitelist // TODO(#1031)
+	// The flags are only parsed by stripFlags in the order of run()
+	_, err = stripFlags(rootCmd, []string{})
+	assert.NilError(t, err)
+
+	for _, tc := range []struct {
+		args     []string
+		expected int
+	}{
+		{[]string{"deploy", "hello"}, 0},
+		{[]string{"--context", "deploy", "deploy", "hello"}, 2},
+		{[]string{"--context=deploy", "deploy"}, 1},
+		{[]string{"--log-http", "-h", "deploy"}, 2},
+		{[]string{"--context", "deploy"}, -1},
+		{[]string{}, -1},
+	} {
+		assert.Equal(t, firstArgIndex(rootCmd, tc.args), tc.expected, "args: %v", tc.args)
+	}
+}
+
+func TestRunWithAlias(t *testing.T) {
+	oldArgs := os.Args
+	defer (func() {
+		os.Args = oldArgs
+	})()
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NilError(t, os.WriteFile(configFile, []byte(`aliases:
+  v: version
+  vo: version --output $1
+  service: version
+`), 0600))
+
+	for _, tc := range []struct {
+		args           []string
+		expectedOut    []string
+		expectedErrOut []string
+		exitCode       int
+	}{
+		{
+			[]string{"kn", "--config", configFile, "v"},
+			[]string{"Version", "Supported APIs"},
+			[]string{""},
+			0,
+		},
+		{
+			[]string{"kn", "--config", configFile, "vo", "json"},
+			[]string{`"SupportedAPIs"`},
+			[]string{""},
+			0,
+		},
+		{
+			[]string{"kn", "--config", configFile, "--context", "v", "v"},
+			[]string{"Version", "Supported APIs"},
+			[]string{""},
+			0,
+		},
+		{
+			[]string{"kn", "--config", configFile, "vo"},
+			[]string{""},
+			[]string{"alias 'vo' requires argument $1"},
+			1,
+		},
+		{
+			[]string{"kn", "--config", configFile, "service", "--help"},
+			[]string{"Manage Knative services"},
+			[]string{"WARNING: alias 'service' is ignored as it is shadowing built-in command 'service'"},
+			0,
+		},
+		{
+			[]string{"kn", "--config", configFile, "--help"},
+			[]string{"Command Aliases:", "vo", "version --output $1"},
+			[]string{""},
+			0,
+		},
+	} {
+		capture := test.CaptureOutput(t)
+		os.Args = tc.args
+		exitCode := runWithExit(tc.args[1:])
+		out, errOut := capture.Close()
+		assert.Equal(t, exitCode, tc.exitCode)
+		assert.Assert(t, util.ContainsAll(out, tc.expectedOut...))
+		assert.Assert(t, util.ContainsAll(errOut, tc.expectedErrOut...))
+	}
+}
+
+func TestRunPluginWithContextSharing(t *testing.T) {
+	oldArgs := os.Args
+	oldPlugins := pluginpkg.InternalPlugins
+	defer (func() {
+		os.Args = oldArgs
+		pluginpkg.InternalPlugins = oldPlugins
+		pluginpkg.CtxManager = nil
+	})()
+	configDir := t.TempDir()
+	configFile := filepath.Join(configDir, "config.yaml")
+	assert.NilError(t, os.WriteFile(configFile, []byte(`features:
+  context-sharing: true
+plugins:
+  directory: `+filepath.Join(configDir, "plugins")+`
+`), 0600))
+
+	// A plugin without manifest is executed as usual
+	pluginpkg.InternalPlugins = pluginpkg.PluginList{&internalPlugin{
+		executeError: func() error {
+			fmt.Println("OK plugin out")
+			return nil
+		},
+		commandParts: []string{"foo"},
+	}}
+	os.Args = []string{"kn", "--config", configFile, "foo"}
+	capture := test.CaptureOutput(t)
+	err := run(os.Args[1:])
+	out, _ := capture.Close()
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "OK plugin out"))
+
+	// The cache of context sharing is written after the plugin has been executed
+	_, err = os.Stat(filepath.Join(configDir, "context.json"))
+	assert.NilError(t, err)
+}
+
+func TestPluginEnvironment(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{
+		TestConfigFile: "/tmp/kn/config.yaml",
+		TestDefaults: config.DefaultsConfig{
+			Contexts: map[string]config.ContextDefaults{"dev": {Namespace: "team-dev"}},
+		},
+	}
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	assert.NilError(t, os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: c1
+  cluster: {server: "https://c1"}
+- name: c2
+  cluster: {server: "https://c2"}
+contexts:
+- name: prod
+  context: {cluster: c1, user: u, namespace: prod-ns}
+- name: dev
+  context: {cluster: c2, user: u}
+current-context: prod
+users:
+- name: u
+  user: {}
+`), 0600))
+
+	for _, tc := range []struct {
+		args     []string
+		expected pluginpkg.Environment
+	}{
+		{
+			[]string{"--kubeconfig", kubeconfig, "myplugin"},
+			pluginpkg.Environment{Namespace: "prod-ns", Context: "prod", Cluster: "c1"},
+		},
+		{
+			[]string{"--kubeconfig", kubeconfig, "-n", "foo", "myplugin", "-o", "json"},
+			pluginpkg.Environment{Namespace: "foo", Context: "prod", Cluster: "c1", Output: "json"},
+		},
+		{
+			[]string{"--kubeconfig", kubeconfig, "--context", "dev", "--as", "admin", "--log-http", "myplugin", "--unknown"},
+			pluginpkg.Environment{Namespace: "team-dev", Context: "dev", Cluster: "c2", As: "admin", LogHTTP: true},
+		},
+	} {
+		rootCmd, err := root.NewRootCommand(nil)
+		assert.NilError(t, err)
+		rootCmd.FParseErrWhitelist = cobra.FParseErrWhitelist{UnknownFlags: true} // wokeignore:rule=whitelist
+		_, err = stripFlags(rootCmd, tc.args)
+		assert.NilError(t, err)
+
+		tc.expected.Kubeconfig = kubeconfig
+		tc.expected.ConfigFile = "/tmp/kn/config.yaml"
+		assert.DeepEqual(t, pluginEnvironment(rootCmd, tc.args), tc.expected)
+	}
+
+	// Settings depending on kubeconfig are left empty if it can't be loaded
+	rootCmd, err := root.NewRootCommand(nil)
+	assert.NilError(t, err)
+	args := []string{"--kubeconfig", filepath.Join(t.TempDir(), "missing"), "myplugin"}
+	_, err = stripFlags(rootCmd, args)
+	assert.NilError(t, err)
+	env := pluginEnvironment(rootCmd, args)
+	assert.Equal(t, env.Namespace, "")
+	assert.Equal(t, env.Context, "")
+}
+
+func TestVerifyPluginSignature(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	for _, tc := range []struct {
+		signatures    config.SignaturesConfig
+		expectedErr   string
+		expectedWarn  string
+		givenPlugin   pluginpkg.Plugin
+		expectedClean bool
+	}{
+		{config.SignaturesConfig{}, "", "", commandPartsOnlyPlugin{"foo"}, true},
+		{config.SignaturesConfig{Policy: config.SignaturePolicyWarn}, "", "WARNING: pluginPath is not signed", commandPartsOnlyPlugin{"foo"}, false},
+		{config.SignaturesConfig{Policy: config.SignaturePolicyEnforce}, "refusing to run plugin", "", commandPartsOnlyPlugin{"foo"}, true},
+		{config.SignaturesConfig{Policy: config.SignaturePolicyEnforce}, "", "", &internalPlugin{commandParts: []string{"foo"}}, true},
+		{config.SignaturesConfig{TrustedKeys: []config.TrustedKey{{Name: "team", Key: "broken"}}}, "invalid trusted key 'team'", "", commandPartsOnlyPlugin{"foo"}, true},
+	} {
+		config.GlobalConfig = &config.TestConfig{TestPluginSignatures: tc.signatures}
+		capture := test.CaptureOutput(t)
+		err := verifyPluginSignature(tc.givenPlugin)
+		_, errOut := capture.Close()
+		if tc.expectedErr != "" {
+			assert.ErrorContains(t, err, tc.expectedErr)
+		} else {
+			assert.NilError(t, err)
+		}
+		assert.Assert(t, util.ContainsAll(errOut, tc.expectedWarn))
+		assert.Equal(t, errOut == "", tc.expectedClean)
+	}
+}
+
+func TestCompletionRequest(t *testing.T) {
+	assert.Assert(t, isCompletionRequest([]string{"__complete", "foo", ""}))
+	assert.Assert(t, isCompletionRequest([]string{"__completeNoDesc", "foo", ""}))
+	assert.Assert(t, !isCompletionRequest([]string{"foo", "__complete"}))
+	assert.Assert(t, !isCompletionRequest([]string{}))
+
+	plugin := commandPartsOnlyPlugin{"source", "github"}
+	assert.Assert(t, completesPluginName([]string{"source", "github"}, []string{"__complete", "source", "github"}, plugin))
+	assert.Assert(t, !completesPluginName([]string{"source", "github", ""}, []string{"__complete", "source", "github", ""}, plugin))
+	assert.Assert(t, !completesPluginName([]string{"source", "github"}, []string{"__complete", "source", "github", "--n"}, plugin))
+}
+
+func TestCompletePlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugin is a bash script")
+	}
+	pluginsDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(pluginsDir, "kn-func"), []byte(`#!/bin/bash
+case "$1" in
+manifest) echo '{"flags":[{"name":"path","description":"Path to the function"}]}';;
+__complete) echo "deploy	Deploy a function"; echo ":4";;
+esac
+`), 0777)
+	assert.NilError(t, err)
+	manager := pluginpkg.NewManager(pluginsDir, false)
+	plugin, err := manager.FindPlugin([]string{"func"})
+	assert.NilError(t, err)
+
+	out := new(strings.Builder)
+	assert.NilError(t, completePlugin(out, manager, plugin, "__complete", []string{""}))
+	assert.Equal(t, out.String(), "deploy\tDeploy a function\n:4\n")
+
+	out.Reset()
+	assert.NilError(t, completePlugin(out, manager, plugin, "__completeNoDesc", []string{"--"}))
+	assert.Equal(t, out.String(), "deploy\n--path\n:4\n")
+}
+
 // Used above for wrapping the command part to check
 type commandPartsOnlyPlugin []string
 
@@ -165,7 +511,7 @@ func TestUnknownCommands(t *testing.T) {
 		rootCmd.FParseErrWhitelist = cobra.FParseErrWhitelist{UnknownFlags: true} // wokeignore:rule=whitelist // TODO(#1031)
 		os.Args = args
 		assert.NilError(t, err)
-		err = validateRootCommand(rootCmd)
+		err = validateRootCommand(rootCmd, d.givenCmdArgs)
 		if len(d.expectedError) == 0 {
 			assert.NilError(t, err)
 			continue
@@ -330,6 +676,55 @@ func TestRunWithExit(t *testing.T) {
 	}
 }
 
+func TestRunWithInvalidConfig(t *testing.T) {
+	oldArgs := os.Args
+	defer (func() {
+		os.Args = oldArgs
+	})()
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NilError(t, os.WriteFile(configFile, []byte("output:\n  color: rainbow\n"), 0600))
+
+	for _, tc := range []struct {
+		args           []string
+		expectedOut    []string
+		expectedErrOut []string
+		exitCode       int
+	}{
+		{
+			[]string{"kn", "--config", configFile, "version"},
+			[]string{""},
+			[]string{"invalid value 'rainbow' for output.color"},
+			1,
+		},
+		{
+			[]string{"kn", "--config", configFile, "config", "validate"},
+			[]string{"output.color: invalid value 'rainbow'"},
+			[]string{"has 1 error(s)"},
+			1,
+		},
+		{
+			[]string{"kn", "config", "set", "output.color", "never", "--config", configFile},
+			[]string{"Key 'output.color' set"},
+			[]string{""},
+			0,
+		},
+		{
+			[]string{"kn", "--config", configFile, "version"},
+			[]string{"Version", "Supported APIs"},
+			[]string{""},
+			0,
+		},
+	} {
+		capture := test.CaptureOutput(t)
+		os.Args = tc.args
+		exitCode := runWithExit(tc.args[1:])
+		out, errOut := capture.Close()
+		assert.Equal(t, exitCode, tc.exitCode)
+		assert.Assert(t, util.ContainsAll(out, tc.expectedOut...))
+		assert.Assert(t, util.ContainsAll(errOut, tc.expectedErrOut...))
+	}
+}
+
 func TestExtractCommandPathFromErrorMessage(t *testing.T) {
 	for _, d := range []struct{ arg0, errMsg, expected string }{
 		{"kn", "Invalid argument for 'kn service'", "kn service"},
@@ -421,6 +816,28 @@ func TestRun(t *testing.T) {
 				commandParts: []string{"foo", "bar"},
 			},
 		},
+		// Shell completion of internal plugins
+		{
+			[]string{"kn", "__complete", "fo"},
+			[]string{"foo", ":4"},
+			[]string{},
+			&internalPlugin{
+				executeError: func() error { return nil },
+				commandParts: []string{"foo"},
+			},
+		},
+		{
+			[]string{"kn", "__complete", "foo", ""},
+			[]string{"OK", "completion"},
+			[]string{},
+			&internalPlugin{
+				executeError: func() error {
+					fmt.Println("OK completion")
+					return nil
+				},
+				commandParts: []string{"foo"},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		os.Args = tc.args
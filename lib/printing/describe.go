@@ -19,7 +19,9 @@ package printing
 import (
 	"fmt"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/printers"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -43,3 +45,19 @@ func DescribeSink(dw printers.PrefixWriter, attribute, namespace string, sink *d
 		subWriter.WriteAttribute("URI", uri.String())
 	}
 }
+
+// SinkDescription returns the describe model of the given 'sink', together with
+// the URI it has been resolved to, if known
+func SinkDescription(sink *duckv1.Destination, resolved *apis.URL) *v1alpha1.SinkDescription {
+	if sink == nil {
+		return nil
+	}
+	desc := &v1alpha1.SinkDescription{Ref: sink.Ref}
+	if sink.URI != nil {
+		desc.URI = sink.URI.String()
+	}
+	if resolved != nil {
+		desc.ResolvedURI = resolved.String()
+	}
+	return desc
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2024 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceDescription is the view of a service as shown by 'kn service describe'
+type ServiceDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// URL is the public URL of the service
+	URL string `json:"url,omitempty"`
+	// ClusterURL is the address of the service within the cluster
+	ClusterURL string `json:"clusterURL,omitempty"`
+	// ServiceAccount the revisions run with
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// ImagePullSecrets used for pulling the images of the revisions
+	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+	// Revisions receiving traffic or being the latest created or ready revision,
+	// newest first. With --verbose all revisions of the service are included.
+	Revisions []RevisionDescription `json:"revisions"`
+	// Conditions of the service
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RevisionDescription is the view of a revision as shown by 'kn revision describe'
+// and as part of a service description
+type RevisionDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Service the revision belongs to
+	Service string `json:"service,omitempty"`
+	// ConfigurationGeneration is the generation of the configuration the revision was created for
+	ConfigurationGeneration int `json:"configurationGeneration,omitempty"`
+	// Image of the revision's container as given by the user
+	Image string `json:"image,omitempty"`
+	// ImageDigest the image has been resolved to
+	ImageDigest string `json:"imageDigest,omitempty"`
+	// TrafficPercent is the share of the service's traffic routed to the revision
+	TrafficPercent int64 `json:"trafficPercent,omitempty"`
+	// Tags under which the revision is addressable
+	Tags []string `json:"tags,omitempty"`
+	// LatestTraffic is true if the traffic is routed to the revision as the service's @latest revision
+	LatestTraffic bool `json:"latestTraffic,omitempty"`
+	// LatestCreated is true if this is the latest revision created for the service
+	LatestCreated bool `json:"latestCreated,omitempty"`
+	// LatestReady is true if this is the latest ready revision of the service
+	LatestReady bool `json:"latestReady,omitempty"`
+	// Scale is the desired and actual number of pods, if known
+	Scale *ScaleDescription `json:"scale,omitempty"`
+	// Conditions of the revision
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// ScaleDescription is the desired and actual number of pods of a revision
+type ScaleDescription struct {
+	Desired *int32 `json:"desired,omitempty"`
+	Actual  *int32 `json:"actual,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BrokerDescription is the view of a broker as shown by 'kn broker describe'
+type BrokerDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// URL events are sent to
+	URL string `json:"url,omitempty"`
+	// Class of the broker
+	Class string `json:"class,omitempty"`
+	// DeadLetterSink receiving the events which could not be delivered
+	DeadLetterSink *SinkDescription `json:"deadLetterSink,omitempty"`
+	// Conditions of the broker
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TriggerDescription is the view of a trigger as shown by 'kn trigger describe'
+type TriggerDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Broker the trigger subscribes to
+	Broker string `json:"broker"`
+	// Filter on the attributes of the events
+	Filter map[string]string `json:"filter,omitempty"`
+	// Sink the matching events are sent to
+	Sink SinkDescription `json:"sink"`
+	// Conditions of the trigger
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ChannelDescription is the view of a channel as shown by 'kn channel describe'
+type ChannelDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ChannelType is the kind and API version of the channel implementation
+	ChannelType metav1.TypeMeta `json:"channelType,omitempty"`
+	// URL events are sent to
+	URL string `json:"url,omitempty"`
+	// DeadLetterSink receiving the events which could not be delivered
+	DeadLetterSink *SinkDescription `json:"deadLetterSink,omitempty"`
+	// Conditions of the channel
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SubscriptionDescription is the view of a subscription as shown by 'kn subscription describe'
+type SubscriptionDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Channel the subscription subscribes to
+	Channel duckv1.KReference `json:"channel"`
+	// Subscriber the events of the channel are sent to
+	Subscriber *SinkDescription `json:"subscriber,omitempty"`
+	// Reply the responses of the subscriber are sent to
+	Reply *SinkDescription `json:"reply,omitempty"`
+	// DeadLetterSink receiving the events which could not be delivered
+	DeadLetterSink *SinkDescription `json:"deadLetterSink,omitempty"`
+	// Conditions of the subscription
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DomainMappingDescription is the view of a domain mapping as shown by 'kn domain describe'
+type DomainMappingDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// URL of the mapped domain
+	URL string `json:"url,omitempty"`
+	// Ref is the resource the domain is mapped to
+	Ref duckv1.KReference `json:"ref"`
+	// Conditions of the domain mapping
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventTypeDescription is the view of an event type as shown by 'kn eventtype describe'
+type EventTypeDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Type of the events
+	Type string `json:"type"`
+	// Source of the events
+	Source string `json:"source,omitempty"`
+	// Reference is the resource the events are available from, e.g. a broker
+	Reference *duckv1.KReference `json:"reference,omitempty"`
+	// Conditions of the event type
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SourceDescription is the view of an event source as shown by 'kn source <type> describe'
+type SourceDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// SourceKind is the kind of the source, e.g. PingSource
+	SourceKind string `json:"sourceKind"`
+	// Sink the events are sent to
+	Sink *SinkDescription `json:"sink,omitempty"`
+	// Conditions of the source
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+}
+
+// SinkDescription is a sink as given by the user and the URI it has been resolved to
+type SinkDescription struct {
+	// Ref is the resource events are sent to
+	Ref *duckv1.KReference `json:"ref,omitempty"`
+	// URI events are sent to, or the path relative to the resource's address
+	URI string `json:"uri,omitempty"`
+	// ResolvedURI is the address events are actually sent to
+	ResolvedURI string `json:"resolvedURI,omitempty"`
+}
@@ -49,6 +49,15 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Export{},
 		&ExportList{},
+		&ServiceDescription{},
+		&RevisionDescription{},
+		&BrokerDescription{},
+		&TriggerDescription{},
+		&ChannelDescription{},
+		&SubscriptionDescription{},
+		&DomainMappingDescription{},
+		&EventTypeDescription{},
+		&SourceDescription{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -60,6 +60,9 @@ type KnDynamicClient interface {
 	// ListSources returns list of available source objects
 	ListSources(ctx context.Context, types ...WithType) (*unstructured.UnstructuredList, error)
 
+	// SourcesGVRs returns the resources of the available source types
+	SourcesGVRs(ctx context.Context, types ...WithType) ([]schema.GroupVersionResource, error)
+
 	// ListSourcesUsingGVKs returns list of available source objects using given list of GVKs
 	ListSourcesUsingGVKs(context.Context, *[]schema.GroupVersionKind, ...WithType) (*unstructured.UnstructuredList, error)
 
@@ -147,12 +150,37 @@ func (c knDynamicClient) RawClient() dynamic.Interface {
 
 // ListSources returns list of available sources objects
 // Provide the list of source types as for example: WithTypes("pingsource", "apiserversource"...) to list
-// only given types of source objects
+// only given types of source objects. The list options carried by the context restrict the objects.
 func (c *knDynamicClient) ListSources(ctx context.Context, types ...WithType) (*unstructured.UnstructuredList, error) {
-	var (
-		sourceList unstructured.UnstructuredList
-		options    metav1.ListOptions
-	)
+	var sourceList unstructured.UnstructuredList
+	options := util.ToListOptions(ctx)
+	gvrs, err := c.SourcesGVRs(ctx, types...)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := c.Namespace()
+	// For each source type available, find out each source types objects
+	for _, gvr := range gvrs {
+		// list objects of source type with this GVR
+		sList, err := c.client.Resource(gvr).Namespace(namespace).List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sList.Items) > 0 {
+			sourceList.Items = append(sourceList.Items, sList.Items...)
+		}
+	}
+	if len(sourceList.Items) > 0 {
+		sourceList.SetGroupVersionKind(schema.GroupVersionKind{Group: sourceListGroup, Version: sourceListVersion, Kind: sourceListKind})
+	}
+	return &sourceList, nil
+}
+
+// SourcesGVRs returns the resources of the available source types, optionally restricted
+// to the given types like WithTypes("pingsource", "apiserversource"...)
+func (c *knDynamicClient) SourcesGVRs(ctx context.Context, types ...WithType) ([]schema.GroupVersionResource, error) {
 	sourceTypes, err := c.ListSourcesTypes(ctx)
 	if err != nil {
 		return nil, err
@@ -162,9 +190,8 @@ func (c *knDynamicClient) ListSources(ctx context.Context, types ...WithType) (*
 		return nil, errors.New("no sources found on the backend, please verify the installation")
 	}
 
-	namespace := c.Namespace()
 	filters := WithTypes(types).List()
-	// For each source type available, find out each source types objects
+	var gvrs []schema.GroupVersionResource
 	for i := range sourceTypes.Items {
 		source := &sourceTypes.Items[i]
 		// find source kind before hand to fail early
@@ -182,21 +209,9 @@ func (c *knDynamicClient) ListSources(ctx context.Context, types ...WithType) (*
 		if err != nil {
 			return nil, err
 		}
-
-		// list objects of source type with this GVR
-		sList, err := c.client.Resource(gvr).Namespace(namespace).List(ctx, options)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(sList.Items) > 0 {
-			sourceList.Items = append(sourceList.Items, sList.Items...)
-		}
+		gvrs = append(gvrs, gvr)
 	}
-	if len(sourceList.Items) > 0 {
-		sourceList.SetGroupVersionKind(schema.GroupVersionKind{Group: sourceListGroup, Version: sourceListVersion, Kind: sourceListKind})
-	}
-	return &sourceList, nil
+	return gvrs, nil
 }
 
 // ListSourcesUsingGVKs returns list of available source objects using given list of GVKs
@@ -127,6 +127,17 @@ func (c *MockKnDynamicClient) ListSourcesUsingGVKs(ctx context.Context, gvks *[]
 	return call.Result[0].(*unstructured.UnstructuredList), mock.ErrorOrNil(call.Result[1])
 }
 
+// SourcesGVRs returns the resources of the available source types
+func (dr *ClientRecorder) SourcesGVRs(types interface{}, gvrs []schema.GroupVersionResource, err error) {
+	dr.r.Add("SourcesGVRs", []interface{}{types}, []interface{}{gvrs, err})
+}
+
+// SourcesGVRs returns the resources of the available source types
+func (c *MockKnDynamicClient) SourcesGVRs(ctx context.Context, types ...WithType) ([]schema.GroupVersionResource, error) {
+	call := c.recorder.r.VerifyCall("SourcesGVRs")
+	return call.Result[0].([]schema.GroupVersionResource), mock.ErrorOrNil(call.Result[1])
+}
+
 // Validate validates whether every recorded action has been called
 func (dr *ClientRecorder) Validate() {
 	dr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
@@ -37,6 +37,7 @@ func TestMockKnDynamicClient(t *testing.T) {
 	recorder.RawClient(&fake.FakeDynamicClient{})
 	recorder.ListSourcesUsingGVKs(mock.Any(), mock.Any(), nil, nil)
 	recorder.ListChannelsUsingGVKs(mock.Any(), mock.Any(), nil, nil)
+	recorder.SourcesGVRs(mock.Any(), nil, nil)
 
 	ctx := context.Background()
 	client.ListCRDs(ctx, metav1.ListOptions{})
@@ -46,6 +47,7 @@ func TestMockKnDynamicClient(t *testing.T) {
 	client.RawClient()
 	client.ListSourcesUsingGVKs(ctx, &[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
 	client.ListChannelsUsingGVKs(ctx, &[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
+	client.SourcesGVRs(ctx, WithTypeFilter("blub"))
 
 	// Validate
 	recorder.Validate()
@@ -134,6 +134,20 @@ func TestListSources(t *testing.T) {
 	})
 }
 
+func TestSourcesGVRs(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1beta2", "PingSource"),
+		newSourceCRDObjWithSpec("apiserversources", "sources.knative.dev", "v1", "ApiServerSource"),
+	)
+	gvrs, err := client.SourcesGVRs(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, len(gvrs), 2)
+
+	gvrs, err = client.SourcesGVRs(context.Background(), WithTypeFilter("pingsource"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, gvrs, []schema.GroupVersionResource{{Group: "sources.knative.dev", Version: "v1beta2", Resource: "pingsources"}})
+}
+
 func TestListSourcesUsingGVKs(t *testing.T) {
 	t.Run("No GVKs given", func(t *testing.T) {
 		client := createFakeKnDynamicClient(testNamespace)
@@ -376,3 +390,19 @@ func TestListChannelsUsingGVKs(t *testing.T) {
 	})
 
 }
+
+func TestListSourcesWithListOptions(t *testing.T) {
+	labeled := newSourceUnstructuredObj("p1", "sources.knative.dev/v1beta2", "PingSource")
+	labeled.SetLabels(map[string]string{"team": "a"})
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1beta2", "PingSource"),
+		newSourceCRDObjWithSpec("apiserversources", "sources.knative.dev", "v1", "ApiServerSource"),
+		labeled,
+		newSourceUnstructuredObj("a1", "sources.knative.dev/v1", "ApiServerSource"),
+	)
+	ctx := util.WithListOptions(context.Background(), util.WithSelectors("team=a", ""))
+	sources, err := client.ListSources(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(sources.Items), 1)
+	assert.Equal(t, sources.Items[0].GetName(), "p1")
+}
@@ -88,3 +88,13 @@ func IsForbiddenError(err error) bool {
 	}
 	return errAPIStatus.Status().Code == int32(http.StatusForbidden)
 }
+
+// IsAPINotAvailableError returns true if given error indicates that the API of the requested
+// resources is not installed in the cluster, e.g. when Knative Eventing is not installed
+func IsAPINotAvailableError(err error) bool {
+	var knErr *KNError
+	if errors.As(err, &knErr) {
+		return knErr.Status != nil && knErr.Status.Status().Code == http.StatusNotFound
+	}
+	return api_errors.IsNotFound(err)
+}
@@ -176,6 +176,16 @@ func TestIsForbiddenError(t *testing.T) {
 	}
 }
 
+func TestIsAPINotAvailableError(t *testing.T) {
+	notFound := api_errors.NewNotFound(schema.GroupResource{Group: "eventing.knative.dev", Resource: "brokers"}, "")
+	missingCRD := api_errors.NewGenericServerResponse(404, "LIST", schema.GroupResource{Group: "eventing.knative.dev", Resource: "brokers"}, "", "unknown", 0, true)
+	assert.Assert(t, IsAPINotAvailableError(notFound))
+	assert.Assert(t, IsAPINotAvailableError(GetError(missingCRD)))
+	assert.Assert(t, !IsAPINotAvailableError(GetError(api_errors.NewForbidden(schema.GroupResource{Resource: "brokers"}, "", nil))))
+	assert.Assert(t, !IsAPINotAvailableError(NewInvalidCRD("eventing.knative.dev")))
+	assert.Assert(t, !IsAPINotAvailableError(errors.New("panic")))
+}
+
 func TestNilError(t *testing.T) {
 	assert.NilError(t, GetError(nil), nil)
 }
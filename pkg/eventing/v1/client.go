@@ -118,7 +118,7 @@ func (c *knEventingClient) GetTrigger(ctx context.Context, name string) (*eventi
 }
 
 func (c *knEventingClient) ListTriggers(ctx context.Context) (*eventingv1.TriggerList, error) {
-	triggerList, err := c.client.Triggers(c.namespace).List(ctx, apis_v1.ListOptions{})
+	triggerList, err := c.client.Triggers(c.namespace).List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, kn_errors.GetError(err)
 	}
@@ -262,6 +262,12 @@ func (b *TriggerBuilder) Filters(filters map[string]string) *TriggerBuilder {
 	return b
 }
 
+// Delivery sets the delivery settings of the trigger
+func (b *TriggerBuilder) Delivery(delivery *v1.DeliverySpec) *TriggerBuilder {
+	b.trigger.Spec.Delivery = delivery
+	return b
+}
+
 // Build to return an instance of trigger object
 func (b *TriggerBuilder) Build() *eventingv1.Trigger {
 	return b.trigger
@@ -331,7 +337,7 @@ func (c *knEventingClient) deleteBroker(ctx context.Context, name string, propag
 
 // ListBrokers is used to retrieve the list of broker instances
 func (c *knEventingClient) ListBrokers(ctx context.Context) (*eventingv1.BrokerList, error) {
-	brokerList, err := c.client.Brokers(c.namespace).List(ctx, apis_v1.ListOptions{})
+	brokerList, err := c.client.Brokers(c.namespace).List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, kn_errors.GetError(err)
 	}
@@ -322,6 +322,13 @@ func TestTriggerBuilder(t *testing.T) {
 		assert.DeepEqual(t, make(map[string]string), b.Build().ObjectMeta.Annotations)
 
 	})
+
+	t.Run("set delivery", func(t *testing.T) {
+		retry := int32(3)
+		delivery := &v1.DeliverySpec{Retry: &retry}
+		b := NewTriggerBuilder("delivery-trigger").Delivery(delivery)
+		assert.DeepEqual(t, delivery, b.Build().Spec.Delivery)
+	})
 }
 
 func TestWithGvk(t *testing.T) {
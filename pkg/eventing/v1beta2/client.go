@@ -67,7 +67,7 @@ func (c *knEventingV1Beta1Client) Namespace() string {
 }
 
 func (c *knEventingV1Beta1Client) ListEventtypes(ctx context.Context) (*eventingv1beta2.EventTypeList, error) {
-	eventTypeList, err := c.client.EventTypes(c.namespace).List(ctx, apis_v1.ListOptions{})
+	eventTypeList, err := c.client.EventTypes(c.namespace).List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, kn_errors.GetError(err)
 	}
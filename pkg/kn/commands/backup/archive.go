@@ -0,0 +1,216 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+)
+
+const (
+	manifestFile       = "backup.yaml"
+	manifestAPIVersion = "client.knative.dev/v1alpha1"
+	manifestKind       = "Backup"
+)
+
+// manifest describes a backup archive
+type manifest struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Namespace the resources have been backed up from
+	Namespace string `json:"namespace"`
+	// Created is the time the backup has been created
+	Created time.Time `json:"created"`
+}
+
+// content of a backup archive, with a list of resources for each kind
+type content struct {
+	manifest manifest
+
+	services       clientv1alpha1.ExportList
+	domainMappings servingv1beta1.DomainMappingList
+	brokers        eventingv1.BrokerList
+	channels       messagingv1.ChannelList
+	subscriptions  messagingv1.SubscriptionList
+	triggers       eventingv1.TriggerList
+	sources        unstructured.UnstructuredList
+	eventTypes     eventingv1beta2.EventTypeList
+}
+
+// archiveEntry is a file of a backup archive containing the list of resources of one kind
+type archiveEntry struct {
+	file string
+	list interface{}
+}
+
+// entries returns the files of the archive in the order the resources are restored,
+// so that resources are created after the resources they depend on
+func (c *content) entries() []archiveEntry {
+	return []archiveEntry{
+		{"services.yaml", &c.services},
+		{"domainmappings.yaml", &c.domainMappings},
+		{"brokers.yaml", &c.brokers},
+		{"channels.yaml", &c.channels},
+		{"subscriptions.yaml", &c.subscriptions},
+		{"triggers.yaml", &c.triggers},
+		{"sources.yaml", &c.sources},
+		{"eventtypes.yaml", &c.eventTypes},
+	}
+}
+
+// summary returns the number of resources of each kind in the archive, e.g. "2 services, 1 broker"
+func (c *content) summary() string {
+	var parts []string
+	for _, kind := range []struct {
+		singular, plural string
+		count            int
+	}{
+		{"service", "services", len(c.services.Items)},
+		{"domain mapping", "domain mappings", len(c.domainMappings.Items)},
+		{"broker", "brokers", len(c.brokers.Items)},
+		{"channel", "channels", len(c.channels.Items)},
+		{"subscription", "subscriptions", len(c.subscriptions.Items)},
+		{"trigger", "triggers", len(c.triggers.Items)},
+		{"source", "sources", len(c.sources.Items)},
+		{"event type", "event types", len(c.eventTypes.Items)},
+	} {
+		switch kind.count {
+		case 0:
+		case 1:
+			parts = append(parts, "1 "+kind.singular)
+		default:
+			parts = append(parts, fmt.Sprintf("%d %s", kind.count, kind.plural))
+		}
+	}
+	if len(parts) == 0 {
+		return "no resources"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// writeArchive writes the content as gzip compressed tar archive
+func writeArchive(out io.Writer, c *content) error {
+	gzipWriter := gzip.NewWriter(out)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	c.manifest.APIVersion = manifestAPIVersion
+	c.manifest.Kind = manifestKind
+	// Needed for reading the list, also if it's empty
+	c.sources.SetAPIVersion("v1")
+	c.sources.SetKind("List")
+	entries := append([]archiveEntry{{manifestFile, &c.manifest}}, c.entries()...)
+	for _, entry := range entries {
+		data, err := yaml.Marshal(entry.list)
+		if err != nil {
+			return err
+		}
+		header := &tar.Header{
+			Name:    entry.file,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: c.manifest.Created,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// writeArchiveFile writes the content to a backup archive file. The archive is written to a
+// temporary file next to it first, so that an existing archive is only replaced by a complete one.
+func writeArchiveFile(filename string, c *content) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if err := writeArchive(tmpFile, c); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), filename)
+}
+
+// readArchive reads a backup archive written by writeArchive
+func readArchive(filename string) (*content, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read backup archive '%s': %w", filename, err)
+	}
+	files := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read backup archive '%s': %w", filename, err)
+		}
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read backup archive '%s': %w", filename, err)
+		}
+		files[header.Name] = data
+	}
+
+	c := &content{}
+	entries := append([]archiveEntry{{manifestFile, &c.manifest}}, c.entries()...)
+	for _, entry := range entries {
+		data, ok := files[entry.file]
+		if !ok {
+			continue
+		}
+		if err := yaml.Unmarshal(data, entry.list); err != nil {
+			return nil, fmt.Errorf("cannot read '%s' of backup archive '%s': %w", entry.file, filename, err)
+		}
+	}
+	if c.manifest.Kind != manifestKind {
+		return nil, fmt.Errorf("'%s' is not an archive created by 'kn backup create'", filename)
+	}
+	return c, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	clienteventingv1beta2 "knative.dev/client/pkg/eventing/v1beta2"
+	"knative.dev/client/pkg/kn/commands"
+	clientmessagingv1 "knative.dev/client/pkg/messaging/v1"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	clientservingv1beta1 "knative.dev/client/pkg/serving/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+// IgnoredAnnotations defines the annotation keys which should be removed from
+// domain mappings and eventing resources before backing them up. Services and
+// revisions are stripped as done by 'kn service export'.
+var IgnoredAnnotations = []string{
+	"serving.knative.dev/creator",
+	"serving.knative.dev/lastModifier",
+	"eventing.knative.dev/creator",
+	"eventing.knative.dev/lastModifier",
+	"messaging.knative.dev/creator",
+	"messaging.knative.dev/lastModifier",
+	"sources.knative.dev/creator",
+	"sources.knative.dev/lastModifier",
+	"kubectl.kubernetes.io/last-applied-configuration",
+}
+
+// NewBackupCommand to back up and restore the Knative resources of a namespace
+func NewBackupCommand(p *commands.KnParams) *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:   "backup COMMAND",
+		Short: "Back up and restore the Knative resources of a namespace",
+	}
+	backupCmd.AddCommand(NewBackupCreateCommand(p))
+	backupCmd.AddCommand(NewBackupRestoreCommand(p))
+	return backupCmd
+}
+
+// clients for all kinds of resources included in a backup
+type clients struct {
+	serving    clientservingv1.KnServingClient
+	domains    clientservingv1beta1.KnServingClient
+	eventing   clienteventingv1.KnEventingClient
+	messaging  clientmessagingv1.KnMessagingClient
+	eventTypes clienteventingv1beta2.KnEventingV1Beta2Client
+	dynamic    clientdynamic.KnDynamicClient
+}
+
+func newClients(p *commands.KnParams, namespace string) (*clients, error) {
+	var (
+		c   clients
+		err error
+	)
+	if c.serving, err = p.NewServingClient(namespace); err != nil {
+		return nil, err
+	}
+	if c.domains, err = p.NewServingV1beta1Client(namespace); err != nil {
+		return nil, err
+	}
+	if c.eventing, err = p.NewEventingClient(namespace); err != nil {
+		return nil, err
+	}
+	if c.messaging, err = p.NewMessagingClient(namespace); err != nil {
+		return nil, err
+	}
+	if c.eventTypes, err = p.NewEventingV1beta2Client(namespace); err != nil {
+		return nil, err
+	}
+	if c.dynamic, err = p.NewDynamicClient(namespace); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// backupObjectMeta returns the metadata of a resource as included in a backup, without
+// the namespace, the fields populated by the server and the ignored annotations
+func backupObjectMeta(m metav1.ObjectMeta) metav1.ObjectMeta {
+	return util.CopyObjectMeta(m, IgnoredAnnotations...)
+}
+
+// isOwned returns true if the resource is managed by a controller, which recreates
+// it together with its owner. These resources are not included in a backup.
+func isOwned(m metav1.Object) bool {
+	return metav1.GetControllerOf(m) != nil
+}
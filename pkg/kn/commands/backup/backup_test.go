@@ -0,0 +1,331 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	eventingfake "knative.dev/eventing/pkg/client/clientset/versioned/fake"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+	servingfake "knative.dev/serving/pkg/client/clientset/versioned/fake"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	clienteventingv1beta2 "knative.dev/client/pkg/eventing/v1beta2"
+	"knative.dev/client/pkg/kn/commands"
+	clientmessagingv1 "knative.dev/client/pkg/messaging/v1"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	clientservingv1beta1 "knative.dev/client/pkg/serving/v1beta1"
+)
+
+// testCluster holds fake clients for all kinds of resources included in a backup
+type testCluster struct {
+	serving  *servingfake.Clientset
+	eventing *eventingfake.Clientset
+	dynamic  clientdynamic.KnDynamicClient
+}
+
+func newTestCluster(servingObjects []runtime.Object, eventingObjects []runtime.Object, dynamicObjects ...runtime.Object) *testCluster {
+	cluster := &testCluster{
+		serving:  servingfake.NewSimpleClientset(servingObjects...),
+		eventing: eventingfake.NewSimpleClientset(eventingObjects...),
+		dynamic:  dynamicfake.CreateFakeKnDynamicClient("", dynamicObjects...),
+	}
+	// The configuration of a service is created by the controller
+	cluster.serving.PrependReactor("create", "services", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		svc := action.(clienttesting.CreateAction).GetObject().(*servingv1.Service)
+		conf := &servingv1.Configuration{ObjectMeta: metav1.ObjectMeta{Name: svc.Name, Namespace: action.GetNamespace()}}
+		return false, nil, cluster.serving.Tracker().Add(conf)
+	})
+	return cluster
+}
+
+func (c *testCluster) params() *commands.KnParams {
+	return &commands.KnParams{
+		NewServingClient: func(namespace string) (clientservingv1.KnServingClient, error) {
+			return clientservingv1.NewKnServingClient(c.serving.ServingV1(), namespace), nil
+		},
+		NewServingV1beta1Client: func(namespace string) (clientservingv1beta1.KnServingClient, error) {
+			return clientservingv1beta1.NewKnServingClient(c.serving.ServingV1beta1(), namespace), nil
+		},
+		NewEventingClient: func(namespace string) (clienteventingv1.KnEventingClient, error) {
+			return clienteventingv1.NewKnEventingClient(c.eventing.EventingV1(), namespace), nil
+		},
+		NewMessagingClient: func(namespace string) (clientmessagingv1.KnMessagingClient, error) {
+			return clientmessagingv1.NewKnMessagingClient(c.eventing.MessagingV1(), namespace), nil
+		},
+		NewEventingV1beta2Client: func(namespace string) (clienteventingv1beta2.KnEventingV1Beta2Client, error) {
+			return clienteventingv1beta2.NewKnEventingV1Beta2Client(c.eventing.EventingV1beta2(), namespace), nil
+		},
+		NewDynamicClient: func(namespace string) (clientdynamic.KnDynamicClient, error) {
+			return clientdynamic.NewKnDynamicClient(c.dynamic.RawClient(), namespace), nil
+		},
+	}
+}
+
+func (c *testCluster) execute(args ...string) (string, error) {
+	cmd := NewBackupCommand(c.params())
+	output := new(bytes.Buffer)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func TestReplaceNamespace(t *testing.T) {
+	spec := map[string]interface{}{
+		"sink": map[string]interface{}{
+			"ref": map[string]interface{}{"name": "foo", "namespace": "prod"},
+		},
+		"subjects": []interface{}{
+			map[string]interface{}{"namespace": "prod"},
+			map[string]interface{}{"namespace": "other"},
+		},
+		"name": "prod",
+	}
+	replaceNamespace(spec, "prod", "staging")
+	assert.DeepEqual(t, spec, map[string]interface{}{
+		"sink": map[string]interface{}{
+			"ref": map[string]interface{}{"name": "foo", "namespace": "staging"},
+		},
+		"subjects": []interface{}{
+			map[string]interface{}{"namespace": "staging"},
+			map[string]interface{}{"namespace": "other"},
+		},
+		"name": "prod",
+	})
+}
+
+func TestBackupObjectMeta(t *testing.T) {
+	original := metav1.ObjectMeta{
+		Name:            "foo",
+		Namespace:       "prod",
+		UID:             "uid",
+		ResourceVersion: "42",
+		Generation:      3,
+		Labels:          map[string]string{"app": "foo"},
+		Annotations: map[string]string{
+			"eventing.knative.dev/creator":                     "admin",
+			"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			"eventing.knative.dev/broker.class":                "MTChannelBasedBroker",
+		},
+	}
+	meta := backupObjectMeta(original)
+	assert.DeepEqual(t, meta, metav1.ObjectMeta{
+		Name:        "foo",
+		Labels:      map[string]string{"app": "foo"},
+		Annotations: map[string]string{"eventing.knative.dev/broker.class": "MTChannelBasedBroker"},
+	})
+	// The metadata of the backed up resource is not modified
+	assert.Equal(t, len(original.Annotations), 3)
+}
+
+func sinkToService(name, namespace string) duckv1.Destination {
+	return duckv1.Destination{Ref: &duckv1.KReference{APIVersion: "serving.knative.dev/v1", Kind: "Service", Name: name, Namespace: namespace}}
+}
+
+func newService(name, namespace string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   namespace,
+		UID:         "service-uid",
+		Annotations: map[string]string{"serving.knative.dev/creator": "admin"},
+	}}
+	service.Spec.Template.Name = name + "-00002"
+	service.Spec.Template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v2"}}
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: name + "-00001", Percent: ptr.Int64(50)},
+		{RevisionName: name + "-00002", Percent: ptr.Int64(50)},
+	}
+	return service
+}
+
+func newRevision(service, namespace string, generation string) *servingv1.Revision {
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{
+		Name:      service + "-0000" + generation,
+		Namespace: namespace,
+		Labels: map[string]string{
+			serving.ServiceLabelKey:                 service,
+			serving.ConfigurationGenerationLabelKey: generation,
+		},
+	}}
+	revision.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v" + generation}}
+	return revision
+}
+
+func newSourceCRD(kind, plural string) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]interface{}{
+			"name":   plural + ".sources.knative.dev",
+			"labels": map[string]interface{}{"duck.knative.dev/source": "true"},
+		},
+		"spec": map[string]interface{}{
+			"group":   "sources.knative.dev",
+			"version": "v1",
+			"names":   map[string]interface{}{"kind": kind, "plural": plural},
+		},
+	}}
+	return crd
+}
+
+func newPingSource(name, namespace, sink string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "sources.knative.dev/v1",
+		"kind":       "PingSource",
+		"metadata": map[string]interface{}{
+			"name":            name,
+			"namespace":       namespace,
+			"resourceVersion": "7",
+		},
+		"spec": map[string]interface{}{
+			"schedule": "* * * * *",
+			"sink": map[string]interface{}{
+				"ref": map[string]interface{}{"apiVersion": "serving.knative.dev/v1", "kind": "Service", "name": sink, "namespace": namespace},
+			},
+		},
+		"status": map[string]interface{}{"sinkUri": "http://foo.prod.svc.cluster.local"},
+	}}
+}
+
+// newProdCluster returns a cluster with resources of all kinds in namespace 'prod'
+func newProdCluster() *testCluster {
+	svc := newService("foo", "prod")
+
+	domainMapping := &servingv1beta1.DomainMapping{ObjectMeta: metav1.ObjectMeta{Name: "foo.example.com", Namespace: "prod"}}
+	domainMapping.Spec.Ref = duckv1.KReference{APIVersion: "serving.knative.dev/v1", Kind: "Service", Name: "foo", Namespace: "prod"}
+
+	dls := sinkToService("foo", "prod")
+	broker := &eventingv1.Broker{ObjectMeta: metav1.ObjectMeta{
+		Name:        "default",
+		Namespace:   "prod",
+		Annotations: map[string]string{"eventing.knative.dev/broker.class": "MTChannelBasedBroker", "eventing.knative.dev/creator": "admin"},
+	}}
+	broker.Spec.Delivery = &eventingduckv1.DeliverySpec{DeadLetterSink: &dls}
+
+	trigger := &eventingv1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "t1", Namespace: "prod"}}
+	trigger.Spec.Broker = "default"
+	trigger.Spec.Subscriber = sinkToService("foo", "prod")
+
+	channel := &messagingv1.Channel{ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "prod"}}
+	channel.Spec.Subscribers = []eventingduckv1.SubscriberSpec{{UID: "subscription-uid"}}
+
+	subscription := &messagingv1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "prod"}}
+	subscription.Spec.Channel = duckv1.KReference{APIVersion: "messaging.knative.dev/v1", Kind: "Channel", Name: "c1"}
+	subscriber := sinkToService("foo", "prod")
+	subscription.Spec.Subscriber = &subscriber
+
+	eventType := &eventingv1beta2.EventType{ObjectMeta: metav1.ObjectMeta{Name: "et1", Namespace: "prod"}}
+	eventType.Spec.Type = "dev.knative.foo"
+	eventType.Spec.Reference = &duckv1.KReference{APIVersion: "eventing.knative.dev/v1", Kind: "Broker", Name: "default", Namespace: "prod"}
+	// Registered by the broker, which creates it again
+	ownedEventType := &eventingv1beta2.EventType{ObjectMeta: metav1.ObjectMeta{
+		Name:            "et2",
+		Namespace:       "prod",
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "eventing.knative.dev/v1", Kind: "Broker", Name: "default", Controller: ptr.Bool(true)}},
+	}}
+
+	return newTestCluster(
+		[]runtime.Object{svc, newRevision("foo", "prod", "1"), newRevision("foo", "prod", "2"), domainMapping},
+		[]runtime.Object{broker, trigger, channel, subscription, eventType, ownedEventType},
+		newSourceCRD("PingSource", "pingsources"), newPingSource("ping", "prod", "foo"),
+	)
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	archive := t.TempDir() + "/prod.tar.gz"
+	cluster := newProdCluster()
+
+	output, err := cluster.execute("create", "-n", "prod", "-o", archive)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(output, "Backed up 1 service, 1 domain mapping, 1 broker, 1 channel, 1 subscription, 1 trigger, 1 source, 1 event type from namespace 'prod'"), output)
+
+	output, err = cluster.execute("restore", archive, "-n", "staging")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(output, "Restored 8 resources from '"+archive+"' into namespace 'staging', skipped 0 existing resources."), output)
+	// Restored in the order of their dependencies
+	assert.Assert(t, strings.Index(output, "service 'foo'") < strings.Index(output, "broker 'default'"))
+	assert.Assert(t, strings.Index(output, "channel 'c1'") < strings.Index(output, "subscription 's1'"))
+	assert.Assert(t, strings.Index(output, "broker 'default'") < strings.Index(output, "trigger 't1'"))
+
+	ctx := context.Background()
+	svc, err := cluster.serving.ServingV1().Services("staging").Get(ctx, "foo", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, svc.Spec.Template.Name, "foo-00002")
+	assert.Equal(t, len(svc.Spec.Traffic), 2)
+	assert.Assert(t, svc.Annotations["serving.knative.dev/creator"] == "")
+	revision, err := cluster.serving.ServingV1().Revisions("staging").Get(ctx, "foo-00001", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, revision.OwnerReferences[0].Kind, "Configuration")
+
+	dm, err := cluster.serving.ServingV1beta1().DomainMappings("staging").Get(ctx, "foo.example.com", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, dm.Spec.Ref.Namespace, "staging")
+
+	broker, err := cluster.eventing.EventingV1().Brokers("staging").Get(ctx, "default", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, broker.Spec.Delivery.DeadLetterSink.Ref.Namespace, "staging")
+	assert.DeepEqual(t, broker.Annotations, map[string]string{"eventing.knative.dev/broker.class": "MTChannelBasedBroker"})
+
+	trigger, err := cluster.eventing.EventingV1().Triggers("staging").Get(ctx, "t1", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trigger.Spec.Subscriber.Ref.Namespace, "staging")
+
+	channel, err := cluster.eventing.MessagingV1().Channels("staging").Get(ctx, "c1", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(channel.Spec.Subscribers), 0)
+
+	_, err = cluster.eventing.MessagingV1().Subscriptions("staging").Get(ctx, "s1", metav1.GetOptions{})
+	assert.NilError(t, err)
+
+	_, err = cluster.eventing.EventingV1beta2().EventTypes("staging").Get(ctx, "et1", metav1.GetOptions{})
+	assert.NilError(t, err)
+	_, err = cluster.eventing.EventingV1beta2().EventTypes("staging").Get(ctx, "et2", metav1.GetOptions{})
+	assert.ErrorContains(t, err, "not found")
+
+	sources, err := clientdynamic.NewKnDynamicClient(cluster.dynamic.RawClient(), "staging").ListSources(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(sources.Items), 1)
+	sinkNamespace, _, _ := unstructured.NestedString(sources.Items[0].Object, "spec", "sink", "ref", "namespace")
+	assert.Equal(t, sinkNamespace, "staging")
+	_, found, _ := unstructured.NestedMap(sources.Items[0].Object, "status")
+	assert.Assert(t, !found)
+
+	// Restoring again skips the existing resources
+	output, err = cluster.execute("restore", archive, "-n", "staging")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(output, "Skipping trigger 't1', it already exists in namespace 'staging'."), output)
+	assert.Assert(t, strings.Contains(output, "Restored 0 resources from '"+archive+"' into namespace 'staging', skipped 8 existing resources."), output)
+}
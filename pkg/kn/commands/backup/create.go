@@ -0,0 +1,310 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/service"
+)
+
+// NewBackupCreateCommand represents 'kn backup create' command
+func NewBackupCreateCommand(p *commands.KnParams) *cobra.Command {
+	var (
+		archive string
+		force   bool
+	)
+
+	command := &cobra.Command{
+		Use:   "create",
+		Short: "Back up the Knative resources of a namespace to an archive",
+		Long: `Back up the Knative resources of a namespace to an archive
+
+The archive contains all services with their routed revisions, domain mappings, brokers,
+triggers, channels, subscriptions, sources and event types of the namespace. Fields populated
+by the cluster, like the status, are not included. Resources managed by another resource, e.g.
+event types registered by a source, are left out as their owner creates them again.`,
+		Example: `
+  # Back up the Knative resources of namespace 'prod' to 'prod.tar.gz'
+  kn backup create -n prod -o prod.tar.gz`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn backup create' does not accept any arguments")
+			}
+			if archive == "" {
+				return errors.New("'kn backup create' requires the archive to write given with '--output', e.g. '-o backup.tar.gz'")
+			}
+			if _, err := os.Stat(archive); err == nil && !force {
+				return fmt.Errorf("archive '%s' already exists, use '--force' to overwrite it", archive)
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			c, err := newClients(p, namespace)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			backup, err := createBackup(cmd.Context(), c, namespace, out)
+			if err != nil {
+				return err
+			}
+			if err := writeArchiveFile(archive, backup); err != nil {
+				return fmt.Errorf("cannot write backup archive '%s': %w", archive, err)
+			}
+			fmt.Fprintf(out, "Backed up %s from namespace '%s' to '%s'.\n", backup.summary(), namespace, archive)
+			return nil
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVarP(&archive, "output", "o", "", "Archive file to write the backup to, e.g. 'backup.tar.gz'.")
+	flags.BoolVar(&force, "force", false, "Overwrite the archive if it already exists.")
+	return command
+}
+
+// createBackup collects the resources of all kinds in the namespace. Kinds whose API
+// is not installed in the cluster are skipped.
+func createBackup(ctx context.Context, c *clients, namespace string, out io.Writer) (*content, error) {
+	backup := &content{
+		manifest: manifest{
+			Namespace: namespace,
+			Created:   time.Now().UTC().Truncate(time.Second),
+		},
+	}
+	for _, kind := range []struct {
+		name   string
+		backup func() error
+	}{
+		{"services", func() error { return backupServices(ctx, c, &backup.services) }},
+		{"domain mappings", func() error { return backupDomainMappings(ctx, c, &backup.domainMappings) }},
+		{"brokers", func() error { return backupBrokers(ctx, c, &backup.brokers) }},
+		{"channels", func() error { return backupChannels(ctx, c, &backup.channels) }},
+		{"subscriptions", func() error { return backupSubscriptions(ctx, c, &backup.subscriptions) }},
+		{"triggers", func() error { return backupTriggers(ctx, c, &backup.triggers) }},
+		{"sources", func() error { return backupSources(ctx, c.dynamic, &backup.sources) }},
+		{"event types", func() error { return backupEventTypes(ctx, c, &backup.eventTypes) }},
+	} {
+		err := kind.backup()
+		if knerrors.IsAPINotAvailableError(err) {
+			fmt.Fprintf(out, "Skipping %s, the API is not available in the cluster.\n", kind.name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot back up %s of namespace '%s': %w", kind.name, namespace, err)
+		}
+	}
+	return backup, nil
+}
+
+func backupServices(ctx context.Context, c *clients, list *clientv1alpha1.ExportList) error {
+	services, err := c.serving.ListServices(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: clientv1alpha1.SchemeGroupVersion.String(), Kind: "ExportList"}
+	for i := range services.Items {
+		svc := &services.Items[i]
+		if isOwned(svc) {
+			continue
+		}
+		export, err := service.ExportService(ctx, svc, c.serving, true)
+		if err != nil {
+			return err
+		}
+		list.Items = append(list.Items, *export)
+	}
+	return nil
+}
+
+func backupDomainMappings(ctx context.Context, c *clients, list *servingv1beta1.DomainMappingList) error {
+	domainMappings, err := c.domains.ListDomainMappings(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: servingv1beta1.SchemeGroupVersion.String(), Kind: "DomainMappingList"}
+	for i := range domainMappings.Items {
+		dm := &domainMappings.Items[i]
+		if isOwned(dm) {
+			continue
+		}
+		list.Items = append(list.Items, servingv1beta1.DomainMapping{
+			TypeMeta:   metav1.TypeMeta{APIVersion: servingv1beta1.SchemeGroupVersion.String(), Kind: "DomainMapping"},
+			ObjectMeta: backupObjectMeta(dm.ObjectMeta),
+			Spec:       dm.Spec,
+		})
+	}
+	return nil
+}
+
+func backupBrokers(ctx context.Context, c *clients, list *eventingv1.BrokerList) error {
+	brokers, err := c.eventing.ListBrokers(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: eventingv1.SchemeGroupVersion.String(), Kind: "BrokerList"}
+	for i := range brokers.Items {
+		broker := &brokers.Items[i]
+		if isOwned(broker) {
+			continue
+		}
+		list.Items = append(list.Items, eventingv1.Broker{
+			TypeMeta:   metav1.TypeMeta{APIVersion: eventingv1.SchemeGroupVersion.String(), Kind: "Broker"},
+			ObjectMeta: backupObjectMeta(broker.ObjectMeta),
+			Spec:       broker.Spec,
+		})
+	}
+	return nil
+}
+
+func backupChannels(ctx context.Context, c *clients, list *messagingv1.ChannelList) error {
+	channels, err := c.messaging.ChannelsClient().ListChannel(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: messagingv1.SchemeGroupVersion.String(), Kind: "ChannelList"}
+	for i := range channels.Items {
+		channel := &channels.Items[i]
+		if isOwned(channel) {
+			continue
+		}
+		spec := channel.Spec
+		// Subscribers are added by the subscriptions to the channel
+		spec.Subscribers = nil
+		list.Items = append(list.Items, messagingv1.Channel{
+			TypeMeta:   metav1.TypeMeta{APIVersion: messagingv1.SchemeGroupVersion.String(), Kind: "Channel"},
+			ObjectMeta: backupObjectMeta(channel.ObjectMeta),
+			Spec:       spec,
+		})
+	}
+	return nil
+}
+
+func backupSubscriptions(ctx context.Context, c *clients, list *messagingv1.SubscriptionList) error {
+	subscriptions, err := c.messaging.SubscriptionsClient().ListSubscription(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: messagingv1.SchemeGroupVersion.String(), Kind: "SubscriptionList"}
+	for i := range subscriptions.Items {
+		subscription := &subscriptions.Items[i]
+		if isOwned(subscription) {
+			continue
+		}
+		list.Items = append(list.Items, messagingv1.Subscription{
+			TypeMeta:   metav1.TypeMeta{APIVersion: messagingv1.SchemeGroupVersion.String(), Kind: "Subscription"},
+			ObjectMeta: backupObjectMeta(subscription.ObjectMeta),
+			Spec:       subscription.Spec,
+		})
+	}
+	return nil
+}
+
+func backupTriggers(ctx context.Context, c *clients, list *eventingv1.TriggerList) error {
+	triggers, err := c.eventing.ListTriggers(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: eventingv1.SchemeGroupVersion.String(), Kind: "TriggerList"}
+	for i := range triggers.Items {
+		trigger := &triggers.Items[i]
+		if isOwned(trigger) {
+			continue
+		}
+		list.Items = append(list.Items, eventingv1.Trigger{
+			TypeMeta:   metav1.TypeMeta{APIVersion: eventingv1.SchemeGroupVersion.String(), Kind: "Trigger"},
+			ObjectMeta: backupObjectMeta(trigger.ObjectMeta),
+			Spec:       trigger.Spec,
+		})
+	}
+	return nil
+}
+
+// backupSources collects the sources of all source types installed in the cluster
+func backupSources(ctx context.Context, client clientdynamic.KnDynamicClient, list *unstructured.UnstructuredList) error {
+	sourceTypes, err := client.ListSourcesTypes(ctx)
+	if err != nil {
+		return err
+	}
+	if len(sourceTypes.Items) == 0 {
+		return nil
+	}
+	sources, err := client.ListSources(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range sources.Items {
+		source := &sources.Items[i]
+		if isOwned(source) {
+			continue
+		}
+		meta := backupObjectMeta(metav1.ObjectMeta{
+			Name:        source.GetName(),
+			Labels:      source.GetLabels(),
+			Annotations: source.GetAnnotations(),
+		})
+		backup := unstructured.Unstructured{Object: map[string]interface{}{}}
+		backup.SetAPIVersion(source.GetAPIVersion())
+		backup.SetKind(source.GetKind())
+		backup.SetName(meta.Name)
+		backup.SetLabels(meta.Labels)
+		backup.SetAnnotations(meta.Annotations)
+		if spec, ok := source.Object["spec"]; ok {
+			backup.Object["spec"] = spec
+		}
+		list.Items = append(list.Items, backup)
+	}
+	return nil
+}
+
+func backupEventTypes(ctx context.Context, c *clients, list *eventingv1beta2.EventTypeList) error {
+	eventTypes, err := c.eventTypes.ListEventtypes(ctx)
+	if err != nil {
+		return err
+	}
+	list.TypeMeta = metav1.TypeMeta{APIVersion: eventingv1beta2.SchemeGroupVersion.String(), Kind: "EventTypeList"}
+	for i := range eventTypes.Items {
+		eventType := &eventTypes.Items[i]
+		if isOwned(eventType) {
+			continue
+		}
+		list.Items = append(list.Items, eventingv1beta2.EventType{
+			TypeMeta:   metav1.TypeMeta{APIVersion: eventingv1beta2.SchemeGroupVersion.String(), Kind: "EventType"},
+			ObjectMeta: backupObjectMeta(eventType.ObjectMeta),
+			Spec:       eventType.Spec,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+func TestBackupCreateErrors(t *testing.T) {
+	archive := t.TempDir() + "/backup.tar.gz"
+	cluster := newTestCluster(nil, nil)
+
+	_, err := cluster.execute("create", "-n", "prod")
+	assert.ErrorContains(t, err, "requires the archive to write given with '--output'")
+
+	_, err = cluster.execute("create", "foo", "-n", "prod", "-o", archive)
+	assert.ErrorContains(t, err, "does not accept any arguments")
+
+	assert.NilError(t, os.WriteFile(archive, []byte("data"), 0600))
+	_, err = cluster.execute("create", "-n", "prod", "-o", archive)
+	assert.ErrorContains(t, err, "already exists, use '--force' to overwrite it")
+
+	output, err := cluster.execute("create", "-n", "prod", "-o", archive, "--force")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(output, "Backed up no resources from namespace 'prod'"), output)
+}
+
+func TestBackupCreateSkipsUnavailableAPIs(t *testing.T) {
+	archive := t.TempDir() + "/backup.tar.gz"
+	cluster := newProdCluster()
+	cluster.eventing.PrependReactor("list", "brokers", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(eventingv1.Resource("brokers"), "")
+	})
+
+	output, err := cluster.execute("create", "-n", "prod", "-o", archive)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(output, "Skipping brokers, the API is not available in the cluster."), output)
+	assert.Assert(t, strings.Contains(output, "Backed up 1 service, 1 domain mapping, 1 channel"), output)
+
+	backup, err := readArchive(archive)
+	assert.NilError(t, err)
+	assert.Equal(t, backup.manifest.Namespace, "prod")
+	assert.Equal(t, len(backup.brokers.Items), 0)
+	assert.Equal(t, len(backup.services.Items), 1)
+	assert.Equal(t, backup.services.Items[0].Spec.Service.Namespace, "")
+	// The routed revision which is not the latest one
+	assert.Equal(t, len(backup.services.Items[0].Spec.Revisions), 1)
+	assert.Equal(t, backup.services.Items[0].Spec.Revisions[0].Name, "foo-00001")
+}
+
+func TestBackupCreateError(t *testing.T) {
+	archive := t.TempDir() + "/backup.tar.gz"
+	cluster := newProdCluster()
+	cluster.eventing.PrependReactor("list", "triggers", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(eventingv1.Resource("triggers"), "", nil)
+	})
+
+	_, err := cluster.execute("create", "-n", "prod", "-o", archive)
+	assert.ErrorContains(t, err, "cannot back up triggers of namespace 'prod'")
+	_, err = os.Stat(archive)
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func TestBackupCreateWriteError(t *testing.T) {
+	dir := t.TempDir()
+	cluster := newProdCluster()
+
+	_, err := cluster.execute("create", "-n", "prod", "-o", filepath.Join(dir, "missing", "backup.tar.gz"))
+	assert.ErrorContains(t, err, "cannot write backup archive")
+
+	// An existing archive is replaced without leaving temporary files behind
+	archive := filepath.Join(dir, "backup.tar.gz")
+	assert.NilError(t, os.WriteFile(archive, []byte("data"), 0600))
+	_, err = cluster.execute("create", "-n", "prod", "-o", archive, "--force")
+	assert.NilError(t, err)
+	backup, err := readArchive(archive)
+	assert.NilError(t, err)
+	assert.Equal(t, backup.manifest.Namespace, "prod")
+	files, err := os.ReadDir(dir)
+	assert.NilError(t, err)
+	assert.Equal(t, len(files), 1)
+}
@@ -0,0 +1,278 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/service"
+)
+
+// NewBackupRestoreCommand represents 'kn backup restore' command
+func NewBackupRestoreCommand(p *commands.KnParams) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "restore ARCHIVE",
+		Short: "Restore the Knative resources of a backup archive",
+		Long: `Restore the Knative resources of an archive created by 'kn backup create'
+
+Resources are created after the resources they depend on, in the order services, domain mappings,
+brokers, channels, subscriptions, triggers, sources and event types. Resources which already exist
+are skipped. Without '--namespace' the resources are restored into the namespace they have been
+backed up from. When restoring into another namespace, references to resources in the original
+namespace are changed to refer to the same resources in the target namespace.`,
+		Example: `
+  # Restore the resources backed up to 'prod.tar.gz' into their original namespace
+  kn backup restore prod.tar.gz
+
+  # Restore the resources backed up to 'prod.tar.gz' into namespace 'staging'
+  kn backup restore prod.tar.gz -n staging`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn backup restore' requires the archive to restore as single argument")
+			}
+			archive := args[0]
+			backup, err := readArchive(archive)
+			if err != nil {
+				return err
+			}
+
+			namespace := backup.manifest.Namespace
+			if cmd.Flags().Changed("namespace") || namespace == "" {
+				namespace, err = p.GetNamespace(cmd)
+				if err != nil {
+					return err
+				}
+			}
+			c, err := newClients(p, namespace)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			r := &restorer{
+				clients:   c,
+				out:       out,
+				from:      backup.manifest.Namespace,
+				namespace: namespace,
+			}
+			err = r.restore(cmd.Context(), backup)
+			fmt.Fprintf(out, "Restored %d resources from '%s' into namespace '%s', skipped %d existing resources.\n", r.restored, archive, namespace, r.skipped)
+			return err
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	return command
+}
+
+// restorer creates the resources of a backup in the target namespace
+type restorer struct {
+	clients *clients
+	out     io.Writer
+	// from is the namespace the resources have been backed up from
+	from string
+	// namespace the resources are restored into
+	namespace string
+
+	restored int
+	skipped  int
+
+	// sourceResources caches the resource names of the source types by their group and kind
+	sourceResources map[schema.GroupKind]string
+}
+
+// restore creates the resources of all kinds in the order of the archive's entries
+func (r *restorer) restore(ctx context.Context, backup *content) error {
+	for i := range backup.services.Items {
+		export := backup.services.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&export.Spec); err != nil {
+			return err
+		}
+		if err := r.create("service", export.Spec.Service.Name, func() error {
+			return service.ImportService(ctx, r.clients.serving, export)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.domainMappings.Items {
+		dm := backup.domainMappings.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&dm.Spec); err != nil {
+			return err
+		}
+		if err := r.create("domain mapping", dm.Name, func() error {
+			return r.clients.domains.CreateDomainMapping(ctx, dm)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.brokers.Items {
+		broker := backup.brokers.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&broker.Spec); err != nil {
+			return err
+		}
+		if err := r.create("broker", broker.Name, func() error {
+			return r.clients.eventing.CreateBroker(ctx, broker)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.channels.Items {
+		channel := backup.channels.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&channel.Spec); err != nil {
+			return err
+		}
+		if err := r.create("channel", channel.Name, func() error {
+			return r.clients.messaging.ChannelsClient().CreateChannel(ctx, channel)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.subscriptions.Items {
+		subscription := backup.subscriptions.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&subscription.Spec); err != nil {
+			return err
+		}
+		if err := r.create("subscription", subscription.Name, func() error {
+			return r.clients.messaging.SubscriptionsClient().CreateSubscription(ctx, subscription)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.triggers.Items {
+		trigger := backup.triggers.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&trigger.Spec); err != nil {
+			return err
+		}
+		if err := r.create("trigger", trigger.Name, func() error {
+			return r.clients.eventing.CreateTrigger(ctx, trigger)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.sources.Items {
+		source := backup.sources.Items[i].DeepCopy()
+		replaceNamespace(source.Object["spec"], r.from, r.namespace)
+		gvk := source.GroupVersionKind()
+		if err := r.create(strings.ToLower(gvk.Kind), source.GetName(), func() error {
+			gvr, err := r.sourceResource(ctx, gvk)
+			if err != nil {
+				return err
+			}
+			_, err = r.clients.dynamic.RawClient().Resource(gvr).Namespace(r.namespace).Create(ctx, source, metav1.CreateOptions{})
+			return knerrors.GetError(err)
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range backup.eventTypes.Items {
+		eventType := backup.eventTypes.Items[i].DeepCopy()
+		if err := r.rewriteNamespace(&eventType.Spec); err != nil {
+			return err
+		}
+		if err := r.create("event type", eventType.Name, func() error {
+			return r.clients.eventTypes.CreateEventtype(ctx, eventType)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceResource returns the resource of the given source type, as declared by the source's
+// CRD in the cluster
+func (r *restorer) sourceResource(ctx context.Context, gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	if resource, ok := r.sourceResources[gvk.GroupKind()]; ok {
+		return gvk.GroupVersion().WithResource(resource), nil
+	}
+	gvrs, err := r.clients.dynamic.SourcesGVRs(ctx, clientdynamic.WithTypeFilter(gvk.Kind))
+	if err != nil {
+		return schema.GroupVersionResource{}, knerrors.GetError(err)
+	}
+	for _, gvr := range gvrs {
+		if gvr.Group == gvk.Group {
+			if r.sourceResources == nil {
+				r.sourceResources = map[schema.GroupKind]string{}
+			}
+			r.sourceResources[gvk.GroupKind()] = gvr.Resource
+			return gvk.GroupVersion().WithResource(gvr.Resource), nil
+		}
+	}
+	return schema.GroupVersionResource{}, fmt.Errorf("source type '%s' is not installed in the cluster", gvk.GroupKind())
+}
+
+// create calls the given function for creating a resource and reports the outcome.
+// Resources which already exist are skipped.
+func (r *restorer) create(kind, name string, create func() error) error {
+	err := create()
+	if apierrors.IsAlreadyExists(err) {
+		fmt.Fprintf(r.out, "Skipping %s '%s', it already exists in namespace '%s'.\n", kind, name, r.namespace)
+		r.skipped++
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot restore %s '%s' in namespace '%s': %w", kind, name, r.namespace, err)
+	}
+	fmt.Fprintf(r.out, "Restored %s '%s'.\n", kind, name)
+	r.restored++
+	return nil
+}
+
+// rewriteNamespace changes the references in the given spec to resources in the namespace
+// of the backup to refer to the namespace the resources are restored into
+func (r *restorer) rewriteNamespace(spec interface{}) error {
+	if r.from == "" || r.from == r.namespace {
+		return nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+	if err != nil {
+		return err
+	}
+	replaceNamespace(u, r.from, r.namespace)
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u, spec)
+}
+
+// replaceNamespace replaces all 'namespace' fields with the value from by the value to
+func replaceNamespace(value interface{}, from, to string) {
+	if from == "" || from == to {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if namespace, ok := field.(string); ok && key == "namespace" && namespace == from {
+				v[key] = to
+				continue
+			}
+			replaceNamespace(field, from, to)
+		}
+	case []interface{}:
+		for _, item := range v {
+			replaceNamespace(item, from, to)
+		}
+	}
+}
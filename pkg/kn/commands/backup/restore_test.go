@@ -0,0 +1,108 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+func TestBackupRestoreErrors(t *testing.T) {
+	dir := t.TempDir()
+	cluster := newTestCluster(nil, nil)
+
+	_, err := cluster.execute("restore")
+	assert.ErrorContains(t, err, "requires the archive to restore as single argument")
+
+	_, err = cluster.execute("restore", dir+"/missing.tar.gz")
+	assert.ErrorContains(t, err, "no such file")
+
+	assert.NilError(t, os.WriteFile(dir+"/plain.txt", []byte("data"), 0600))
+	_, err = cluster.execute("restore", dir+"/plain.txt")
+	assert.ErrorContains(t, err, "cannot read backup archive")
+
+	// A valid archive without the backup manifest
+	buf := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	assert.NilError(t, tarWriter.WriteHeader(&tar.Header{Name: "service.yaml", Mode: 0644, Size: 4}))
+	_, err = tarWriter.Write([]byte("a: b"))
+	assert.NilError(t, err)
+	assert.NilError(t, tarWriter.Close())
+	assert.NilError(t, gzipWriter.Close())
+	assert.NilError(t, os.WriteFile(dir+"/other.tar.gz", buf.Bytes(), 0600))
+	_, err = cluster.execute("restore", dir+"/other.tar.gz")
+	assert.ErrorContains(t, err, "is not an archive created by 'kn backup create'")
+}
+
+func TestBackupRestoreIntoOriginalNamespace(t *testing.T) {
+	archive := t.TempDir() + "/prod.tar.gz"
+	_, err := newProdCluster().execute("create", "-n", "prod", "-o", archive)
+	assert.NilError(t, err)
+
+	cluster := newTestCluster(nil, nil, newSourceCRD("PingSource", "pingsources"))
+	output, err := cluster.execute("restore", archive)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(output, "into namespace 'prod'"), output)
+
+	trigger, err := cluster.eventing.EventingV1().Triggers("prod").Get(context.Background(), "t1", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, trigger.Spec.Subscriber.Ref.Namespace, "prod")
+}
+
+func TestBackupRestoreSourceResource(t *testing.T) {
+	archive := t.TempDir() + "/prod.tar.gz"
+	_, err := newProdCluster().execute("create", "-n", "prod", "-o", archive)
+	assert.NilError(t, err)
+
+	// The resource of a source type is taken from its CRD instead of being derived from the kind
+	cluster := newTestCluster(nil, nil, newSourceCRD("PingSource", "pingsrcs"))
+	_, err = cluster.execute("restore", archive, "-n", "staging")
+	assert.NilError(t, err)
+	gvr := schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1", Resource: "pingsrcs"}
+	_, err = cluster.dynamic.RawClient().Resource(gvr).Namespace("staging").Get(context.Background(), "ping", metav1.GetOptions{})
+	assert.NilError(t, err)
+
+	cluster = newTestCluster(nil, nil, newSourceCRD("ApiServerSource", "apiserversources"))
+	_, err = cluster.execute("restore", archive, "-n", "staging")
+	assert.ErrorContains(t, err, "cannot restore pingsource 'ping' in namespace 'staging': source type 'PingSource.sources.knative.dev' is not installed in the cluster")
+}
+
+func TestBackupRestoreError(t *testing.T) {
+	archive := t.TempDir() + "/prod.tar.gz"
+	_, err := newProdCluster().execute("create", "-n", "prod", "-o", archive)
+	assert.NilError(t, err)
+
+	cluster := newTestCluster(nil, nil)
+	cluster.eventing.PrependReactor("create", "triggers", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(eventingv1.Resource("triggers"), "t1", nil)
+	})
+	output, err := cluster.execute("restore", archive, "-n", "staging")
+	assert.ErrorContains(t, err, "cannot restore trigger 't1' in namespace 'staging'")
+	assert.Assert(t, strings.Contains(output, "Restored 5 resources from '"+archive+"' into namespace 'staging', skipped 0 existing resources."), output)
+}
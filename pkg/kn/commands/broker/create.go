@@ -26,6 +26,7 @@ import (
 
 	clientv1beta1 "knative.dev/client/pkg/eventing/v1"
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
 )
 
 var createExample = `
@@ -45,12 +46,16 @@ var createExample = `
 
   # Create a broker 'mybroker' in the myproject namespace with config referencing RabbitmqCluster mycluster in test namespace
   kn broker create mybroker --namespace myproject --class Kafka --broker-config rabbitmq.com/v1beta1:RabbitmqCluster:mycluster:test
+
+  # Create a broker 'mybroker' with the delivery settings of the profile 'prod' from the configuration
+  kn broker create mybroker --profile prod
 `
 
 // NewBrokerCreateCommand represents command to create new broker instance
 func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 
 	var className string
+	var profile string
 
 	var deliveryFlags DeliveryOptionFlags
 	var configFlags ConfigFlags
@@ -111,7 +116,17 @@ func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 				RetryAfterMax(&deliveryFlags.RetryAfterMax).
 				Config(configReference)
 
-			err = eventingClient.CreateBroker(cmd.Context(), brokerBuilder.Build())
+			broker := brokerBuilder.Build()
+			if cmd.Flags().Changed("profile") {
+				delivery, err := flags.ProfileDelivery(cmd.Context(), profile, dynamicClient, namespace)
+				if err != nil {
+					return err
+				}
+				// Delivery settings given with flags take precedence over the profile
+				broker.Spec.Delivery = flags.MergeDelivery(broker.Spec.Delivery, delivery)
+			}
+
+			err = eventingClient.CreateBroker(cmd.Context(), broker)
 			if err != nil {
 				return fmt.Errorf(
 					"cannot create broker '%s' in namespace '%s' "+
@@ -123,6 +138,7 @@ func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	cmd.Flags().StringVar(&className, "class", "", "Broker class like 'MTChannelBasedBroker' or 'Kafka' (if available).")
+	cmd.Flags().StringVar(&profile, "profile", "", "The profile name must be defined in config.yaml. The delivery settings of the profile are used for the settings not given with flags.")
 	configFlags.Add(cmd)
 	deliveryFlags.Add(cmd)
 	return cmd
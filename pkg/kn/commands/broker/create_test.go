@@ -23,6 +23,7 @@ import (
 	v1 "knative.dev/pkg/apis/duck/v1"
 
 	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/util"
 )
 
@@ -214,3 +215,36 @@ func TestBrokerCreateWithRetryAfterMax(t *testing.T) {
 
 	eventingRecorder.Validate()
 }
+
+func TestBrokerCreateWithProfile(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	retry := int32(5)
+	config.GlobalConfig = &config.TestConfig{TestProfiles: map[string]config.Profile{
+		"prod":   {Delivery: config.ProfileDelivery{DeadLetterSink: testSvc, Retry: &retry, Timeout: testTimeout}},
+		"labels": {Labels: []config.NamedValue{{Name: "team", Value: "blue"}}},
+	}}
+
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	expected := createBrokerWithDlSink(brokerName, testSvc)
+	flagRetry := int32(2)
+	expected.Spec.Delivery.Retry = &flagRetry
+	timeout := testTimeout
+	expected.Spec.Delivery.Timeout = &timeout
+	eventingRecorder.CreateBroker(expected, nil)
+	out, err := executeBrokerCommand(eventingClient, "create", brokerName, "--profile", "prod", "--retry", "2")
+	assert.NilError(t, err, "Broker should be created")
+	assert.Assert(t, util.ContainsAll(out, "Broker", brokerName, "created", "namespace", "default"))
+
+	// Profiles without delivery settings don't change the broker
+	eventingRecorder.CreateBroker(createBroker(brokerName), nil)
+	_, err = executeBrokerCommand(eventingClient, "create", brokerName, "--profile", "labels")
+	assert.NilError(t, err, "Broker should be created")
+
+	_, err = executeBrokerCommand(eventingClient, "create", brokerName, "--profile", "unknown")
+	assert.ErrorContains(t, err, "profile unknown doesn't exist")
+
+	eventingRecorder.Validate()
+}
@@ -62,13 +62,16 @@ func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
 			if waitFlags.Wait {
 				timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
 			}
+			progress := p.NewProgress(cmd.OutOrStdout())
+			start := time.Now()
 			err = eventingClient.DeleteBroker(cmd.Context(), name, timeout)
+			progress.Summary("broker", name, namespace, "delete", time.Since(start), err)
 			if err != nil {
 				return fmt.Errorf(
 					"cannot delete broker '%s' in namespace '%s' "+
 						"because: %s", name, namespace, err)
 			}
-			fmt.Fprintf(cmd.OutOrStdout(), "Broker '%s' successfully deleted in namespace '%s'.\n", args[0], namespace)
+			fmt.Fprintf(progress.Out(), "Broker '%s' successfully deleted in namespace '%s'.\n", args[0], namespace)
 			return nil
 		},
 	}
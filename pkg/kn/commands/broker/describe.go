@@ -22,12 +22,16 @@ import (
 	"io"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/spf13/cobra"
 
+	"knative.dev/eventing/pkg/apis/eventing"
 	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1"
 
+	"knative.dev/client/lib/printing"
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
 )
@@ -43,7 +47,10 @@ var describeExample = `
   kn broker describe mybroker -o yaml
 
   # Print only broker URL
-  kn broker describe mybroker -o url`
+  kn broker describe mybroker -o url
+
+  # Print the resolved dead letter sink URI of broker 'mybroker'
+  kn broker describe mybroker --model -o jsonpath='{.deadLetterSink.resolvedURI}'`
 
 // NewBrokerDescribeCommand represents command to describe details of broker instance
 func NewBrokerDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -79,22 +86,26 @@ func NewBrokerDescribeCommand(p *commands.KnParams) *cobra.Command {
 
 			out := cmd.OutOrStdout()
 
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				if strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
-					fmt.Fprintf(out, "%s\n", extractURL(broker))
-					return nil
-				}
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(broker, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() && strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
+				fmt.Fprintf(out, "%s\n", extractURL(broker))
+				return nil
+			}
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, broker, func() (runtime.Object, error) {
+					return brokerDescription(broker), nil
+				})
+			}
+			err = describeBroker(out, broker, false)
+			if err != nil {
+				return err
 			}
-			return describeBroker(out, broker, false)
+			return commands.WriteEventsIfRequested(cmd, p, namespace, commands.StaticEventObjects(commands.EventObject{Kind: "Broker", Name: broker.Name}))
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	commands.AddEventsFlag(cmd.Flags())
 	machineReadablePrintFlags.AddFlags(cmd)
+	commands.AddModelFlag(cmd.Flags())
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	return cmd
 }
@@ -113,6 +124,21 @@ func describeBroker(out io.Writer, broker *v1beta1.Broker, printDetails bool) er
 	return nil
 }
 
+// brokerDescription returns the describe model of the given broker
+func brokerDescription(broker *v1beta1.Broker) *v1alpha1.BrokerDescription {
+	desc := &v1alpha1.BrokerDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("BrokerDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(broker.ObjectMeta),
+		URL:        extractURL(broker),
+		Class:      broker.Annotations[eventing.BrokerClassKey],
+		Conditions: broker.Status.Conditions,
+	}
+	if broker.Spec.Delivery != nil {
+		desc.DeadLetterSink = printing.SinkDescription(broker.Spec.Delivery.DeadLetterSink, broker.Status.DeliveryStatus.DeadLetterSinkURI)
+	}
+	return desc
+}
+
 func extractURL(broker *v1beta1.Broker) string {
 	if broker.Status.AddressStatus.Address != nil {
 		return broker.Status.AddressStatus.Address.URL.String()
@@ -26,6 +26,7 @@ import (
 	"gotest.tools/v3/assert/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -116,6 +117,26 @@ func TestTriggerDescribeMachineReadable(t *testing.T) {
 	recorder.Validate()
 
 }
+func TestBrokerDescribeModel(t *testing.T) {
+	client := clientv1.NewMockKnEventingClient(t, "mynamespace")
+
+	broker := getBroker()
+	broker.Annotations = map[string]string{"eventing.knative.dev/broker.class": "MTChannelBasedBroker"}
+	broker.Spec.Delivery = &eventingduckv1.DeliverySpec{
+		DeadLetterSink: &duckv1.Destination{Ref: &duckv1.KReference{Kind: "Service", Name: "dls"}},
+	}
+	broker.Status.DeliveryStatus.DeadLetterSinkURI = &apis.URL{Scheme: "http", Host: "dls.default.svc.cluster.local"}
+	recorder := client.Recorder()
+	recorder.GetBroker("foo", broker, nil)
+
+	out, err := executeBrokerCommand(client, "describe", "foo", "--model", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: BrokerDescription", "url: http://foo-broker.test", "class: MTChannelBasedBroker",
+		"deadLetterSink:", "name: dls", "resolvedURI: http://dls.default.svc.cluster.local"))
+
+	recorder.Validate()
+}
+
 func getBroker() *eventingv1.Broker {
 	return &eventingv1.Broker{
 		TypeMeta: v1.TypeMeta{
@@ -23,12 +23,16 @@ import (
 
 	"github.com/spf13/cobra"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
+	"knative.dev/eventing/pkg/apis/eventing"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 )
 
@@ -37,7 +41,10 @@ var listExample = `
   kn broker list
 
   # List all brokers in JSON output format
-  kn broker list -o json`
+  kn broker list -o json
+
+  # List all brokers and watch for changes until interrupted
+  kn broker list --watch`
 
 // NewBrokerListCommand represents command to list all brokers
 func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
@@ -59,11 +66,20 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			brokerList, err := eventingClient.ListBrokers(cmd.Context())
+			labelSelector, fieldSelector, err := brokerListFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			brokerList, err := eventingClient.ListBrokers(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
+			if err != nil {
+				return err
+			}
+			err = brokerListFlags.Filter(brokerList)
 			if err != nil {
 				return err
 			}
-			if !brokerListFlags.GenericPrintFlags.OutputFlagSpecified() && len(brokerList.Items) == 0 {
+
+			if !brokerListFlags.GenericPrintFlags.OutputFlagSpecified() && len(brokerList.Items) == 0 && !brokerListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No brokers found.\n")
 				return nil
 			}
@@ -77,11 +93,17 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return nil
+			return brokerListFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{eventingv1.SchemeGroupVersion.WithResource("brokers")},
+				ListOptions: metav1.ListOptions{ResourceVersion: brokerList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &eventingv1.Broker{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
 	brokerListFlags.AddFlags(cmd)
+	brokerListFlags.AddWatchFlag(cmd)
 	return cmd
 }
 
@@ -95,6 +117,8 @@ func ListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready state of the Broker instance", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+		{Name: "Class", Type: "string", Description: "Class of the Broker implementation", Priority: hprinters.WideColumnPriority},
+		{Name: "DL-Sink", Type: "string", Description: "Sink for events which can't be delivered", Priority: hprinters.WideColumnPriority},
 	}
 	h.TableHandler(brokerColumnDefinitions, printBroker)
 	h.TableHandler(brokerColumnDefinitions, printBrokerList)
@@ -142,5 +166,12 @@ func printBroker(broker *eventingv1.Broker, options hprinters.PrintOptions) ([]m
 		conditions,
 		ready,
 		reason)
+	if options.Wide {
+		dlSink := ""
+		if broker.Spec.Delivery != nil && broker.Spec.Delivery.DeadLetterSink != nil {
+			dlSink = flags.SinkToString(*broker.Spec.Delivery.DeadLetterSink)
+		}
+		row.Cells = append(row.Cells, broker.Annotations[eventing.BrokerClassKey], dlSink)
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
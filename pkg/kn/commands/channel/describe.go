@@ -21,9 +21,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
 
+	"knative.dev/client/lib/printing"
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	knerrors "knative.dev/client/pkg/errors"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
@@ -34,7 +37,10 @@ var describeExample = `
   kn channel describe pipe
 
   # Print only channel URL
-  kn channel describe pipe -o url`
+  kn channel describe pipe -o url
+
+  # Print the kind of the channel implementation of 'pipe'
+  kn channel describe pipe --model -o jsonpath='{.channelType.kind}'`
 
 // NewChannelDescribeCommand returns a new command for describe a channel object
 func NewChannelDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -65,16 +71,14 @@ func NewChannelDescribeCommand(p *commands.KnParams) *cobra.Command {
 
 			out := cmd.OutOrStdout()
 
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				if strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
-					fmt.Fprintf(out, "%s\n", extractURL(channel))
-					return nil
-				}
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(channel, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() && strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
+				fmt.Fprintf(out, "%s\n", extractURL(channel))
+				return nil
+			}
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, channel, func() (runtime.Object, error) {
+					return channelDescription(channel), nil
+				})
 			}
 
 			dw := printers.NewPrefixWriter(out)
@@ -103,6 +107,7 @@ func NewChannelDescribeCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
 	machineReadablePrintFlags.AddFlags(cmd)
+	commands.AddModelFlag(flags)
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	return cmd
 }
@@ -116,6 +121,25 @@ func writeChannel(dw printers.PrefixWriter, channel *messagingv1.Channel, printD
 	}
 }
 
+// channelDescription returns the describe model of the given channel
+func channelDescription(channel *messagingv1.Channel) *v1alpha1.ChannelDescription {
+	desc := &v1alpha1.ChannelDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("ChannelDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(channel.ObjectMeta),
+		Conditions: channel.Status.Conditions,
+	}
+	if channel.Spec.ChannelTemplate != nil {
+		desc.ChannelType = channel.Spec.ChannelTemplate.TypeMeta
+	}
+	if channel.Status.Address != nil {
+		desc.URL = extractURL(channel)
+	}
+	if channel.Spec.Delivery != nil {
+		desc.DeadLetterSink = printing.SinkDescription(channel.Spec.Delivery.DeadLetterSink, channel.Status.DeadLetterSinkURI)
+	}
+	return desc
+}
+
 func extractURL(channel *messagingv1.Channel) string {
 	return channel.Status.Address.URL.String()
 }
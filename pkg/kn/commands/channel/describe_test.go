@@ -22,6 +22,7 @@ import (
 	"gotest.tools/v3/assert"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	clientv1 "knative.dev/client/pkg/messaging/v1"
 	"knative.dev/client/pkg/util"
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
@@ -69,6 +70,20 @@ func TestDescribeChannel(t *testing.T) {
 		assert.DeepEqual(t, channel, result)
 	})
 
+	t.Run("model output", func(t *testing.T) {
+		cRecorder.GetChannel("pipe", createChannelWithStatus("pipe", "default", &schema.GroupVersionKind{Group: "messaging.knative.dev", Version: "v1", Kind: "InMemoryChannel"}), nil)
+		out, err := executeChannelCommand(cClient, "describe", "pipe", "--model", "-o", "json")
+		assert.NilError(t, err, "channel should be described")
+
+		result := &v1alpha1.ChannelDescription{}
+		assert.NilError(t, json.Unmarshal([]byte(out), result))
+		assert.Equal(t, result.Kind, "ChannelDescription")
+		assert.Equal(t, result.Name, "pipe")
+		assert.Equal(t, result.ChannelType.Kind, "InMemoryChannel")
+		assert.Equal(t, result.ChannelType.APIVersion, "messaging.knative.dev/v1")
+		assert.Assert(t, util.ContainsAll(result.URL, "pipe-channel.test"))
+	})
+
 	cRecorder.Validate()
 }
 
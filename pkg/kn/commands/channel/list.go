@@ -21,6 +21,9 @@ import (
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
@@ -39,7 +42,10 @@ func NewChannelListCommand(p *commands.KnParams) *cobra.Command {
   kn channel list
 
   # List channels in YAML format
-  kn channel ping list -o yaml`,
+  kn channel ping list -o yaml
+
+  # List all channels and watch for changes until interrupted
+  kn channel list --watch`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given channel name
@@ -49,7 +55,11 @@ func NewChannelListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			channelList, err := client.ListChannel(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			channelList, err := client.ListChannel(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
@@ -61,7 +71,12 @@ func NewChannelListCommand(p *commands.KnParams) *cobra.Command {
 					return err
 				}
 			}
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(channelList.Items) == 0 {
+			err = listFlags.Filter(channelList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(channelList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No channels found.\n")
 				return nil
 			}
@@ -74,11 +89,16 @@ func NewChannelListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-
-			return nil
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   client.Namespace(),
+				Resources:   []schema.GroupVersionResource{messagingv1.SchemeGroupVersion.WithResource("channels")},
+				ListOptions: metav1.ListOptions{ResourceVersion: channelList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &messagingv1.Channel{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddWatchFlag(listCommand)
 	return listCommand
 }
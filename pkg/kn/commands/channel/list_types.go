@@ -67,6 +67,10 @@ func NewChannelListTypesCommand(p *commands.KnParams) *cobra.Command {
 			if !listTypesFlags.GenericPrintFlags.OutputFlagSpecified() && len(channelListTypes.Items) == 0 {
 				return knerrors.NewInvalidCRD("Channels")
 			}
+			err = listTypesFlags.Filter(channelListTypes)
+			if err != nil {
+				return err
+			}
 
 			if channelListTypes.GroupVersionKind().Empty() {
 				channelListTypes.SetAPIVersion("apiextensions.k8s.io/v1")
@@ -64,6 +64,18 @@ func ResourceNameCompletionFunc(p *KnParams) func(cmd *cobra.Command, args []str
 	}
 }
 
+// CompleteResourceNames returns the names of resources of the given kind, e.g. "broker",
+// which start with toComplete. It can be used for completing flag values.
+func CompleteResourceNames(p *KnParams, cmd *cobra.Command, resource string, toComplete string) []string {
+	config := completionConfig{
+		p,
+		cmd,
+		nil,
+		toComplete,
+	}
+	return config.getCompletion(resource)
+}
+
 func (config *completionConfig) getCompletion(parent string) []string {
 	completionFunc := resourceToFuncMap[parent]
 	if completionFunc == nil {
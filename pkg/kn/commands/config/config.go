@@ -0,0 +1,58 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigCommand to view, change and validate the kn configuration file
+func NewConfigCommand(p *commands.KnParams) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config COMMAND",
+		Short: "View, change and validate the kn configuration file",
+		Long: `View, change and validate the kn configuration file
+
+The configuration file is the one given with '--config' or the default one. The
+commands can be used even if the configuration file is invalid, so that it can be
+repaired. Keys are given as path of nested keys separated by dots, like 'output.color'.
+
+The configuration used by kn is merged from these sources, each overriding the previous ones:
+
+  1. the system configuration file /etc/kn/config.yaml (%ProgramData%\kn\config.yaml on Windows)
+  2. the user configuration file, which is the file changed by these commands
+  3. the project configuration file .kn/config.yaml in the working directory or one of its parents
+  4. environment variables like KN_OUTPUT_COLOR for 'output.color'
+  5. command line flags
+
+Sink mappings and channel type mappings are merged by their prefix and alias, and profiles
+by their name.`,
+	}
+	configCmd.AddCommand(NewConfigViewCommand(p))
+	configCmd.AddCommand(NewConfigGetCommand(p))
+	configCmd.AddCommand(NewConfigSetCommand(p))
+	configCmd.AddCommand(NewConfigUnsetCommand(p))
+	configCmd.AddCommand(NewConfigValidateCommand(p))
+	configCmd.AddCommand(NewConfigPathCommand(p))
+	return configCmd
+}
+
+// loadConfigFile reads the configuration file used by kn
+func loadConfigFile() (*knconfig.File, error) {
+	return knconfig.LoadFile(knconfig.GlobalConfig.ConfigFile())
+}
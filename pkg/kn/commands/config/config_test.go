@@ -0,0 +1,199 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/util"
+)
+
+// withConfigFile uses a configuration file with the given content
+func withConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0600))
+	oldConfig := knconfig.GlobalConfig
+	knconfig.GlobalConfig = &knconfig.TestConfig{TestConfigFile: path}
+	t.Cleanup(func() {
+		knconfig.GlobalConfig = oldConfig
+	})
+	return path
+}
+
+func executeConfigCommand(args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	cmd := NewConfigCommand(&commands.KnParams{})
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func TestConfigPathAndView(t *testing.T) {
+	content := "# my config\noutput:\n  color: never\n"
+	path := withConfigFile(t, content)
+
+	out, err := executeConfigCommand("path")
+	assert.NilError(t, err)
+	assert.Equal(t, out, path+"\n")
+
+	out, err = executeConfigCommand("view")
+	assert.NilError(t, err)
+	assert.Equal(t, out, content)
+
+	_, err = executeConfigCommand("view", "foo")
+	assert.ErrorContains(t, err, "does not accept any arguments")
+}
+
+func TestConfigPathAll(t *testing.T) {
+	oldConfig := knconfig.GlobalConfig
+	knconfig.GlobalConfig = &knconfig.TestConfig{TestLayers: []knconfig.Layer{
+		{Name: knconfig.LayerSystem, Path: "/etc/kn/config.yaml"},
+		{Name: knconfig.LayerProject, Path: "/work/.kn/config.yaml"},
+	}}
+	defer func() { knconfig.GlobalConfig = oldConfig }()
+
+	out, err := executeConfigCommand("path", "--all")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "system\t/etc/kn/config.yaml\nproject\t/work/.kn/config.yaml\n")
+}
+
+func TestConfigViewMerged(t *testing.T) {
+	oldConfig := knconfig.GlobalConfig
+	knconfig.GlobalConfig = &knconfig.TestConfig{TestMergedSettings: map[string]interface{}{
+		"output": map[string]interface{}{"color": "never"},
+		"lint":   map[string]interface{}{"dev-namespaces": []interface{}{"sandbox"}},
+	}}
+	defer func() { knconfig.GlobalConfig = oldConfig }()
+
+	out, err := executeConfigCommand("view", "--merged")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "lint:\n  dev-namespaces:\n    - sandbox\noutput:\n  color: never\n")
+
+	knconfig.GlobalConfig = &knconfig.TestConfig{}
+	out, err = executeConfigCommand("view", "--merged")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "")
+}
+
+func TestConfigGet(t *testing.T) {
+	withConfigFile(t, "output:\n  color: never\n")
+
+	out, err := executeConfigCommand("get", "output.color")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "never\n")
+
+	out, err = executeConfigCommand("get", "output")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "color: never\n")
+
+	_, err = executeConfigCommand("get", "plugins.directory")
+	assert.ErrorContains(t, err, "key 'plugins.directory' is not set in configuration file")
+
+	_, err = executeConfigCommand("get")
+	assert.ErrorContains(t, err, "requires the key as single argument")
+}
+
+func TestConfigSet(t *testing.T) {
+	path := withConfigFile(t, "# my config\noutput:\n  color: never\n")
+
+	out, err := executeConfigCommand("set", "output.color", "always")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Key 'output.color' set in configuration file", path))
+
+	out, err = executeConfigCommand("set", "eventing.sink-mappings", "[{prefix: svc, resource: services, group: core, version: v1}]")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Key 'eventing.sink-mappings' set"))
+
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), `# my config
+output:
+  color: always
+eventing:
+  sink-mappings: [{prefix: svc, resource: services, group: core, version: v1}]
+`)
+}
+
+func TestConfigSetInvalid(t *testing.T) {
+	content := "output:\n  color: never\nfoo: bar\n"
+	path := withConfigFile(t, content)
+
+	_, err := executeConfigCommand("set", "output.color", "blue")
+	assert.ErrorContains(t, err, "invalid value for key 'output.color':\n  output.color: invalid value 'blue', must be one of auto, always, never")
+
+	_, err = executeConfigCommand("set", "eventing.sink-mappings", "[{prefix: svc}]")
+	assert.ErrorContains(t, err, "eventing.sink-mappings[0]: missing key 'resource'")
+
+	_, err = executeConfigCommand("set", "outputs.color", "never")
+	assert.ErrorContains(t, err, "outputs: unknown key")
+
+	_, err = executeConfigCommand("set", "output.color")
+	assert.ErrorContains(t, err, "requires the key and the value as arguments")
+
+	// Nothing has been written
+	written, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(written), content)
+
+	// An issue with another key doesn't prevent setting a valid value
+	_, err = executeConfigCommand("set", "output.color", "auto")
+	assert.NilError(t, err)
+}
+
+func TestConfigUnset(t *testing.T) {
+	path := withConfigFile(t, "output:\n  color: never\nfoo: bar\n")
+
+	out, err := executeConfigCommand("unset", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Key 'foo' removed from configuration file"))
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "output:\n  color: never\n")
+
+	_, err = executeConfigCommand("unset", "foo")
+	assert.ErrorContains(t, err, "key 'foo' is not set")
+}
+
+func TestConfigValidate(t *testing.T) {
+	path := withConfigFile(t, "output:\n  color: never\n")
+	out, err := executeConfigCommand("validate")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Configuration file "+path+" is valid."))
+
+	path = withConfigFile(t, "output:\n  color: blue\nplugins-dir: /plugins\n")
+	out, err = executeConfigCommand("validate")
+	assert.ErrorContains(t, err, "configuration file "+path+" has 1 error(s)")
+	assert.Assert(t, util.ContainsAll(out,
+		path+":2: error: output.color: invalid value 'blue', must be one of auto, always, never",
+		path+":3: warning: plugins-dir: deprecated, use 'plugins.directory' instead"))
+
+	path = withConfigFile(t, "- foo\n")
+	out, err = executeConfigCommand("validate")
+	assert.ErrorContains(t, err, "has 1 error(s)")
+	assert.Assert(t, util.ContainsAll(out, path+":1: error: must be a mapping of keys to values"))
+
+	withConfigFile(t, "output: [foo\n")
+	_, err = executeConfigCommand("validate")
+	assert.ErrorContains(t, err, "cannot parse configuration file")
+}
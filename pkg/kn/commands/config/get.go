@@ -0,0 +1,59 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewConfigGetCommand represents 'kn config get' command
+func NewConfigGetCommand(p *commands.KnParams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get KEY",
+		Short: "Print the value of a key of the configuration file",
+		Long: `Print the value of a key of the configuration file
+
+Values which are not a single value, like lists or nested keys, are printed as YAML.`,
+		Example: `
+  # Print the configured color mode
+  kn config get output.color
+
+  # Print the configured sink mappings
+  kn config get eventing.sink-mappings`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn config get' requires the key as single argument")
+			}
+			file, err := loadConfigFile()
+			if err != nil {
+				return err
+			}
+			value, found, err := file.Get(args[0])
+			if err != nil {
+				return err
+			}
+			if !found {
+				return fmt.Errorf("key '%s' is not set in configuration file %s", args[0], file.Path())
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), value)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigPathCommand represents 'kn config path' command
+func NewConfigPathCommand(p *commands.KnParams) *cobra.Command {
+	var all bool
+	cmd := &cobra.Command{
+		Use:   "path",
+		Short: "Print the location of the configuration file",
+		Example: `
+  # Open the configuration file in an editor
+  vi $(kn config path)
+
+  # Print all configuration files merged into the configuration
+  kn config path --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn config path' does not accept any arguments")
+			}
+			if !all {
+				fmt.Fprintln(cmd.OutOrStdout(), knconfig.GlobalConfig.ConfigFile())
+				return nil
+			}
+			for _, layer := range knconfig.GlobalConfig.Layers() {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\n", layer.Name, layer.Path)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&all, "all", false, "Print all configuration files merged into the configuration with their layer, from the lowest to the highest precedence")
+	return cmd
+}
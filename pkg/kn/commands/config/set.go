@@ -0,0 +1,94 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigSetCommand represents 'kn config set' command
+func NewConfigSetCommand(p *commands.KnParams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "Set the value of a key in the configuration file",
+		Long: `Set the value of a key in the configuration file
+
+The value is parsed as YAML, so that lists and nested keys can be set, too. Missing
+parent keys are created and comments are preserved. A value which is not valid for the
+key is not written.`,
+		Example: `
+  # Disable colored output
+  kn config set output.color never
+
+  # Map sink prefix 'svc' to Kubernetes services
+  kn config set eventing.sink-mappings '[{prefix: svc, resource: services, group: core, version: v1}]'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("'kn config set' requires the key and the value as arguments")
+			}
+			key, value := args[0], args[1]
+			file, err := loadConfigFile()
+			if err != nil {
+				return err
+			}
+			if err := file.Set(key, value); err != nil {
+				return err
+			}
+			if err := checkChangedKey(file, key); err != nil {
+				return err
+			}
+			if err := file.Save(); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Key '%s' set in configuration file %s.\n", key, file.Path())
+			return nil
+		},
+	}
+}
+
+// checkChangedKey returns an error if the schema validation of the changed file fails
+// for the changed key. Issues of other keys don't prevent fixing the file key by key.
+func checkChangedKey(file *knconfig.File, key string) error {
+	issues, err := file.Validate()
+	if err != nil {
+		return err
+	}
+	var messages []string
+	for _, issue := range issues {
+		if !issue.Warning && relatedKeys(issue.Key, key) {
+			messages = append(messages, fmt.Sprintf("%s: %s", issue.Key, issue.Message))
+		}
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("invalid value for key '%s':\n  %s", key, strings.Join(messages, "\n  "))
+	}
+	return nil
+}
+
+// relatedKeys returns true if one key is the other one or nested in it
+func relatedKeys(a, b string) bool {
+	return a == b || isNestedKey(a, b) || isNestedKey(b, a)
+}
+
+func isNestedKey(key, parent string) bool {
+	return parent == "" || strings.HasPrefix(key, parent+".") || strings.HasPrefix(key, parent+"[")
+}
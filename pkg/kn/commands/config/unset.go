@@ -0,0 +1,52 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewConfigUnsetCommand represents 'kn config unset' command
+func NewConfigUnsetCommand(p *commands.KnParams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unset KEY",
+		Short: "Remove a key from the configuration file",
+		Example: `
+  # Remove the configured color mode
+  kn config unset output.color`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn config unset' requires the key as single argument")
+			}
+			file, err := loadConfigFile()
+			if err != nil {
+				return err
+			}
+			if !file.Unset(args[0]) {
+				return fmt.Errorf("key '%s' is not set in configuration file %s", args[0], file.Path())
+			}
+			if err := file.Save(); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Key '%s' removed from configuration file %s.\n", args[0], file.Path())
+			return nil
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewConfigValidateCommand represents 'kn config validate' command
+func NewConfigValidateCommand(p *commands.KnParams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configuration file",
+		Long: `Validate the configuration file
+
+All keys and values of the configuration file are checked against the JSON schema of
+the configuration. Errors and warnings are printed with the line they refer to.`,
+		Example: `
+  # Validate the configuration file
+  kn config validate`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn config validate' does not accept any arguments")
+			}
+			file, err := loadConfigFile()
+			if err != nil {
+				return err
+			}
+			issues, err := file.Validate()
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			errCount := 0
+			for _, issue := range issues {
+				level := "warning"
+				if !issue.Warning {
+					level = "error"
+					errCount++
+				}
+				if issue.Key == "" {
+					fmt.Fprintf(out, "%s:%d: %s: %s\n", file.Path(), issue.Line, level, issue.Message)
+				} else {
+					fmt.Fprintf(out, "%s:%d: %s: %s: %s\n", file.Path(), issue.Line, level, issue.Key, issue.Message)
+				}
+			}
+			if errCount > 0 {
+				return fmt.Errorf("configuration file %s has %d error(s)", file.Path(), errCount)
+			}
+			fmt.Fprintf(out, "Configuration file %s is valid.\n", file.Path())
+			return nil
+		},
+	}
+}
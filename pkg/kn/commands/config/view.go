@@ -0,0 +1,66 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigViewCommand represents 'kn config view' command
+func NewConfigViewCommand(p *commands.KnParams) *cobra.Command {
+	var merged bool
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Print the configuration file",
+		Example: `
+  # Print the configuration file
+  kn config view
+
+  # Print the configuration merged from all configuration files and environment variables
+  kn config view --merged`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn config view' does not accept any arguments")
+			}
+			if merged {
+				settings := knconfig.GlobalConfig.MergedSettings()
+				if len(settings) == 0 {
+					return nil
+				}
+				encoder := yaml.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent(2)
+				if err := encoder.Encode(settings); err != nil {
+					return err
+				}
+				return encoder.Close()
+			}
+			content, err := os.ReadFile(knconfig.GlobalConfig.ConfigFile())
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(content)
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&merged, "merged", false, "Print the configuration merged from the system, user and project configuration files and the KN_ environment variables")
+	return cmd
+}
@@ -169,15 +169,39 @@ func WriteConditions(dw printers.PrefixWriter, conditions []apis.Condition, prin
 	formatRow := "%-2s %-" + strconv.Itoa(maxLen) + "s %6s %-s\n"
 	section.Writef(formatHeader, "OK", "TYPE", "AGE", "REASON")
 	for _, condition := range conditions {
-		ok := formatStatus(condition)
+		color := conditionColor(condition)
+		ok := printers.Colorize(fmt.Sprintf("%-2s", formatStatus(condition)), color)
 		reason := condition.Reason
 		if printMessage && reason != "" {
 			reason = fmt.Sprintf("%s (%s)", reason, condition.Message)
 		}
+		if condition.Status == corev1.ConditionFalse && reason != "" {
+			reason = printers.Colorize(reason, color)
+		}
 		section.Writef(formatRow, ok, formatConditionType(condition), Age(condition.LastTransitionTime.Inner.Time), reason)
 	}
 }
 
+// conditionColor returns green for true conditions, yellow for unknown ones and red
+// for false ones, unless they are only warnings or informational
+func conditionColor(c apis.Condition) printers.Color {
+	switch c.Status {
+	case corev1.ConditionTrue:
+		return printers.ColorGreen
+	case corev1.ConditionFalse:
+		switch c.Severity {
+		case apis.ConditionSeverityWarning:
+			return printers.ColorYellow
+		case apis.ConditionSeverityInfo:
+			return printers.ColorDefault
+		default:
+			return printers.ColorRed
+		}
+	default:
+		return printers.ColorYellow
+	}
+}
+
 // Writer a slice compact (printDetails == false) in one line, or over multiple line
 // with key-value line-by-line (printDetails == true)
 func WriteSliceDesc(dw printers.PrefixWriter, s []string, label string, printDetails bool) {
@@ -0,0 +1,90 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/lib/printing"
+	"knative.dev/client/pkg/apis/client/v1alpha1"
+)
+
+// AddModelFlag adds the --model flag to describe commands
+func AddModelFlag(flags *pflag.FlagSet) {
+	flags.Bool("model", false, "Print the information shown by describe, e.g. URLs, traffic and resolved sinks, instead of the resource. Requires --output, e.g. '-o json' or '-o jsonpath={.url}'.")
+}
+
+// ModelRequested returns true if --model has been given
+func ModelRequested(cmd *cobra.Command) bool {
+	flag := cmd.Flags().Lookup("model")
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// PrintDescribeOutput prints the given resource in the requested machine readable format or,
+// if --model is given, the describe model created by newModel
+func PrintDescribeOutput(cmd *cobra.Command, printFlags *genericclioptions.PrintFlags, resource runtime.Object, newModel func() (runtime.Object, error)) error {
+	if !printFlags.OutputFlagSpecified() {
+		return errors.New("'--model' requires an output format given with '--output', e.g. '-o yaml'")
+	}
+	printer, err := printFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+	toPrint := resource
+	if ModelRequested(cmd) {
+		toPrint, err = newModel()
+		if err != nil {
+			return err
+		}
+	}
+	return printer.PrintObj(toPrint, cmd.OutOrStdout())
+}
+
+// DescriptionTypeMeta returns the type of the describe model with the given kind
+func DescriptionTypeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: kind}
+}
+
+// DescriptionObjectMeta returns the metadata of a resource as included in its describe model,
+// leaving out the fields only used for managing the resource
+func DescriptionObjectMeta(m metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              m.Name,
+		Namespace:         m.Namespace,
+		UID:               m.UID,
+		Generation:        m.Generation,
+		CreationTimestamp: m.CreationTimestamp,
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+	}
+}
+
+// SourceDescription returns the describe model of an event source of the given kind
+func SourceDescription(kind string, m metav1.ObjectMeta, spec *duckv1.SourceSpec, status *duckv1.SourceStatus) *v1alpha1.SourceDescription {
+	return &v1alpha1.SourceDescription{
+		TypeMeta:   DescriptionTypeMeta("SourceDescription"),
+		ObjectMeta: DescriptionObjectMeta(m),
+		SourceKind: kind,
+		Sink:       printing.SinkDescription(&spec.Sink, status.SinkURI),
+		Conditions: status.Conditions,
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"knative.dev/client/pkg/apis/client/v1alpha1"
+)
+
+func TestPrintDescribeOutput(t *testing.T) {
+	resource := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+	}
+	model := func() (runtime.Object, error) {
+		return &v1alpha1.SourceDescription{
+			TypeMeta:   DescriptionTypeMeta("SourceDescription"),
+			ObjectMeta: DescriptionObjectMeta(resource.ObjectMeta),
+			SourceKind: "TestSource",
+		}, nil
+	}
+
+	for _, tc := range []struct {
+		name     string
+		args     []string
+		expected string
+		err      string
+	}{
+		{"resource", []string{"-o", "jsonpath={.kind}"}, "ConfigMap", ""},
+		{"model", []string{"--model", "-o", "jsonpath={.kind}/{.sourceKind}/{.apiVersion}"}, "SourceDescription/TestSource/client.knative.dev/v1alpha1", ""},
+		{"model without output", []string{"--model"}, "", "requires an output format"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			printFlags := genericclioptions.NewPrintFlags("")
+			cmd := &cobra.Command{Use: "describe"}
+			printFlags.AddFlags(cmd)
+			AddModelFlag(cmd.Flags())
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			assert.NilError(t, cmd.ParseFlags(tc.args))
+
+			err := PrintDescribeOutput(cmd, printFlags, resource, model)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, out.String(), tc.expected)
+		})
+	}
+}
+
+func TestDescriptionObjectMeta(t *testing.T) {
+	meta := metav1.ObjectMeta{
+		Name:          "foo",
+		Namespace:     "bar",
+		Labels:        map[string]string{"a": "b"},
+		ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kn"}},
+		Finalizers:    []string{"finalizer"},
+	}
+	desc := DescriptionObjectMeta(meta)
+	assert.Equal(t, desc.Name, "foo")
+	assert.Equal(t, desc.Namespace, "bar")
+	assert.DeepEqual(t, desc.Labels, map[string]string{"a": "b"})
+	assert.Assert(t, desc.ManagedFields == nil)
+	assert.Assert(t, desc.Finalizers == nil)
+}
@@ -26,6 +26,7 @@ import (
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
 	"knative.dev/pkg/apis"
 )
 
@@ -112,6 +113,24 @@ OK TYPE AGE REASON
 	}
 }
 
+func TestWriteConditionsWithColor(t *testing.T) {
+	printers.EnableColor(true)
+	defer printers.EnableColor(false)
+
+	buf := &bytes.Buffer{}
+	dw := printers.NewBarePrefixWriter(buf)
+	conditions := append([]apis.Condition{{Type: "Broken", Status: "False", Reason: "Failed"}, {Type: "Pending", Status: "Unknown"}}, someConditions...)
+	WriteConditions(dw, conditions, false)
+	out := buf.String()
+	assert.Assert(t, util.ContainsAll(out,
+		"\x1b[32m++\x1b[0m Ready",
+		"\x1b[31m!!\x1b[0m Broken",
+		"\x1b[31mFailed\x1b[0m",
+		"\x1b[33m??\x1b[0m Pending",
+		"\x1b[33m W\x1b[0m Bbb",
+		"\x1b[33mBad\x1b[0m"))
+}
+
 func TestWriteSliceDesc(t *testing.T) {
 	var out bytes.Buffer
 	pw := printers.NewBarePrefixWriter(&out)
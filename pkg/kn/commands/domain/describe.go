@@ -21,8 +21,10 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
 	"knative.dev/serving/pkg/apis/serving/v1beta1"
@@ -37,7 +39,10 @@ func NewDomainMappingDescribeCommand(p *commands.KnParams) *cobra.Command {
 		Short: "Show details of a domain mapping",
 		Example: `
   # Show details of for the domain 'hello.example.com'
-  kn domain describe hello.example.com`,
+  kn domain describe hello.example.com
+
+  # Print the name of the resource the domain 'hello.example.com' is mapped to
+  kn domain describe hello.example.com --model -o jsonpath='{.ref.name}'`,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
@@ -58,16 +63,14 @@ func NewDomainMappingDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				if strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
-					fmt.Fprintf(cmd.OutOrStdout(), "%s\n", domainMapping.Status.URL)
-					return nil
-				}
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(domainMapping, cmd.OutOrStdout())
+			if machineReadablePrintFlags.OutputFlagSpecified() && strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", domainMapping.Status.URL)
+				return nil
+			}
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, domainMapping, func() (runtime.Object, error) {
+					return domainMappingDescription(domainMapping), nil
+				})
 			}
 			printDetails, err := cmd.Flags().GetBool("verbose")
 			if err != nil {
@@ -79,11 +82,26 @@ func NewDomainMappingDescribeCommand(p *commands.KnParams) *cobra.Command {
 	flags := cmd.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	machineReadablePrintFlags.AddFlags(cmd)
+	commands.AddModelFlag(flags)
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	flags.BoolP("verbose", "v", false, "More output.")
 	return cmd
 }
 
+// domainMappingDescription returns the describe model of the given domain mapping
+func domainMappingDescription(domainMapping *v1beta1.DomainMapping) *v1alpha1.DomainMappingDescription {
+	desc := &v1alpha1.DomainMappingDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("DomainMappingDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(domainMapping.ObjectMeta),
+		Ref:        domainMapping.Spec.Ref,
+		Conditions: domainMapping.Status.Conditions,
+	}
+	if domainMapping.Status.URL != nil {
+		desc.URL = domainMapping.Status.URL.String()
+	}
+	return desc
+}
+
 func describe(w io.Writer, domainMapping *v1beta1.DomainMapping, printDetails bool) error {
 	dw := printers.NewPrefixWriter(w)
 	commands.WriteMetadata(dw, &domainMapping.ObjectMeta, printDetails)
@@ -135,6 +135,20 @@ func TestDomainMappingDescribeYAML(t *testing.T) {
 	servingRecorder.Validate()
 }
 
+func TestDomainMappingDescribeModel(t *testing.T) {
+	client := v1beta1.NewMockKnServiceClient(t)
+
+	servingRecorder := client.Recorder()
+	servingRecorder.GetDomainMapping("foo.bar", getDomainMapping(), nil)
+
+	out, err := executeDomainCommand(client, nil, "describe", "foo.bar", "--model", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: DomainMappingDescription", "url: http://foo.bar", "ref:", "name: foo"))
+	assert.Assert(t, util.ContainsNone(out, "\nspec:", "\nstatus:"))
+
+	servingRecorder.Validate()
+}
+
 func getDomainMapping(ns ...string) *servingv1beta1.DomainMapping {
 	serviceNamespace := "default"
 	if len(ns) == 1 {
@@ -18,9 +18,14 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewDomainMappingListCommand represents 'kn revision list' command
@@ -35,7 +40,10 @@ func NewDomainMappingListCommand(p *commands.KnParams) *cobra.Command {
   kn domain list
 
   # List all domain mappings in JSON output format
-  kn domain list -o json`,
+  kn domain list -o json
+
+  # List all domain mappings and watch for changes until interrupted
+  kn domain list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -50,20 +58,39 @@ func NewDomainMappingListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			domainMappingList, err := client.ListDomainMappings(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			domainMappingList, err := client.ListDomainMappings(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
 
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(domainMappingList.Items) == 0 {
+			err = listFlags.Filter(domainMappingList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(domainMappingList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No domain mapping found.\n")
 				return nil
 			}
 
-			return listFlags.Print(domainMappingList, cmd.OutOrStdout())
+			err = listFlags.Print(domainMappingList, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{servingv1beta1.SchemeGroupVersion.WithResource("domainmappings")},
+				ListOptions: metav1.ListOptions{ResourceVersion: domainMappingList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &servingv1beta1.DomainMapping{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
 	listFlags.AddFlags(cmd)
+	listFlags.AddWatchFlag(cmd)
 	return cmd
 }
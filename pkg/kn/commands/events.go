@@ -0,0 +1,164 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/client/pkg/printers"
+)
+
+// EventObject identifies an object whose events should be shown
+type EventObject struct {
+	Kind string
+	Name string
+}
+
+// AddEventsFlag adds the --events flag to describe commands
+func AddEventsFlag(flags *pflag.FlagSet) {
+	flags.Bool("events", false, "Show the events of the resource and of the resources it owns.")
+}
+
+// EventsRequested returns true if --events has been given
+func EventsRequested(cmd *cobra.Command) bool {
+	flag := cmd.Flags().Lookup("events")
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// WorkloadEventObjects returns the Deployments and Pods matching the given label,
+// e.g. the ones created for a revision or a service
+func WorkloadEventObjects(ctx context.Context, client kubernetes.Interface, namespace string, labelKey string, labelValue string) ([]EventObject, error) {
+	opts := metav1.ListOptions{LabelSelector: labels.Set{labelKey: labelValue}.String()}
+	var objects []EventObject
+	deployments, err := client.AppsV1().Deployments(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments.Items {
+		objects = append(objects, EventObject{Kind: "Deployment", Name: d.Name})
+	}
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pods.Items {
+		objects = append(objects, EventObject{Kind: "Pod", Name: p.Name})
+	}
+	return objects, nil
+}
+
+// ListEvents returns the events of all given objects, sorted by the time they occurred last.
+// The events of the namespace are listed once and filtered by their involved object.
+func ListEvents(ctx context.Context, client kubernetes.Interface, namespace string, objects ...EventObject) ([]corev1.Event, error) {
+	if len(objects) == 0 {
+		return nil, nil
+	}
+	wanted := make(map[EventObject]bool, len(objects))
+	for _, obj := range objects {
+		wanted[obj] = true
+	}
+	list, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var events []corev1.Event
+	for _, e := range list.Items {
+		if wanted[EventObject{Kind: e.InvolvedObject.Kind, Name: e.InvolvedObject.Name}] {
+			events = append(events, e)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+	return events, nil
+}
+
+// EventObjectsFunc returns the objects whose events should be shown. The given client
+// can be used for looking up objects owned by the described resource.
+type EventObjectsFunc func(ctx context.Context, client kubernetes.Interface) ([]EventObject, error)
+
+// StaticEventObjects returns an EventObjectsFunc for a fixed set of objects
+func StaticEventObjects(objects ...EventObject) EventObjectsFunc {
+	return func(ctx context.Context, client kubernetes.Interface) ([]EventObject, error) {
+		return objects, nil
+	}
+}
+
+// WriteEventsIfRequested writes an events section for the objects returned by objectsFunc
+// to the command's output if --events has been given
+func WriteEventsIfRequested(cmd *cobra.Command, p *KnParams, namespace string, objectsFunc EventObjectsFunc) error {
+	if !EventsRequested(cmd) {
+		return nil
+	}
+	client, err := p.NewKubeClient()
+	if err != nil {
+		return err
+	}
+	objects, err := objectsFunc(cmd.Context(), client)
+	if err != nil {
+		return fmt.Errorf("cannot look up resources for events in namespace '%s': %w", namespace, err)
+	}
+	events, err := ListEvents(cmd.Context(), client, namespace, objects...)
+	if err != nil {
+		return fmt.Errorf("cannot list events in namespace '%s': %w", namespace, err)
+	}
+	dw := printers.NewPrefixWriter(cmd.OutOrStdout())
+	dw.WriteLine()
+	WriteEvents(dw, events)
+	return dw.Flush()
+}
+
+// WriteEvents prints out a table with the given events
+func WriteEvents(dw printers.PrefixWriter, events []corev1.Event) {
+	section := dw.WriteAttribute("Events", "")
+	if len(events) == 0 {
+		section.WriteColsLn("<none>")
+		return
+	}
+	section.WriteColsLn("AGE", "TYPE", "REASON", "OBJECT", "MESSAGE")
+	for _, e := range events {
+		object := fmt.Sprintf("%s/%s", strings.ToLower(e.InvolvedObject.Kind), e.InvolvedObject.Name)
+		reason := e.Reason
+		if e.Count > 1 {
+			reason = fmt.Sprintf("%s (x%d)", reason, e.Count)
+		}
+		section.WriteColsLn(Age(eventTime(&e)), e.Type, reason, object, strings.TrimSpace(e.Message))
+	}
+}
+
+// eventTime returns the time an event has been observed last
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
+)
+
+func TestListEvents(t *testing.T) {
+	now := time.Now()
+	client := fake.NewSimpleClientset(
+		newTestEvent("e1", "Revision", "foo-00001", "Created", now.Add(-time.Minute)),
+		newTestEvent("e2", "Pod", "foo-00001-deployment-abc", "Pulled", now.Add(-2*time.Minute)),
+		newTestEvent("e3", "Revision", "other", "Created", now),
+	)
+	labels := map[string]string{"serving.knative.dev/revision": "foo-00001"}
+	_, err := client.CoreV1().Pods(FakeNamespace).Create(context.Background(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-00001-deployment-abc", Namespace: FakeNamespace, Labels: labels},
+	}, metav1.CreateOptions{})
+	assert.NilError(t, err)
+
+	workloads, err := WorkloadEventObjects(context.Background(), client, FakeNamespace, "serving.knative.dev/revision", "foo-00001")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, workloads, []EventObject{{Kind: "Pod", Name: "foo-00001-deployment-abc"}})
+
+	events, err := ListEvents(context.Background(), client, FakeNamespace, append(workloads, EventObject{Kind: "Revision", Name: "foo-00001"})...)
+	assert.NilError(t, err)
+	assert.Equal(t, len(events), 2)
+	assert.Equal(t, events[0].Name, "e2")
+	assert.Equal(t, events[1].Name, "e1")
+
+	// Events are listed once for all objects
+	client.ClearActions()
+	_, err = ListEvents(context.Background(), client, FakeNamespace, EventObject{Kind: "Revision", Name: "foo-00001"}, EventObject{Kind: "Revision", Name: "other"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(client.Actions()), 1)
+
+	events, err = ListEvents(context.Background(), client, FakeNamespace)
+	assert.NilError(t, err)
+	assert.Equal(t, len(events), 0)
+}
+
+func TestWriteEvents(t *testing.T) {
+	buf := &bytes.Buffer{}
+	dw := printers.NewPrefixWriter(buf)
+	event := newTestEvent("e1", "Pod", "foo", "BackOff", time.Now())
+	event.Type = corev1.EventTypeWarning
+	event.Count = 3
+	event.Message = "Back-off restarting failed container\n"
+	WriteEvents(dw, []corev1.Event{*event})
+	assert.NilError(t, dw.Flush())
+	assert.Assert(t, util.ContainsAll(buf.String(), "Events:", "AGE", "REASON", "Warning", "BackOff (x3)", "pod/foo", "Back-off restarting failed container"))
+
+	buf.Reset()
+	WriteEvents(dw, nil)
+	assert.NilError(t, dw.Flush())
+	assert.Assert(t, util.ContainsAll(buf.String(), "Events:", "<none>"))
+}
+
+func TestWriteEventsIfRequested(t *testing.T) {
+	client := fake.NewSimpleClientset(newTestEvent("e1", "Broker", "foo", "Ready", time.Now()))
+	p := &KnParams{NewKubeClient: func() (kubernetes.Interface, error) { return client, nil }}
+	buf := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	AddEventsFlag(cmd.Flags())
+	objects := StaticEventObjects(EventObject{Kind: "Broker", Name: "foo"})
+
+	assert.NilError(t, WriteEventsIfRequested(cmd, p, FakeNamespace, objects))
+	assert.Equal(t, buf.String(), "")
+
+	assert.NilError(t, cmd.Flags().Set("events", "true"))
+	assert.NilError(t, WriteEventsIfRequested(cmd, p, FakeNamespace, objects))
+	assert.Assert(t, strings.HasPrefix(buf.String(), "\nEvents:"))
+	assert.Assert(t, util.ContainsAll(buf.String(), "broker/foo", "Ready"))
+}
+
+func newTestEvent(name string, kind string, objectName string, reason string, timestamp time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: FakeNamespace},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: objectName, Namespace: FakeNamespace},
+		Reason:         reason,
+		Type:           corev1.EventTypeNormal,
+		LastTimestamp:  metav1.NewTime(timestamp),
+	}
+}
@@ -23,9 +23,11 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"knative.dev/eventing/pkg/apis/eventing/v1beta2"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
 )
@@ -38,7 +40,10 @@ var describeExample = `
   kn eventtype describe myeventtype --namespace myproject
 
   # Describe eventtype 'myeventtype' in YAML format
-  kn eventtype describe myeventtype -o yaml`
+  kn eventtype describe myeventtype -o yaml
+
+  # Print the name of the resource the events of eventtype 'myeventtype' are available from
+  kn eventtype describe myeventtype --model -o jsonpath='{.reference.name}'`
 
 // NewEventtypeDescribeCommand represents command to describe the details of an eventtype instance
 func NewEventtypeDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -74,22 +79,36 @@ func NewEventtypeDescribeCommand(p *commands.KnParams) *cobra.Command {
 
 			out := cmd.OutOrStdout()
 
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(eventtype, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, eventtype, func() (runtime.Object, error) {
+					return eventtypeDescription(eventtype), nil
+				})
 			}
 			return describeEventtype(out, eventtype, false)
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	machineReadablePrintFlags.AddFlags(cmd)
+	commands.AddModelFlag(cmd.Flags())
 	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(machineReadablePrintFlags.AllowedFormats(), "|"))
 	return cmd
 }
 
+// eventtypeDescription returns the describe model of the given eventtype
+func eventtypeDescription(eventtype *v1beta2.EventType) *v1alpha1.EventTypeDescription {
+	desc := &v1alpha1.EventTypeDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("EventTypeDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(eventtype.ObjectMeta),
+		Type:       eventtype.Spec.Type,
+		Reference:  eventtype.Spec.Reference,
+		Conditions: eventtype.Status.Conditions,
+	}
+	if eventtype.Spec.Source != nil {
+		desc.Source = eventtype.Spec.Source.String()
+	}
+	return desc
+}
+
 // describeEventtype prints eventtype details to the provided output writer
 func describeEventtype(out io.Writer, eventtype *v1beta2.EventType, printDetails bool) error {
 	var source string
@@ -108,6 +108,20 @@ func TestEventtypeDescribeMachineReadable(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestEventtypeDescribeModel(t *testing.T) {
+	eventingClient := v1beta2.NewMockKnEventingV1beta2Client(t, testNs)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient(testNs)
+
+	eventingRecorder := eventingClient.Recorder()
+	eventingRecorder.GetEventtype(eventtypeName, getEventtype(eventtypeName, testNs), nil)
+
+	out, err := executeEventtypeCommand(eventingClient, dynamicClient, "describe", eventtypeName, "--namespace", testNs, "--model", "-o", "jsonpath={.type} {.source} {.reference.name}")
+	assert.NilError(t, err)
+	assert.Equal(t, out, cetype+" "+testSource+" "+testBroker)
+
+	eventingRecorder.Validate()
+}
+
 func getEventtype(name string, ns string) *eventingv1beta2.EventType {
 	source, _ := apis.ParseURL(testSource)
 	return &eventingv1beta2.EventType{
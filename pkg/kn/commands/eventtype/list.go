@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -27,6 +28,7 @@ import (
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
 	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 )
 
@@ -35,7 +37,10 @@ var listExample = `
   kn eventtype list
 
   # List all eventtypes in JSON output format
-  kn eventtype list -o json`
+  kn eventtype list -o json
+
+  # List all eventtypes and watch for changes until interrupted
+  kn eventtype list --watch`
 
 // NewEventtypeListCommand represents command to list all eventtypes
 func NewEventtypeListCommand(p *commands.KnParams) *cobra.Command {
@@ -57,11 +62,20 @@ func NewEventtypeListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			eventTypeList, err := eventingV1Beta2Client.ListEventtypes(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			eventTypeList, err := eventingV1Beta2Client.ListEventtypes(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(eventTypeList.Items) == 0 {
+			err = listFlags.Filter(eventTypeList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(eventTypeList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No eventtypes found.\n")
 				return nil
 			}
@@ -75,11 +89,17 @@ func NewEventtypeListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return nil
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{eventingv1beta2.SchemeGroupVersion.WithResource("eventtypes")},
+				ListOptions: metav1.ListOptions{ResourceVersion: eventTypeList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &eventingv1beta2.EventType{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
 	listFlags.AddFlags(cmd)
+	listFlags.AddWatchFlag(cmd)
 	return cmd
 }
 
@@ -0,0 +1,343 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// completionFunc completes the value of a flag
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// flagCompletionFuncs returns the value completions for all flags supporting it, by flag name
+func flagCompletionFuncs(p *commands.KnParams) map[string]completionFunc {
+	return map[string]completionFunc{
+		"sink":            sinkCompletionFunc(p),
+		"dl-sink":         sinkCompletionFunc(p),
+		"broker":          resourceCompletionFunc(p, "broker"),
+		"channel":         channelCompletionFunc(p),
+		"traffic":         trafficCompletionFunc(p, true),
+		"tag":             trafficCompletionFunc(p, false),
+		"untag":           untagCompletionFunc(p),
+		"service-account": serviceAccountCompletionFunc(p),
+		"env-from":        envFromCompletionFunc(p),
+		"profile":         profileCompletionFunc,
+		"namespace":       namespaceCompletionFunc(p),
+	}
+}
+
+// RegisterFlagCompletions registers value completions for the flags of the given
+// command and all of its sub-commands
+func RegisterFlagCompletions(cmd *cobra.Command, p *commands.KnParams) {
+	registerFlagCompletions(cmd, flagCompletionFuncs(p))
+}
+
+func registerFlagCompletions(cmd *cobra.Command, funcs map[string]completionFunc) {
+	for name, fn := range funcs {
+		if cmd.Flags().Lookup(name) != nil {
+			// Flags shared between commands are registered only once
+			_ = cmd.RegisterFlagCompletionFunc(name, fn)
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		registerFlagCompletions(sub, funcs)
+	}
+}
+
+// resourceCompletionFunc completes with the names of resources of the given kind
+func resourceCompletionFunc(p *commands.KnParams, resource string) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return commands.CompleteResourceNames(p, cmd, resource, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// sinkCompletionFunc completes the prefixes of the sink mappings and Knative services, which can
+// be given without a prefix. After a prefix, it completes the names of the mapped resources.
+func sinkCompletionFunc(p *commands.KnParams) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		mappings := sinkMappings()
+		prefix, name, found := strings.Cut(toComplete, ":")
+		if !found {
+			var suggestions []string
+			for prefix := range mappings {
+				if strings.HasPrefix(prefix, toComplete) {
+					suggestions = append(suggestions, prefix+":")
+				}
+			}
+			sort.Strings(suggestions)
+			suggestions = append(suggestions, commands.CompleteResourceNames(p, cmd, "service", toComplete)...)
+			return suggestions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+		}
+		gvr, ok := mappings[prefix]
+		if !ok || strings.Contains(name, ":") {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := listResourceNames(p, cmd, gvr, name)
+		for i := range names {
+			names[i] = prefix + ":" + names[i]
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// sinkMappings returns the built-in sink mappings merged with the configured ones
+func sinkMappings() map[string]schema.GroupVersionResource {
+	mappings := make(map[string]schema.GroupVersionResource, len(defaultSinkMappings))
+	for prefix, gvr := range defaultSinkMappings {
+		mappings[prefix] = gvr
+	}
+	for _, m := range config.GlobalConfig.SinkMappings() {
+		mappings[m.Prefix] = schema.GroupVersionResource{Group: m.Group, Version: m.Version, Resource: m.Resource}
+	}
+	return mappings
+}
+
+// listResourceNames lists the names of arbitrary resources with the dynamic client
+func listResourceNames(p *commands.KnParams, cmd *cobra.Command, gvr schema.GroupVersionResource, toComplete string) []string {
+	namespace, err := p.GetNamespace(cmd)
+	if err != nil || p.NewDynamicClient == nil {
+		return nil
+	}
+	client, err := p.NewDynamicClient(namespace)
+	if err != nil {
+		return nil
+	}
+	if gvr.Group == "core" {
+		gvr.Group = ""
+	}
+	list, err := client.RawClient().Resource(gvr).Namespace(namespace).List(cmd.Context(), metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, item := range list.Items {
+		if strings.HasPrefix(item.GetName(), toComplete) {
+			names = append(names, item.GetName())
+		}
+	}
+	return names
+}
+
+// channelCompletionFunc completes channel names, optionally after a channel type alias
+func channelCompletionFunc(p *commands.KnParams) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		prefix, name, found := strings.Cut(toComplete, ":")
+		if !found {
+			return commands.CompleteResourceNames(p, cmd, "channel", toComplete), cobra.ShellCompDirectiveNoFileComp
+		}
+		names := commands.CompleteResourceNames(p, cmd, "channel", name)
+		for i := range names {
+			names[i] = prefix + ":" + names[i]
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// trafficCompletionFunc completes the left hand side of --traffic and --tag with the revisions
+// of the service given as argument. For --traffic, existing tags can be used, too.
+func trafficCompletionFunc(p *commands.KnParams, withTags bool) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 || strings.Contains(toComplete, "=") {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client := servingClient(p, cmd)
+		if client == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		candidates := []string{"@latest"}
+		revisions, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(args[0]))
+		if err == nil {
+			for _, revision := range revisions.Items {
+				candidates = append(candidates, revision.Name)
+			}
+		}
+		if withTags {
+			candidates = append(candidates, serviceTags(cmd, client, args[0])...)
+		}
+		var suggestions []string
+		for _, candidate := range candidates {
+			if strings.HasPrefix(candidate, toComplete) {
+				suggestions = append(suggestions, candidate+"=")
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// untagCompletionFunc completes with the tags of the service given as argument
+func untagCompletionFunc(p *commands.KnParams) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client := servingClient(p, cmd)
+		if client == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var suggestions []string
+		for _, tag := range serviceTags(cmd, client, args[0]) {
+			if strings.HasPrefix(tag, toComplete) {
+				suggestions = append(suggestions, tag)
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func servingClient(p *commands.KnParams, cmd *cobra.Command) clientservingv1.KnServingClient {
+	namespace, err := p.GetNamespace(cmd)
+	if err != nil {
+		return nil
+	}
+	client, err := p.NewServingClient(namespace)
+	if err != nil {
+		return nil
+	}
+	return client
+}
+
+func serviceTags(cmd *cobra.Command, client clientservingv1.KnServingClient, name string) []string {
+	service, err := client.GetService(cmd.Context(), name)
+	if err != nil {
+		return nil
+	}
+	var tags []string
+	for _, target := range service.Spec.Traffic {
+		if target.Tag != "" {
+			tags = append(tags, target.Tag)
+		}
+	}
+	return tags
+}
+
+// serviceAccountCompletionFunc completes with the service accounts of the namespace
+func serviceAccountCompletionFunc(p *commands.KnParams) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		namespace, err := p.GetNamespace(cmd)
+		if err != nil || p.NewKubeClient == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client, err := p.NewKubeClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		list, err := client.CoreV1().ServiceAccounts(namespace).List(cmd.Context(), metav1.ListOptions{})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var suggestions []string
+		for _, sa := range list.Items {
+			if strings.HasPrefix(sa.Name, toComplete) {
+				suggestions = append(suggestions, sa.Name)
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// envFromCompletionFunc completes the "cm:" and "secret:" prefixes and then the names
+// of the ConfigMaps or Secrets of the namespace
+func envFromCompletionFunc(p *commands.KnParams) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		prefix, name, found := strings.Cut(toComplete, ":")
+		if !found {
+			var suggestions []string
+			for _, prefix := range []string{"cm:", "config-map:", "secret:"} {
+				if strings.HasPrefix(prefix, toComplete) {
+					suggestions = append(suggestions, prefix)
+				}
+			}
+			return suggestions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+		}
+		namespace, err := p.GetNamespace(cmd)
+		if err != nil || p.NewKubeClient == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client, err := p.NewKubeClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var names []string
+		switch prefix {
+		case "cm", "config-map":
+			list, err := client.CoreV1().ConfigMaps(namespace).List(cmd.Context(), metav1.ListOptions{})
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			for _, cm := range list.Items {
+				names = append(names, cm.Name)
+			}
+		case "secret":
+			list, err := client.CoreV1().Secrets(namespace).List(cmd.Context(), metav1.ListOptions{})
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			for _, secret := range list.Items {
+				names = append(names, secret.Name)
+			}
+		}
+		var suggestions []string
+		for _, n := range names {
+			if strings.HasPrefix(n, name) {
+				suggestions = append(suggestions, prefix+":"+n)
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// profileCompletionFunc completes with the names of the configured and built-in profiles
+func profileCompletionFunc(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var suggestions []string
+	for _, name := range config.GlobalConfig.ProfileNames() {
+		if strings.HasPrefix(name, toComplete) {
+			suggestions = append(suggestions, name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// namespaceCompletionFunc completes with the namespaces of the cluster
+func namespaceCompletionFunc(p *commands.KnParams) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if p.NewKubeClient == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		client, err := p.NewKubeClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		list, err := client.CoreV1().Namespaces().List(cmd.Context(), metav1.ListOptions{})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var suggestions []string
+		for _, ns := range list.Items {
+			if strings.HasPrefix(ns.Name, toComplete) {
+				suggestions = append(suggestions, ns.Name)
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
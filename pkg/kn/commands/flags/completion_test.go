@@ -0,0 +1,160 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func newCompletionCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "update"}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().Set("namespace", "default")
+	return cmd
+}
+
+func TestSinkCompletion(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListServices(mock.Any(), &servingv1.ServiceList{Items: []servingv1.Service{
+		{ObjectMeta: metav1.ObjectMeta{Name: "bar"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "baz"}},
+	}}, nil)
+
+	p := &commands.KnParams{
+		NewServingClient: func(namespace string) (clientservingv1.KnServingClient, error) { return client, nil },
+		NewDynamicClient: func(namespace string) (clientdynamic.KnDynamicClient, error) {
+			return dynamicfake.CreateFakeKnDynamicClient("default",
+				&eventingv1.Broker{TypeMeta: metav1.TypeMeta{APIVersion: "eventing.knative.dev/v1", Kind: "Broker"}, ObjectMeta: metav1.ObjectMeta{Name: "nest", Namespace: "default"}},
+				&eventingv1.Broker{TypeMeta: metav1.TypeMeta{APIVersion: "eventing.knative.dev/v1", Kind: "Broker"}, ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+			), nil
+		},
+	}
+	cmd := newCompletionCommand()
+	complete := sinkCompletionFunc(p)
+
+	suggestions, directive := complete(cmd, nil, "b")
+	assert.DeepEqual(t, suggestions, []string{"broker:", "bar", "baz"})
+	assert.Equal(t, directive&cobra.ShellCompDirectiveNoSpace, cobra.ShellCompDirectiveNoSpace)
+
+	suggestions, _ = complete(cmd, nil, "broker:n")
+	assert.DeepEqual(t, suggestions, []string{"broker:nest"})
+
+	suggestions, _ = complete(cmd, nil, "unknown:n")
+	assert.Assert(t, len(suggestions) == 0)
+	r.Validate()
+}
+
+func TestTrafficCompletion(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+	service.Spec.Traffic = []servingv1.TrafficTarget{{Tag: "blue", RevisionName: "foo-00001"}, {Tag: "green"}}
+	revisions := &servingv1.RevisionList{Items: []servingv1.Revision{
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo-00001"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo-00002"}},
+	}}
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("foo", service, nil)
+
+	p := &commands.KnParams{
+		NewServingClient: func(namespace string) (clientservingv1.KnServingClient, error) { return client, nil },
+	}
+	cmd := newCompletionCommand()
+
+	suggestions, directive := trafficCompletionFunc(p, true)(cmd, []string{"foo"}, "")
+	assert.DeepEqual(t, suggestions, []string{"@latest=", "foo-00001=", "foo-00002=", "blue=", "green="})
+	assert.Equal(t, directive&cobra.ShellCompDirectiveNoSpace, cobra.ShellCompDirectiveNoSpace)
+
+	suggestions, _ = trafficCompletionFunc(p, false)(cmd, []string{"foo"}, "foo-")
+	assert.DeepEqual(t, suggestions, []string{"foo-00001=", "foo-00002="})
+
+	suggestions, _ = untagCompletionFunc(p)(cmd, []string{"foo"}, "g")
+	assert.DeepEqual(t, suggestions, []string{"green"})
+
+	// Nothing to complete without service or after the '='
+	suggestions, _ = trafficCompletionFunc(p, true)(cmd, nil, "")
+	assert.Assert(t, len(suggestions) == 0)
+	suggestions, _ = trafficCompletionFunc(p, true)(cmd, []string{"foo"}, "blue=")
+	assert.Assert(t, len(suggestions) == 0)
+	r.Validate()
+}
+
+func TestKubeResourceCompletion(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "demo"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "builder", Namespace: "default"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"}},
+	)
+	p := &commands.KnParams{NewKubeClient: func() (kubernetes.Interface, error) { return kubeClient, nil }}
+	cmd := newCompletionCommand()
+
+	suggestions, _ := namespaceCompletionFunc(p)(cmd, nil, "de")
+	assert.DeepEqual(t, suggestions, []string{"default", "demo"})
+
+	suggestions, _ = serviceAccountCompletionFunc(p)(cmd, nil, "")
+	assert.DeepEqual(t, suggestions, []string{"builder"})
+
+	suggestions, directive := envFromCompletionFunc(p)(cmd, nil, "")
+	assert.DeepEqual(t, suggestions, []string{"cm:", "config-map:", "secret:"})
+	assert.Equal(t, directive&cobra.ShellCompDirectiveNoSpace, cobra.ShellCompDirectiveNoSpace)
+
+	suggestions, _ = envFromCompletionFunc(p)(cmd, nil, "cm:s")
+	assert.DeepEqual(t, suggestions, []string{"cm:settings"})
+
+	suggestions, _ = envFromCompletionFunc(p)(cmd, nil, "secret:")
+	assert.DeepEqual(t, suggestions, []string{"secret:credentials"})
+}
+
+func TestRegisterFlagCompletions(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{TestProfiles: map[string]config.Profile{"istio": {}, "knative": {}, "kourier": {}}}
+
+	root := &cobra.Command{Use: "kn"}
+	sub := &cobra.Command{Use: "create", Run: func(cmd *cobra.Command, args []string) {}}
+	sub.Flags().String("profile", "", "")
+	root.AddCommand(sub)
+	RegisterFlagCompletions(root, &commands.KnParams{})
+
+	out := &bytes.Buffer{}
+	root.SetOut(out)
+	root.SetArgs([]string{cobra.ShellCompRequestCmd, "create", "--profile", "k"})
+	assert.NilError(t, root.Execute())
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.DeepEqual(t, lines[:2], []string{"knative", "kourier"})
+}
@@ -15,10 +15,18 @@
 package flags
 
 import (
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"knative.dev/client/pkg/kn/commands"
@@ -32,6 +40,11 @@ type ListPrintFlags struct {
 	GenericPrintFlags  *genericclioptions.PrintFlags
 	HumanReadableFlags *commands.HumanPrintFlags
 	PrinterHandler     func(h hprinters.PrintHandler)
+	// Watch is set if the command should watch for changes after listing
+	Watch bool
+	// Label and field selectors to filter the list with
+	LabelSelector string
+	FieldSelector string
 }
 
 // AllowedFormats is the list of formats in which data can be displayed
@@ -45,6 +58,7 @@ func (f *ListPrintFlags) AllowedFormats() []string {
 // returning a printer based on current flag values.
 func (f *ListPrintFlags) ToPrinter() (hprinters.ResourcePrinter, error) {
 	// if there are flags specified for generic printing
+	f.HumanReadableFlags.Wide = f.isWide()
 	if f.GenericPrintFlags.OutputFlagSpecified() {
 		p, err := f.GenericPrintFlags.ToPrinter()
 		if err != nil {
@@ -72,6 +86,11 @@ func (f *ListPrintFlags) Print(obj runtime.Object, w io.Writer) error {
 		if err != nil {
 			return err
 		}
+		if f.HumanReadableFlags.SortBy != "" {
+			if err := hprinters.SortUnstructured(unstructuredList.Items, f.HumanReadableFlags.SortBy); err != nil {
+				return err
+			}
+		}
 		return printer.PrintObj(unstructuredList, w)
 	}
 
@@ -81,8 +100,114 @@ func (f *ListPrintFlags) Print(obj runtime.Object, w io.Writer) error {
 // AddFlags receives a *cobra.Command reference and binds
 // flags related to humanreadable and template printing.
 func (f *ListPrintFlags) AddFlags(cmd *cobra.Command) {
+	// "-o wide" is human readable output with extra columns
+	if f.GenericPrintFlags.OutputFlagSpecified == nil {
+		f.GenericPrintFlags.OutputFlagSpecified = func() bool {
+			return cmd.Flag("output").Changed && !f.isWide()
+		}
+	}
 	f.GenericPrintFlags.AddFlags(cmd)
 	f.HumanReadableFlags.AddFlags(cmd)
+	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(f.GenericPrintFlags.AllowedFormats(), "wide"), "|"))
+	cmd.Flags().StringVarP(&f.LabelSelector, "selector", "l", "", "Label selector to filter the list with, e.g. 'app=web,tier!=cache'.")
+	cmd.Flags().StringVar(&f.FieldSelector, "field-selector", "", "Field selector to filter the list with, e.g. 'metadata.name=web'. Any field of the resources can be used, like 'spec.broker=default'.")
+}
+
+// isWide returns true if "-o wide" is given
+func (f *ListPrintFlags) isWide() bool {
+	return f.GenericPrintFlags.OutputFormat != nil && *f.GenericPrintFlags.OutputFormat == "wide"
+}
+
+// serverSideFields are the fields the API server supports in field selectors for all resources
+var serverSideFields = []string{"metadata.name", "metadata.namespace"}
+
+// ServerSelectors returns the selectors to pass to the API server when listing: the label
+// selector and the part of the field selector with the fields the server supports, which
+// are metadata.name, metadata.namespace and the given fields. Filter has to be applied on
+// the result for the other fields.
+func (f *ListPrintFlags) ServerSelectors(supportedFields ...string) (labelSelector string, fieldSelector string, err error) {
+	parsedLabels, err := labels.Parse(f.LabelSelector)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid --selector '%s': %w", f.LabelSelector, err)
+	}
+	parsedFields, err := fields.ParseSelector(f.FieldSelector)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid --field-selector '%s': %w", f.FieldSelector, err)
+	}
+	supported := sets.NewString(serverSideFields...).Insert(supportedFields...)
+	var selectors []fields.Selector
+	for _, requirement := range parsedFields.Requirements() {
+		if !supported.Has(requirement.Field) {
+			continue
+		}
+		if requirement.Operator == selection.NotEquals {
+			selectors = append(selectors, fields.OneTermNotEqualSelector(requirement.Field, requirement.Value))
+		} else {
+			selectors = append(selectors, fields.OneTermEqualSelector(requirement.Field, requirement.Value))
+		}
+	}
+	if len(selectors) > 0 {
+		fieldSelector = fields.AndSelectors(selectors...).String()
+	}
+	return parsedLabels.String(), fieldSelector, nil
+}
+
+// Filter removes all items from a list which don't match the label and field selectors.
+// The selectors are applied on the client, so that any field of the resources can be used
+// and results of servers ignoring the selectors given by ServerSelectors are correct, too.
+func (f *ListPrintFlags) Filter(list runtime.Object) error {
+	if f.LabelSelector == "" && f.FieldSelector == "" {
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	filtered := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		matches, err := f.Matches(item)
+		if err != nil {
+			return err
+		}
+		if matches {
+			filtered = append(filtered, item)
+		}
+	}
+	return meta.SetList(list, filtered)
+}
+
+// Matches returns true if the given object matches the label and field selectors
+func (f *ListPrintFlags) Matches(obj runtime.Object) (bool, error) {
+	labelSelector, err := labels.Parse(f.LabelSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid --selector '%s': %w", f.LabelSelector, err)
+	}
+	fieldSelector, err := fields.ParseSelector(f.FieldSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid --field-selector '%s': %w", f.FieldSelector, err)
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	if !labelSelector.Matches(labels.Set(accessor.GetLabels())) {
+		return false, nil
+	}
+	if fieldSelector.Empty() {
+		return true, nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false, err
+	}
+	objectFields := fields.Set{}
+	for _, requirement := range fieldSelector.Requirements() {
+		value, found, _ := unstructured.NestedFieldNoCopy(content, strings.Split(requirement.Field, ".")...)
+		if found && value != nil {
+			objectFields[requirement.Field] = fmt.Sprint(value)
+		}
+	}
+	return fieldSelector.Matches(objectFields), nil
 }
 
 // NewListFlags returns flags associated with humanreadable,
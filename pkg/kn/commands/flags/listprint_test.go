@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -58,7 +59,7 @@ var (
 func TestListPrintFlagsFormats(t *testing.T) {
 	flags := NewListPrintFlags(nil)
 	formats := flags.AllowedFormats()
-	expected := []string{"json", "yaml", "name", "go-template", "go-template-file", "template", "templatefile", "jsonpath", "jsonpath-as-json", "jsonpath-file", "no-headers"}
+	expected := []string{"json", "yaml", "name", "go-template", "go-template-file", "template", "templatefile", "jsonpath", "jsonpath-as-json", "jsonpath-file", "no-headers", "wide"}
 	assert.DeepEqual(t, formats, expected)
 }
 
@@ -125,3 +126,101 @@ func TestEnsureNamespaces(t *testing.T) {
 	assert.Assert(t, util.ContainsAll(out.String(), "default"))
 	assert.NilError(t, err)
 }
+
+func TestListPrintFlagsFilter(t *testing.T) {
+	newService := func(name string, labels map[string]string, image string) servingv1.Service {
+		service := servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+		service.Spec.Template.Spec.Containers = []corev1.Container{{Image: image}}
+		return service
+	}
+	newList := func() *servingv1.ServiceList {
+		return &servingv1.ServiceList{Items: []servingv1.Service{
+			newService("web", map[string]string{"app": "shop", "tier": "frontend"}, "gcr.io/web"),
+			newService("db", map[string]string{"app": "shop", "tier": "backend"}, "gcr.io/db"),
+			newService("other", nil, "gcr.io/web"),
+		}}
+	}
+	names := func(list *servingv1.ServiceList) []string {
+		var result []string
+		for _, item := range list.Items {
+			result = append(result, item.Name)
+		}
+		return result
+	}
+
+	for _, tc := range []struct {
+		args     []string
+		expected []string
+	}{
+		{nil, []string{"web", "db", "other"}},
+		{[]string{"-l", "app=shop"}, []string{"web", "db"}},
+		{[]string{"--selector", "app=shop,tier!=backend"}, []string{"web"}},
+		{[]string{"--field-selector", "metadata.name=other"}, []string{"other"}},
+		{[]string{"--field-selector", "metadata.name!=other", "-l", "tier"}, []string{"web", "db"}},
+		{[]string{"--field-selector", "spec.template.spec.containers.image=gcr.io/web"}, nil},
+	} {
+		flags := NewListPrintFlags(func(h hprinters.PrintHandler) {})
+		cmd := &cobra.Command{}
+		flags.AddFlags(cmd)
+		assert.NilError(t, cmd.ParseFlags(tc.args))
+		list := newList()
+		assert.NilError(t, flags.Filter(list))
+		assert.DeepEqual(t, names(list), tc.expected)
+	}
+
+	flags := NewListPrintFlags(func(h hprinters.PrintHandler) {})
+	flags.LabelSelector = "app in (shop"
+	assert.ErrorContains(t, flags.Filter(newList()), "invalid --selector")
+}
+
+func TestListPrintFlagsServerSelectors(t *testing.T) {
+	for _, tc := range []struct {
+		labelSelector         string
+		fieldSelector         string
+		supportedFields       []string
+		expectedLabelSelector string
+		expectedFieldSelector string
+	}{
+		{"", "", nil, "", ""},
+		{"app=shop,tier!=backend", "", nil, "app=shop,tier!=backend", ""},
+		{"", "metadata.name=web,spec.broker=default", nil, "", "metadata.name=web"},
+		{"tier", "metadata.namespace!=kube-system,metadata.name==web", nil, "tier", "metadata.name=web,metadata.namespace!=kube-system"},
+		{"", "spec.broker=default", nil, "", ""},
+		{"", "type=Opaque,data.key=value", []string{"type"}, "", "type=Opaque"},
+	} {
+		flags := NewListPrintFlags(func(h hprinters.PrintHandler) {})
+		flags.LabelSelector = tc.labelSelector
+		flags.FieldSelector = tc.fieldSelector
+		labelSelector, fieldSelector, err := flags.ServerSelectors(tc.supportedFields...)
+		assert.NilError(t, err)
+		assert.Equal(t, labelSelector, tc.expectedLabelSelector)
+		assert.Equal(t, fieldSelector, tc.expectedFieldSelector)
+	}
+
+	flags := NewListPrintFlags(func(h hprinters.PrintHandler) {})
+	flags.FieldSelector = "metadata.name"
+	_, _, err := flags.ServerSelectors()
+	assert.ErrorContains(t, err, "invalid --field-selector")
+}
+
+func TestListPrintFlagsWide(t *testing.T) {
+	wideColumnDefs := append(columnDefs, metav1beta1.TableColumnDefinition{Name: "Extra", Priority: hprinters.WideColumnPriority})
+	flags := NewListPrintFlags(func(h hprinters.PrintHandler) {
+		h.TableHandler(wideColumnDefs, func(obj *servingv1.Service, opts printers.PrintOptions) ([]metav1beta1.TableRow, error) {
+			rows, err := validPrintFunc(obj, opts)
+			if opts.Wide {
+				rows[0].Cells = append(rows[0].Cells, "extra-cell")
+			}
+			return rows, err
+		})
+	})
+	cmd := &cobra.Command{}
+	flags.AddFlags(cmd)
+	assert.NilError(t, cmd.ParseFlags([]string{"-o", "wide"}))
+	assert.Assert(t, !flags.GenericPrintFlags.OutputFlagSpecified())
+	assert.Assert(t, util.ContainsAll(cmd.Flag("output").Usage, "json", "wide"))
+
+	var out bytes.Buffer
+	assert.NilError(t, flags.Print(myksvc, &out))
+	assert.Assert(t, util.ContainsAll(out.String(), "EXTRA", "extra-cell", "myksvc"))
+}
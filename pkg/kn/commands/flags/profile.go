@@ -0,0 +1,96 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"context"
+	"fmt"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/config"
+)
+
+// ProfileDelivery returns the event delivery settings of the profile with the given name,
+// or nil if the profile has no delivery settings. The dead letter sink of the profile
+// is resolved like the sink given with '--dl-sink'.
+func ProfileDelivery(ctx context.Context, profileName string, knclient clientdynamic.KnDynamicClient, namespace string) (*eventingduckv1.DeliverySpec, error) {
+	profile := config.GlobalConfig.Profile(profileName)
+	if profile.IsEmpty() {
+		return nil, fmt.Errorf("profile %s doesn't exist", profileName)
+	}
+	settings := profile.Delivery
+	if settings.IsEmpty() {
+		return nil, nil
+	}
+	delivery := &eventingduckv1.DeliverySpec{}
+	if settings.DeadLetterSink != "" {
+		sinkFlags := SinkFlags{Sink: settings.DeadLetterSink}
+		destination, err := sinkFlags.ResolveSink(ctx, knclient, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dead letter sink in profile '%s': %w", profileName, err)
+		}
+		delivery.DeadLetterSink = destination
+	}
+	if settings.Retry != nil {
+		retry := *settings.Retry
+		delivery.Retry = &retry
+	}
+	if settings.Timeout != "" {
+		timeout := settings.Timeout
+		delivery.Timeout = &timeout
+	}
+	if settings.BackoffPolicy != "" {
+		policy := eventingduckv1.BackoffPolicyType(settings.BackoffPolicy)
+		delivery.BackoffPolicy = &policy
+	}
+	if settings.BackoffDelay != "" {
+		delay := settings.BackoffDelay
+		delivery.BackoffDelay = &delay
+	}
+	return delivery, nil
+}
+
+// MergeDelivery returns the delivery settings with the settings of defaults for
+// the settings which are not set
+func MergeDelivery(delivery, defaults *eventingduckv1.DeliverySpec) *eventingduckv1.DeliverySpec {
+	if defaults == nil {
+		return delivery
+	}
+	if delivery == nil {
+		return defaults.DeepCopy()
+	}
+	merged := delivery.DeepCopy()
+	if merged.DeadLetterSink == nil {
+		merged.DeadLetterSink = defaults.DeadLetterSink
+	}
+	if merged.Retry == nil {
+		merged.Retry = defaults.Retry
+	}
+	if merged.Timeout == nil {
+		merged.Timeout = defaults.Timeout
+	}
+	if merged.BackoffPolicy == nil {
+		merged.BackoffPolicy = defaults.BackoffPolicy
+	}
+	if merged.BackoffDelay == nil {
+		merged.BackoffDelay = defaults.BackoffDelay
+	}
+	if merged.RetryAfterMax == nil {
+		merged.RetryAfterMax = defaults.RetryAfterMax
+	}
+	return merged
+}
@@ -0,0 +1,201 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
+)
+
+// WatchOptions describe the resources to watch after the initial list has been printed
+type WatchOptions struct {
+	// Namespace to watch, empty for all namespaces
+	Namespace string
+	// Resources to watch, changes of all of them are printed in the order they arrive
+	Resources []schema.GroupVersionResource
+	// Label and field selectors restricting the watched resources. ResourceVersion
+	// should be the version of the initial list so that only later changes are printed.
+	// If it is empty, the current version of each resource is looked up when watching starts.
+	ListOptions metav1.ListOptions
+	// Convert turns a changed resource into the object the human readable printer
+	// knows how to print. Defaults to printing the unstructured object as is.
+	Convert func(obj *unstructured.Unstructured) (runtime.Object, error)
+}
+
+// AddWatchFlag adds the --watch flag to a list command
+func (f *ListPrintFlags) AddWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.Watch, "watch", "w", false, "After listing, watch for changes and print each changed resource until interrupted.")
+}
+
+// WatchIfRequested watches for changes after the initial list has been printed, if --watch is given
+func (f *ListPrintFlags) WatchIfRequested(cmd *cobra.Command, p *commands.KnParams, opts WatchOptions) error {
+	if !f.Watch {
+		return nil
+	}
+	dynamicClient, err := p.NewDynamicClient(opts.Namespace)
+	if err != nil {
+		return err
+	}
+	// Let the server do the filtering it supports, the rest is done when printing
+	labelSelector, fieldSelector, err := f.ServerSelectors()
+	if err != nil {
+		return err
+	}
+	util.WithSelectors(labelSelector, fieldSelector)(&opts.ListOptions)
+	return f.WatchChanges(cmd.Context(), dynamicClient.RawClient(), cmd.OutOrStdout(), opts)
+}
+
+// WatchChanges watches the given resources and prints each changed resource as it comes in,
+// until the context is cancelled or all watches are closed by the server. Resources which
+// can't be watched are polled instead.
+func (f *ListPrintFlags) WatchChanges(ctx context.Context, client dynamic.Interface, w io.Writer, opts WatchOptions) error {
+	watchers := make([]watch.Interface, 0, len(opts.Resources))
+	for _, gvr := range opts.Resources {
+		resource := client.Resource(gvr).Namespace(opts.Namespace)
+		listFunc := func(ctx context.Context, listOpts metav1.ListOptions) ([]runtime.Object, error) {
+			list, err := resource.List(ctx, listOpts)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]runtime.Object, 0, len(list.Items))
+			for i := range list.Items {
+				items = append(items, &list.Items[i])
+			}
+			return items, nil
+		}
+		listOpts := opts.ListOptions
+		if listOpts.ResourceVersion == "" {
+			current, err := resource.List(ctx, metav1.ListOptions{LabelSelector: listOpts.LabelSelector, FieldSelector: listOpts.FieldSelector, Limit: 1})
+			if err == nil {
+				listOpts.ResourceVersion = current.GetResourceVersion()
+			}
+		}
+		watcher, err := wait.NewListWatcher(ctx, resource.Watch, listFunc, listOpts)
+		if err != nil {
+			return err
+		}
+		watchers = append(watchers, watcher)
+	}
+	events := mergeWatchEvents(ctx, watchers)
+	defer func() {
+		for _, watcher := range watchers {
+			watcher.Stop()
+		}
+		// Drain until the forwarders have noticed that the watches are stopped
+		for range events {
+		}
+	}()
+	return f.PrintWatchEvents(ctx, events, w, opts.Convert)
+}
+
+// PrintWatchEvents prints the object of every event received until the context is
+// cancelled or the channel is closed. Headers are omitted as they have already been
+// printed with the initial list. An error event, e.g. when the watched resource version
+// is too old, ends the watch with an error.
+func (f *ListPrintFlags) PrintWatchEvents(ctx context.Context, events <-chan watch.Event, w io.Writer, convert func(obj *unstructured.Unstructured) (runtime.Object, error)) error {
+	noHeaders := f.HumanReadableFlags.NoHeaders
+	f.HumanReadableFlags.NoHeaders = true
+	defer func() { f.HumanReadableFlags.NoHeaders = noHeaders }()
+	printer, err := f.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return fmt.Errorf("cannot watch for changes: %w", apierrors.FromObject(event.Object))
+			}
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok || event.Type == watch.Bookmark {
+				continue
+			}
+			matches, err := f.Matches(obj)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				continue
+			}
+			var toPrint runtime.Object = obj
+			if convert != nil && !f.GenericPrintFlags.OutputFlagSpecified() {
+				toPrint, err = convert(obj)
+				if err != nil {
+					return err
+				}
+			}
+			if err := printer.PrintObj(toPrint, w); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ConvertTo returns a conversion function for WatchOptions which converts a
+// changed resource to the typed object returned by newObject
+func ConvertTo(newObject func() runtime.Object) func(obj *unstructured.Unstructured) (runtime.Object, error) {
+	return func(obj *unstructured.Unstructured) (runtime.Object, error) {
+		typed := newObject()
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+			return nil, err
+		}
+		return typed, nil
+	}
+}
+
+// mergeWatchEvents forwards the events of all watchers to a single channel,
+// which is closed when all watchers have been stopped
+func mergeWatchEvents(ctx context.Context, watchers []watch.Interface) <-chan watch.Event {
+	merged := make(chan watch.Event)
+	var wg sync.WaitGroup
+	for _, watcher := range watchers {
+		wg.Add(1)
+		go func(events <-chan watch.Event) {
+			defer wg.Done()
+			for event := range events {
+				select {
+				case merged <- event:
+				case <-ctx.Done():
+				}
+			}
+		}(watcher.ResultChan())
+	}
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+	return merged
+}
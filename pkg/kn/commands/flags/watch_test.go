@@ -0,0 +1,141 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
+)
+
+func newWatchTestFlags(t *testing.T, args ...string) *ListPrintFlags {
+	flags := NewListPrintFlags(func(h hprinters.PrintHandler) {
+		h.TableHandler(columnDefs, validPrintFunc)
+	})
+	cmd := &cobra.Command{}
+	flags.AddFlags(cmd)
+	flags.AddWatchFlag(cmd)
+	assert.NilError(t, cmd.ParseFlags(args))
+	return flags
+}
+
+func newUnstructuredService(t *testing.T, name string) *unstructured.Unstructured {
+	service := myksvc.DeepCopy()
+	service.Name = name
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(service)
+	assert.NilError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestAddWatchFlag(t *testing.T) {
+	flags := newWatchTestFlags(t, "-w")
+	assert.Assert(t, flags.Watch)
+}
+
+func TestPrintWatchEvents(t *testing.T) {
+	events := make(chan watch.Event, 4)
+	events <- watch.Event{Type: watch.Added, Object: newUnstructuredService(t, "foo")}
+	events <- watch.Event{Type: watch.Bookmark, Object: newUnstructuredService(t, "baz")}
+	events <- watch.Event{Type: watch.Modified, Object: newUnstructuredService(t, "bar")}
+	close(events)
+
+	flags := newWatchTestFlags(t)
+	buf := &bytes.Buffer{}
+	err := flags.PrintWatchEvents(context.Background(), events, buf, ConvertTo(func() runtime.Object { return &servingv1.Service{} }))
+	assert.NilError(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 2)
+	assert.Assert(t, util.ContainsAll(lines[0], "foo"))
+	assert.Assert(t, util.ContainsNone(lines[0], "NAME"))
+	assert.Assert(t, util.ContainsAll(lines[1], "bar"))
+	// Headers are restored for later printing
+	assert.Assert(t, !flags.HumanReadableFlags.NoHeaders)
+}
+
+func TestPrintWatchEventsError(t *testing.T) {
+	events := make(chan watch.Event, 2)
+	events <- watch.Event{Type: watch.Added, Object: newUnstructuredService(t, "foo")}
+	events <- watch.Event{Type: watch.Error, Object: &metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    410,
+		Reason:  metav1.StatusReasonGone,
+		Message: "too old resource version: 1 (2)",
+	}}
+
+	flags := newWatchTestFlags(t)
+	buf := &bytes.Buffer{}
+	err := flags.PrintWatchEvents(context.Background(), events, buf, ConvertTo(func() runtime.Object { return &servingv1.Service{} }))
+	assert.ErrorContains(t, err, "too old resource version")
+	assert.Assert(t, apierrors.IsGone(err))
+	assert.Assert(t, util.ContainsAll(buf.String(), "foo"))
+}
+
+func TestPrintWatchEventsMachineReadable(t *testing.T) {
+	events := make(chan watch.Event, 1)
+	events <- watch.Event{Type: watch.Added, Object: newUnstructuredService(t, "foo")}
+	close(events)
+
+	flags := newWatchTestFlags(t, "-o", "json")
+	buf := &bytes.Buffer{}
+	err := flags.PrintWatchEvents(context.Background(), events, buf, nil)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(buf.String(), `"kind": "Service"`, `"name": "foo"`))
+}
+
+func TestWatchChanges(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, servingv1.AddToScheme(scheme))
+	client := dynamicfake.NewSimpleDynamicClient(scheme)
+
+	watcher := watch.NewFake()
+	var restrictions clienttesting.WatchRestrictions
+	client.PrependWatchReactor("services", func(a clienttesting.Action) (bool, watch.Interface, error) {
+		restrictions = a.(clienttesting.WatchAction).GetWatchRestrictions()
+		return true, watcher, nil
+	})
+	go func() {
+		watcher.Add(newUnstructuredService(t, "foo"))
+		watcher.Stop()
+	}()
+
+	flags := newWatchTestFlags(t)
+	buf := &bytes.Buffer{}
+	err := flags.WatchChanges(context.Background(), client, buf, WatchOptions{
+		Namespace:   "default",
+		Resources:   []schema.GroupVersionResource{servingv1.SchemeGroupVersion.WithResource("services")},
+		ListOptions: metav1.ListOptions{LabelSelector: "app=foo", ResourceVersion: "3"},
+		Convert:     ConvertTo(func() runtime.Object { return &servingv1.Service{} }),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, restrictions.ResourceVersion, "3")
+	assert.Equal(t, restrictions.Labels.String(), "app=foo")
+	assert.Assert(t, util.ContainsAll(buf.String(), "foo"))
+}
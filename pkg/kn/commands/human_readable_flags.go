@@ -33,19 +33,27 @@ import (
 type HumanPrintFlags struct {
 	WithNamespace bool
 	NoHeaders     bool
-	//TODO: Add more flags as required
+	// Wide is set for "-o wide" and adds extra columns
+	Wide    bool
+	SortBy  string
+	Columns []string
 }
 
 // AllowedFormats returns more customized formating options
 func (f *HumanPrintFlags) AllowedFormats() []string {
-	// TODO: Add more formats eg: wide
-	return []string{"no-headers"}
+	return []string{"no-headers", "wide"}
 }
 
 // ToPrinter receives returns a printer capable of
 // handling human-readable output.
 func (f *HumanPrintFlags) ToPrinter(getHandlerFunc func(h hprinters.PrintHandler)) (hprinters.ResourcePrinter, error) {
-	p := hprinters.NewTablePrinter(hprinters.PrintOptions{AllNamespaces: f.WithNamespace, NoHeaders: f.NoHeaders})
+	p := hprinters.NewTablePrinter(hprinters.PrintOptions{
+		AllNamespaces: f.WithNamespace,
+		NoHeaders:     f.NoHeaders,
+		Wide:          f.Wide,
+		SortBy:        f.SortBy,
+		Columns:       f.Columns,
+	})
 	getHandlerFunc(p)
 	return p, nil
 }
@@ -54,7 +62,8 @@ func (f *HumanPrintFlags) ToPrinter(getHandlerFunc func(h hprinters.PrintHandler
 // flags related to human-readable printing to it
 func (f *HumanPrintFlags) AddFlags(c *cobra.Command) {
 	c.Flags().BoolVar(&f.NoHeaders, "no-headers", false, "When using the default output format, don't print headers (default: print headers).")
-	//TODO: Add more flags as required
+	c.Flags().StringVar(&f.SortBy, "sort-by", "", "Sort the list by a column name like AGE or READY, or by a JSONPath expression like '.metadata.creationTimestamp'.")
+	c.Flags().StringSliceVar(&f.Columns, "columns", nil, "When using the default output format, print only the given comma separated columns, e.g. NAME,URL,READY. Columns of '-o wide' can be picked too.")
 }
 
 // NewHumanPrintFlags returns flags associated with
@@ -0,0 +1,223 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/printers"
+)
+
+// NewLintCommand to check Knative resources against best practices
+func NewLintCommand(p *commands.KnParams) *cobra.Command {
+	var (
+		filenames []string
+		recursive bool
+		output    string
+	)
+
+	command := &cobra.Command{
+		Use:   "lint",
+		Short: "Check Knative resources against best practices",
+		Long: `Check Knative resources against best practices
+
+The services, brokers, triggers, channels and sources of a namespace are checked
+against the following rules. Instead of the resources in the cluster, the services
+of a directory given with '--target' or the resources of manifests given with
+'--filename' can be checked. The command fails if a rule is violated.
+
+` + rulesHelp() + `
+
+Rules can be disabled in the configuration file:
+
+  lint:
+    rules:
+      latest-tag: false
+    # Patterns of the names of development namespaces
+    dev-namespaces: ["dev", "dev-*", "*-dev"]
+    # Number of triggers from which on a broker is considered busy
+    busy-broker-triggers: 5`,
+		Example: `
+  # Check the resources of the current namespace
+  kn lint
+
+  # Check the manifests in directory 'deploy' and its sub directories and print violations as JSON
+  kn lint -f deploy -R -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn lint' does not accept any arguments")
+			}
+			if output != "table" && output != "json" {
+				return fmt.Errorf("invalid value '%s' for '--output', choose one among 'table' or 'json'", output)
+			}
+			target := cmd.Flag("target").Value.String()
+			if target != "" && len(filenames) > 0 {
+				return errors.New("'--target' and '--filename' can't be used together")
+			}
+			settings := config.GlobalConfig.Lint()
+			enabled, err := enabledRules(settings)
+			if err != nil {
+				return err
+			}
+
+			var r *resources
+			if len(filenames) > 0 {
+				r, err = readResources(filenames, recursive, cmd.Flag("namespace").Value.String(), cmd.InOrStdin())
+			} else {
+				r, err = listResources(cmd.Context(), p, cmd, target)
+			}
+			if err != nil {
+				return err
+			}
+
+			violations := []violation{}
+			for _, rule := range enabled {
+				violations = append(violations, rule.check(r, settings)...)
+			}
+			if err := printViolations(cmd.OutOrStdout(), violations, output); err != nil {
+				return err
+			}
+			if len(violations) > 0 {
+				return fmt.Errorf("found %d violation(s) of lint rules", len(violations))
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	commands.AddGitOpsFlags(command.Flags())
+	command.Flags().StringArrayVarP(&filenames, "filename", "f", nil, "Manifest file or directory to check instead of the resources in the cluster, use '-' to read from the standard input. Can be specified multiple times.")
+	command.Flags().BoolVarP(&recursive, "recursive", "R", false, "Process the directories given with '--filename' recursively.")
+	command.Flags().StringVarP(&output, "output", "o", "table", "Output format. One of: table|json.")
+	return command
+}
+
+func rulesHelp() string {
+	help := "Rules:"
+	for _, r := range rules {
+		help += fmt.Sprintf("\n  %-22s %s", r.name, r.description)
+	}
+	return help
+}
+
+// listResources lists the resources to check from the cluster or the services of a target directory
+func listResources(ctx context.Context, p *commands.KnParams, cmd *cobra.Command, target string) (*resources, error) {
+	namespace, err := p.GetNamespace(cmd)
+	if err != nil {
+		return nil, err
+	}
+	r := &resources{namespace: namespace}
+
+	if target != "" {
+		client, err := p.NewGitopsServingClient(namespace, target)
+		if err != nil {
+			return nil, err
+		}
+		services, err := client.ListServices(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.services = services.Items
+		return r, nil
+	}
+
+	servingClient, err := p.NewServingClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	services, err := servingClient.ListServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.services = services.Items
+
+	eventingClient, err := p.NewEventingClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	brokers, err := eventingClient.ListBrokers(ctx)
+	if err != nil && !knerrors.IsAPINotAvailableError(err) {
+		return nil, err
+	}
+	if err == nil {
+		r.brokers = brokers.Items
+	}
+	triggers, err := eventingClient.ListTriggers(ctx)
+	if err != nil && !knerrors.IsAPINotAvailableError(err) {
+		return nil, err
+	}
+	if err == nil {
+		r.triggers = triggers.Items
+	}
+
+	messagingClient, err := p.NewMessagingClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	channels, err := messagingClient.ChannelsClient().ListChannel(ctx)
+	if err != nil && !knerrors.IsAPINotAvailableError(err) {
+		return nil, err
+	}
+	if err == nil {
+		r.channels = channels.Items
+	}
+
+	dynamicClient, err := p.NewDynamicClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	sourceTypes, err := dynamicClient.ListSourcesTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceTypes.Items) > 0 {
+		sources, err := dynamicClient.ListSources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.sources = sources.Items
+	}
+	return r, nil
+}
+
+// printViolations prints the violations as table or JSON
+func printViolations(out io.Writer, violations []violation, output string) error {
+	if output == "json" {
+		b, err := json.MarshalIndent(violations, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+		return nil
+	}
+	if len(violations) == 0 {
+		fmt.Fprintln(out, "No violations of lint rules found.")
+		return nil
+	}
+	w := printers.NewTabWriter(out)
+	fmt.Fprintln(w, "KIND\tNAME\tRULE\tMESSAGE")
+	for _, v := range violations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", v.Kind, v.Name, v.Rule, v.Message)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,217 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingfake "knative.dev/eventing/pkg/client/clientset/versioned/fake"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingfake "knative.dev/serving/pkg/client/clientset/versioned/fake"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	clientmessagingv1 "knative.dev/client/pkg/messaging/v1"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+const lintManifest = `apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: foo
+spec:
+  template:
+    spec:
+      containerConcurrency: 10
+      containers:
+      - image: gcr.io/foo/bar:latest
+        readinessProbe:
+          httpGet:
+            path: /ready
+        resources:
+          requests:
+            cpu: 100m
+          limits:
+            memory: 256Mi
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`
+
+func executeLintCommand(p *commands.KnParams, stdin string, args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	cmd := NewLintCommand(p)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	cmd.SetIn(strings.NewReader(stdin))
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func withLintConfig(t *testing.T, lint config.LintConfig) {
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestLint: lint}
+	t.Cleanup(func() {
+		config.GlobalConfig = oldConfig
+	})
+}
+
+func TestLintManifest(t *testing.T) {
+	withLintConfig(t, config.LintConfig{DevNamespaces: config.DefaultDevNamespaces, BusyBrokerTriggers: 5})
+	file := filepath.Join(t.TempDir(), "service.yaml")
+	assert.NilError(t, os.WriteFile(file, []byte(lintManifest), 0600))
+
+	out, err := executeLintCommand(&commands.KnParams{}, "", "-f", file)
+	assert.ErrorContains(t, err, "found 1 violation(s) of lint rules")
+	assert.Assert(t, util.ContainsAll(out, "KIND", "NAME", "RULE", "MESSAGE",
+		"Service", "foo", "latest-tag", "image 'gcr.io/foo/bar:latest' of container #1 uses the 'latest' tag"))
+
+	out, err = executeLintCommand(&commands.KnParams{}, lintManifest, "-f", "-", "-o", "json")
+	assert.ErrorContains(t, err, "found 1 violation(s)")
+	var violations []violation
+	assert.NilError(t, json.Unmarshal([]byte(out[:strings.Index(out, "Error:")]), &violations))
+	assert.DeepEqual(t, violations, []violation{{
+		Kind:    "Service",
+		Name:    "foo",
+		Rule:    "latest-tag",
+		Message: "image 'gcr.io/foo/bar:latest' of container #1 uses the 'latest' tag without being locked to a digest",
+	}})
+}
+
+func TestLintDisabledRule(t *testing.T) {
+	withLintConfig(t, config.LintConfig{Rules: map[string]bool{"latest-tag": false}, BusyBrokerTriggers: 5})
+
+	out, err := executeLintCommand(&commands.KnParams{}, lintManifest, "-f", "-")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "No violations of lint rules found."))
+
+	out, err = executeLintCommand(&commands.KnParams{}, lintManifest, "-f", "-", "-o", "json")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "[]\n")
+}
+
+func TestLintDirectory(t *testing.T) {
+	withLintConfig(t, config.LintConfig{Rules: map[string]bool{"latest-tag": false}, BusyBrokerTriggers: 5})
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "service.yaml"), []byte(lintManifest), 0600))
+	assert.NilError(t, os.Mkdir(filepath.Join(dir, "sources"), 0700))
+	source := `{"apiVersion": "sources.knative.dev/v1", "kind": "PingSource", "metadata": {"name": "ping"}, "spec": {"sink": {"uri": "http://foo"}}}`
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "sources", "ping.json"), []byte(source), 0600))
+
+	_, err := executeLintCommand(&commands.KnParams{}, "", "-f", dir)
+	assert.NilError(t, err)
+
+	out, err := executeLintCommand(&commands.KnParams{}, "", "-f", dir, "--recursive")
+	assert.ErrorContains(t, err, "found 1 violation(s)")
+	assert.Assert(t, util.ContainsAll(out, "PingSource", "ping", "dead-letter-sink", "source has no dead letter sink"))
+}
+
+func TestLintErrors(t *testing.T) {
+	withLintConfig(t, config.LintConfig{Rules: map[string]bool{"foo": true}})
+	_, err := executeLintCommand(&commands.KnParams{}, "", "-f", "-")
+	assert.ErrorContains(t, err, "unknown lint rule 'foo'")
+
+	withLintConfig(t, config.LintConfig{})
+	_, err = executeLintCommand(&commands.KnParams{}, "", "foo")
+	assert.ErrorContains(t, err, "does not accept any arguments")
+
+	_, err = executeLintCommand(&commands.KnParams{}, "", "-o", "yaml")
+	assert.ErrorContains(t, err, "invalid value 'yaml' for '--output'")
+
+	_, err = executeLintCommand(&commands.KnParams{}, "", "-f", "-", "--target", "dir")
+	assert.ErrorContains(t, err, "'--target' and '--filename' can't be used together")
+
+	_, err = executeLintCommand(&commands.KnParams{}, "kind: [", "-f", "-")
+	assert.ErrorContains(t, err, "cannot read manifest '<stdin>'")
+}
+
+func TestLintCluster(t *testing.T) {
+	withLintConfig(t, config.LintConfig{
+		Rules:              map[string]bool{"resources": false, "readiness-probe": false, "latest-tag": false},
+		DevNamespaces:      []string{"dev"},
+		BusyBrokerTriggers: 2,
+	})
+
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "dev"}}
+	service.Spec.Template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v1"}}
+	service.Spec.Template.Spec.ContainerConcurrency = ptr.Int64(10)
+	service.Spec.Template.Annotations = map[string]string{"autoscaling.knative.dev/min-scale": "1"}
+	triggers := []runtime.Object{
+		&eventingv1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "t1", Namespace: "dev"}, Spec: eventingv1.TriggerSpec{Broker: "default"}},
+		&eventingv1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "t2", Namespace: "dev"}, Spec: eventingv1.TriggerSpec{
+			Broker: "default",
+			Filter: &eventingv1.TriggerFilter{Attributes: map[string]string{"type": "foo"}},
+		}},
+	}
+	serving := servingfake.NewSimpleClientset(service)
+	eventing := eventingfake.NewSimpleClientset(triggers...)
+	dynamic := dynamicfake.CreateFakeKnDynamicClient("dev")
+	p := &commands.KnParams{
+		NewServingClient: func(namespace string) (clientservingv1.KnServingClient, error) {
+			return clientservingv1.NewKnServingClient(serving.ServingV1(), namespace), nil
+		},
+		NewEventingClient: func(namespace string) (clienteventingv1.KnEventingClient, error) {
+			return clienteventingv1.NewKnEventingClient(eventing.EventingV1(), namespace), nil
+		},
+		NewMessagingClient: func(namespace string) (clientmessagingv1.KnMessagingClient, error) {
+			return clientmessagingv1.NewKnMessagingClient(eventing.MessagingV1(), namespace), nil
+		},
+		NewDynamicClient: func(namespace string) (clientdynamic.KnDynamicClient, error) {
+			return dynamic, nil
+		},
+	}
+
+	out, err := executeLintCommand(p, "", "-n", "dev")
+	assert.ErrorContains(t, err, "found 2 violation(s)")
+	assert.Assert(t, util.ContainsAll(out,
+		"min-scale is 1 in development namespace 'dev'",
+		"t1", "trigger has no filter and receives all events of broker 'default' which has 2 triggers"))
+	assert.Assert(t, util.ContainsNone(out, "t2"))
+}
+
+func TestLintTarget(t *testing.T) {
+	withLintConfig(t, config.LintConfig{Rules: map[string]bool{"resources": false, "readiness-probe": false}, BusyBrokerTriggers: 5})
+	dir := t.TempDir()
+	p := &commands.KnParams{}
+	p.Initialize()
+	client, err := p.NewGitopsServingClient("default", dir)
+	assert.NilError(t, err)
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	service.Spec.Template.Spec.Containers = []corev1.Container{{Image: "nginx"}}
+	assert.NilError(t, client.CreateService(context.Background(), service))
+
+	out, err := executeLintCommand(p, "", "--target", dir, "-n", "default")
+	assert.ErrorContains(t, err, "found 2 violation(s)")
+	assert.Assert(t, util.ContainsAll(out, "latest-tag", "unbounded-concurrency"))
+}
@@ -0,0 +1,116 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// readResources reads the resources to check from manifest files, directories or
+// the standard input. Resources without a namespace are in the given namespace.
+func readResources(filenames []string, recursive bool, namespace string, stdin io.Reader) (*resources, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	r := &resources{namespace: namespace}
+	for _, filename := range filenames {
+		if filename == "-" {
+			if err := r.read("<stdin>", stdin); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := commands.WalkManifests(filename, recursive, r.readFile); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (r *resources) readFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return r.read(file, f)
+}
+
+// read adds the resources of a manifest, other kinds of resources are ignored
+func (r *resources) read(file string, in io.Reader) error {
+	decoder := yaml.NewYAMLOrJSONDecoder(in, 4096)
+	for {
+		var obj unstructured.Unstructured
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("cannot read manifest '%s': %w", file, err)
+		}
+		if obj.Object == nil {
+			continue
+		}
+		if err := r.add(&obj); err != nil {
+			return fmt.Errorf("cannot read %s '%s' from manifest '%s': %w", obj.GetKind(), obj.GetName(), file, err)
+		}
+	}
+}
+
+func (r *resources) add(obj *unstructured.Unstructured) error {
+	if obj.IsList() {
+		return obj.EachListItem(func(item runtime.Object) error {
+			return r.add(item.(*unstructured.Unstructured))
+		})
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(r.namespace)
+	}
+	gvk := obj.GroupVersionKind()
+	var target interface{}
+	switch {
+	case gvk.Group == servingv1.SchemeGroupVersion.Group && gvk.Kind == "Service":
+		r.services = append(r.services, servingv1.Service{})
+		target = &r.services[len(r.services)-1]
+	case gvk.Group == eventingv1.SchemeGroupVersion.Group && gvk.Kind == "Broker":
+		r.brokers = append(r.brokers, eventingv1.Broker{})
+		target = &r.brokers[len(r.brokers)-1]
+	case gvk.Group == eventingv1.SchemeGroupVersion.Group && gvk.Kind == "Trigger":
+		r.triggers = append(r.triggers, eventingv1.Trigger{})
+		target = &r.triggers[len(r.triggers)-1]
+	case gvk.Group == messagingv1.SchemeGroupVersion.Group && gvk.Kind == "Channel":
+		r.channels = append(r.channels, messagingv1.Channel{})
+		target = &r.channels[len(r.channels)-1]
+	case gvk.Group == sourcesv1.SchemeGroupVersion.Group:
+		r.sources = append(r.sources, *obj)
+		return nil
+	default:
+		return nil
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, target)
+}
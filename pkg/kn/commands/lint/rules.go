@@ -0,0 +1,296 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/config"
+)
+
+// resources to lint
+type resources struct {
+	namespace string
+	services  []servingv1.Service
+	brokers   []eventingv1.Broker
+	triggers  []eventingv1.Trigger
+	channels  []messagingv1.Channel
+	sources   []unstructured.Unstructured
+}
+
+// violation of a lint rule by a resource
+type violation struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// rule is a best practice checked for resources
+type rule struct {
+	name        string
+	description string
+	check       func(r *resources, settings config.LintConfig) []violation
+}
+
+// rules are all lint rules in the order they are checked
+var rules = []rule{
+	{"resources", "Containers of services specify resource requests and limits", checkResources},
+	{"readiness-probe", "The serving container of services has a readiness probe", checkReadinessProbe},
+	{"latest-tag", "Images of services don't use the 'latest' tag without being locked to a digest", checkLatestTag},
+	{"dev-min-scale", "Services in development namespaces scale to zero", checkDevMinScale},
+	{"unbounded-concurrency", "Services have a container concurrency limit", checkConcurrency},
+	{"trigger-filter", "Triggers of busy brokers filter the events they receive", checkTriggerFilter},
+	{"dead-letter-sink", "Events of sources which can't be delivered end up in a dead letter sink", checkDeadLetterSink},
+}
+
+// enabledRules returns the rules which are not disabled in the configuration
+func enabledRules(settings config.LintConfig) ([]rule, error) {
+	for name := range settings.Rules {
+		if findRule(name) == nil {
+			return nil, fmt.Errorf("unknown lint rule '%s' in configuration, must be one of %s", name, strings.Join(ruleNames(), ", "))
+		}
+	}
+	var enabled []rule
+	for _, r := range rules {
+		if on, configured := settings.Rules[r.name]; configured && !on {
+			continue
+		}
+		enabled = append(enabled, r)
+	}
+	return enabled, nil
+}
+
+func findRule(name string) *rule {
+	for i := range rules {
+		if rules[i].name == name {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func ruleNames() []string {
+	names := make([]string, 0, len(rules))
+	for _, r := range rules {
+		names = append(names, r.name)
+	}
+	return names
+}
+
+func serviceViolation(service *servingv1.Service, rule, format string, args ...interface{}) violation {
+	return violation{Kind: "Service", Name: service.Name, Rule: rule, Message: fmt.Sprintf(format, args...)}
+}
+
+// containerName returns a name for a container to use in messages
+func containerName(container *corev1.Container, index int) string {
+	if container.Name != "" {
+		return fmt.Sprintf("container '%s'", container.Name)
+	}
+	return fmt.Sprintf("container #%d", index+1)
+}
+
+func checkResources(r *resources, _ config.LintConfig) []violation {
+	var violations []violation
+	for i := range r.services {
+		service := &r.services[i]
+		for j := range service.Spec.Template.Spec.Containers {
+			container := &service.Spec.Template.Spec.Containers[j]
+			if len(container.Resources.Requests) == 0 {
+				violations = append(violations, serviceViolation(service, "resources",
+					"%s has no resource requests", containerName(container, j)))
+			}
+			if len(container.Resources.Limits) == 0 {
+				violations = append(violations, serviceViolation(service, "resources",
+					"%s has no resource limits", containerName(container, j)))
+			}
+		}
+	}
+	return violations
+}
+
+func checkReadinessProbe(r *resources, _ config.LintConfig) []violation {
+	var violations []violation
+	for i := range r.services {
+		service := &r.services[i]
+		containers := service.Spec.Template.Spec.Containers
+		for j := range containers {
+			container := &containers[j]
+			// Only the serving container, which is the one with a port if there is
+			// more than one container, is probed
+			if len(containers) > 1 && len(container.Ports) == 0 {
+				continue
+			}
+			if container.ReadinessProbe == nil {
+				violations = append(violations, serviceViolation(service, "readiness-probe",
+					"%s has no readiness probe", containerName(container, j)))
+			}
+		}
+	}
+	return violations
+}
+
+func checkLatestTag(r *resources, _ config.LintConfig) []violation {
+	var violations []violation
+	for i := range r.services {
+		service := &r.services[i]
+		for j := range service.Spec.Template.Spec.Containers {
+			container := &service.Spec.Template.Spec.Containers[j]
+			if usesLatestTag(container.Image) {
+				violations = append(violations, serviceViolation(service, "latest-tag",
+					"image '%s' of %s uses the 'latest' tag without being locked to a digest",
+					container.Image, containerName(container, j)))
+			}
+		}
+	}
+	return violations
+}
+
+// usesLatestTag returns true if an image reference has no digest and the
+// 'latest' tag, which is implied if no tag is given
+func usesLatestTag(image string) bool {
+	if image == "" || strings.Contains(image, "@") {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	colon := strings.LastIndex(name, ":")
+	return colon < 0 || name[colon+1:] == "latest"
+}
+
+func checkDevMinScale(r *resources, settings config.LintConfig) []violation {
+	var violations []violation
+	for i := range r.services {
+		service := &r.services[i]
+		namespace := service.Namespace
+		if namespace == "" {
+			namespace = r.namespace
+		}
+		if !matchesAny(namespace, settings.DevNamespaces) {
+			continue
+		}
+		_, value, ok := autoscaling.MinScaleAnnotation.Get(service.Spec.Template.Annotations)
+		if !ok {
+			continue
+		}
+		if minScale, err := strconv.Atoi(value); err == nil && minScale > 0 {
+			violations = append(violations, serviceViolation(service, "dev-min-scale",
+				"min-scale is %d in development namespace '%s', set it to 0 to scale to zero when idle", minScale, namespace))
+		}
+	}
+	return violations
+}
+
+// matchesAny returns true if the name matches any of the given patterns
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, err := filepath.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func checkConcurrency(r *resources, _ config.LintConfig) []violation {
+	var violations []violation
+	for i := range r.services {
+		service := &r.services[i]
+		concurrency := service.Spec.Template.Spec.ContainerConcurrency
+		if concurrency == nil || *concurrency == 0 {
+			violations = append(violations, serviceViolation(service, "unbounded-concurrency",
+				"container concurrency is unbounded, set a limit with '--concurrency-limit'"))
+		}
+	}
+	return violations
+}
+
+func checkTriggerFilter(r *resources, settings config.LintConfig) []violation {
+	triggersPerBroker := map[string]int{}
+	for _, trigger := range r.triggers {
+		triggersPerBroker[trigger.Spec.Broker]++
+	}
+	var violations []violation
+	for _, trigger := range r.triggers {
+		count := triggersPerBroker[trigger.Spec.Broker]
+		if count < settings.BusyBrokerTriggers || hasFilter(&trigger) {
+			continue
+		}
+		violations = append(violations, violation{
+			Kind: "Trigger",
+			Name: trigger.Name,
+			Rule: "trigger-filter",
+			Message: fmt.Sprintf("trigger has no filter and receives all events of broker '%s' which has %d triggers",
+				trigger.Spec.Broker, count),
+		})
+	}
+	return violations
+}
+
+func hasFilter(trigger *eventingv1.Trigger) bool {
+	if len(trigger.Spec.Filters) > 0 {
+		return true
+	}
+	return trigger.Spec.Filter != nil && len(trigger.Spec.Filter.Attributes) > 0
+}
+
+func checkDeadLetterSink(r *resources, _ config.LintConfig) []violation {
+	var violations []violation
+	for i := range r.sources {
+		source := &r.sources[i]
+		if _, found, _ := unstructured.NestedMap(source.Object, "spec", "delivery", "deadLetterSink"); found {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(source.Object, "spec", "sink", "ref", "kind")
+		name, _, _ := unstructured.NestedString(source.Object, "spec", "sink", "ref", "name")
+		if sinkHasDeadLetterSink(r, kind, name) {
+			continue
+		}
+		message := "source has no dead letter sink"
+		if kind != "" {
+			message = fmt.Sprintf("neither the source nor its sink %s '%s' has a dead letter sink", kind, name)
+		}
+		violations = append(violations, violation{Kind: source.GetKind(), Name: source.GetName(), Rule: "dead-letter-sink", Message: message})
+	}
+	return violations
+}
+
+// sinkHasDeadLetterSink returns true if the sink of a source is a broker or
+// channel which delivers undeliverable events to a dead letter sink
+func sinkHasDeadLetterSink(r *resources, kind, name string) bool {
+	switch kind {
+	case "Broker":
+		for _, broker := range r.brokers {
+			if broker.Name == name {
+				return broker.Spec.Delivery != nil && broker.Spec.Delivery.DeadLetterSink != nil
+			}
+		}
+	case "Channel":
+		for _, channel := range r.channels {
+			if channel.Name == name {
+				return channel.Spec.Delivery != nil && channel.Spec.Delivery.DeadLetterSink != nil
+			}
+		}
+	}
+	return false
+}
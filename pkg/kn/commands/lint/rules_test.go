@@ -0,0 +1,239 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/config"
+)
+
+var defaultSettings = config.LintConfig{
+	DevNamespaces:      config.DefaultDevNamespaces,
+	BusyBrokerTriggers: 2,
+}
+
+func newService(name string, containers ...corev1.Container) servingv1.Service {
+	service := servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "prod"}}
+	service.Spec.Template.Spec.Containers = containers
+	return service
+}
+
+// compliantContainer follows all rules for containers
+func compliantContainer() corev1.Container {
+	return corev1.Container{
+		Name:  "user",
+		Image: "gcr.io/foo/bar:v1",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+		ReadinessProbe: &corev1.Probe{},
+	}
+}
+
+func messages(violations []violation) []string {
+	var result []string
+	for _, v := range violations {
+		result = append(result, v.Kind+"/"+v.Name+": "+v.Message)
+	}
+	return result
+}
+
+func TestEnabledRules(t *testing.T) {
+	enabled, err := enabledRules(config.LintConfig{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(enabled), len(rules))
+
+	enabled, err = enabledRules(config.LintConfig{Rules: map[string]bool{"latest-tag": false, "resources": true}})
+	assert.NilError(t, err)
+	assert.Equal(t, len(enabled), len(rules)-1)
+	for _, r := range enabled {
+		assert.Assert(t, r.name != "latest-tag")
+	}
+
+	_, err = enabledRules(config.LintConfig{Rules: map[string]bool{"foo": false}})
+	assert.ErrorContains(t, err, "unknown lint rule 'foo' in configuration, must be one of resources, readiness-probe")
+}
+
+func TestCheckResources(t *testing.T) {
+	unnamed := compliantContainer()
+	unnamed.Name = ""
+	unnamed.Resources.Limits = nil
+	noRequests := compliantContainer()
+	noRequests.Name = "sidecar"
+	noRequests.Resources.Requests = nil
+
+	r := &resources{services: []servingv1.Service{
+		newService("foo", compliantContainer()),
+		newService("bar", unnamed, noRequests),
+	}}
+	assert.DeepEqual(t, messages(checkResources(r, defaultSettings)), []string{
+		"Service/bar: container #1 has no resource limits",
+		"Service/bar: container 'sidecar' has no resource requests",
+	})
+}
+
+func TestCheckReadinessProbe(t *testing.T) {
+	noProbe := compliantContainer()
+	noProbe.ReadinessProbe = nil
+	serving := compliantContainer()
+	serving.ReadinessProbe = nil
+	serving.Ports = []corev1.ContainerPort{{ContainerPort: 8080}}
+
+	r := &resources{services: []servingv1.Service{
+		newService("foo", compliantContainer()),
+		newService("bar", noProbe),
+		// only the serving container of a multi container service is probed
+		newService("baz", noProbe, serving),
+	}}
+	assert.DeepEqual(t, messages(checkReadinessProbe(r, defaultSettings)), []string{
+		"Service/bar: container 'user' has no readiness probe",
+		"Service/baz: container 'user' has no readiness probe",
+	})
+}
+
+func TestUsesLatestTag(t *testing.T) {
+	for image, expected := range map[string]bool{
+		"nginx":                            true,
+		"nginx:latest":                     true,
+		"localhost:5000/foo/bar":           true,
+		"localhost:5000/foo/bar:latest":    true,
+		"localhost:5000/foo/bar:v1":        false,
+		"gcr.io/foo/bar:v1":                false,
+		"gcr.io/foo/bar@sha256:deadbeef":   false,
+		"gcr.io/foo/bar:latest@sha256:abc": false,
+		"":                                 false,
+	} {
+		assert.Equal(t, usesLatestTag(image), expected, image)
+	}
+}
+
+func TestCheckDevMinScale(t *testing.T) {
+	dev := newService("foo", compliantContainer())
+	dev.Namespace = "team-dev"
+	dev.Spec.Template.Annotations = map[string]string{"autoscaling.knative.dev/min-scale": "1"}
+	devZero := newService("bar", compliantContainer())
+	devZero.Namespace = "dev"
+	devZero.Spec.Template.Annotations = map[string]string{"autoscaling.knative.dev/minScale": "0"}
+	prod := newService("baz", compliantContainer())
+	prod.Spec.Template.Annotations = map[string]string{"autoscaling.knative.dev/min-scale": "3"}
+	noNamespace := newService("qux", compliantContainer())
+	noNamespace.Namespace = ""
+	noNamespace.Spec.Template.Annotations = map[string]string{"autoscaling.knative.dev/minScale": "2"}
+
+	r := &resources{namespace: "dev-1", services: []servingv1.Service{dev, devZero, prod, noNamespace}}
+	assert.DeepEqual(t, messages(checkDevMinScale(r, defaultSettings)), []string{
+		"Service/foo: min-scale is 1 in development namespace 'team-dev', set it to 0 to scale to zero when idle",
+		"Service/qux: min-scale is 2 in development namespace 'dev-1', set it to 0 to scale to zero when idle",
+	})
+
+	settings := defaultSettings
+	settings.DevNamespaces = []string{"prod"}
+	assert.DeepEqual(t, messages(checkDevMinScale(r, settings)), []string{
+		"Service/baz: min-scale is 3 in development namespace 'prod', set it to 0 to scale to zero when idle",
+	})
+}
+
+func TestCheckConcurrency(t *testing.T) {
+	limited := newService("foo", compliantContainer())
+	limited.Spec.Template.Spec.ContainerConcurrency = ptr.Int64(10)
+	unlimited := newService("bar", compliantContainer())
+	unlimited.Spec.Template.Spec.ContainerConcurrency = ptr.Int64(0)
+
+	r := &resources{services: []servingv1.Service{limited, unlimited, newService("baz", compliantContainer())}}
+	assert.DeepEqual(t, messages(checkConcurrency(r, defaultSettings)), []string{
+		"Service/bar: container concurrency is unbounded, set a limit with '--concurrency-limit'",
+		"Service/baz: container concurrency is unbounded, set a limit with '--concurrency-limit'",
+	})
+}
+
+func newTrigger(name, broker string, filter map[string]string) eventingv1.Trigger {
+	trigger := eventingv1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	trigger.Spec.Broker = broker
+	if filter != nil {
+		trigger.Spec.Filter = &eventingv1.TriggerFilter{Attributes: filter}
+	}
+	return trigger
+}
+
+func TestCheckTriggerFilter(t *testing.T) {
+	withFilters := newTrigger("filters", "busy", nil)
+	withFilters.Spec.Filters = []eventingv1.SubscriptionsAPIFilter{{Exact: map[string]string{"type": "foo"}}}
+
+	r := &resources{triggers: []eventingv1.Trigger{
+		newTrigger("all", "busy", nil),
+		newTrigger("empty", "busy", map[string]string{}),
+		newTrigger("filtered", "busy", map[string]string{"type": "foo"}),
+		withFilters,
+		newTrigger("quiet", "quiet", nil),
+	}}
+	assert.DeepEqual(t, messages(checkTriggerFilter(r, defaultSettings)), []string{
+		"Trigger/all: trigger has no filter and receives all events of broker 'busy' which has 4 triggers",
+		"Trigger/empty: trigger has no filter and receives all events of broker 'busy' which has 4 triggers",
+	})
+}
+
+func newSource(name string, spec map[string]interface{}) unstructured.Unstructured {
+	source := unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	source.SetAPIVersion("sources.knative.dev/v1")
+	source.SetKind("PingSource")
+	source.SetName(name)
+	return source
+}
+
+func sinkRef(kind, name string) map[string]interface{} {
+	return map[string]interface{}{"sink": map[string]interface{}{"ref": map[string]interface{}{"kind": kind, "name": name}}}
+}
+
+func TestCheckDeadLetterSink(t *testing.T) {
+	delivery := &eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{Ref: &duckv1.KReference{Kind: "Service", Name: "dls"}}}
+	r := &resources{
+		brokers: []eventingv1.Broker{
+			{ObjectMeta: metav1.ObjectMeta{Name: "safe"}, Spec: eventingv1.BrokerSpec{Delivery: delivery}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "unsafe"}},
+		},
+		channels: []messagingv1.Channel{
+			{ObjectMeta: metav1.ObjectMeta{Name: "safe"}, Spec: messagingv1.ChannelSpec{ChannelableSpec: eventingduckv1.ChannelableSpec{Delivery: delivery}}},
+		},
+		sources: []unstructured.Unstructured{
+			newSource("own", map[string]interface{}{"delivery": map[string]interface{}{"deadLetterSink": map[string]interface{}{"uri": "http://dls"}}}),
+			newSource("safe-broker", sinkRef("Broker", "safe")),
+			newSource("unsafe-broker", sinkRef("Broker", "unsafe")),
+			newSource("unknown-broker", sinkRef("Broker", "unknown")),
+			newSource("safe-channel", sinkRef("Channel", "safe")),
+			newSource("service", sinkRef("Service", "foo")),
+			newSource("uri", map[string]interface{}{"sink": map[string]interface{}{"uri": "http://foo"}}),
+		},
+	}
+	assert.DeepEqual(t, messages(checkDeadLetterSink(r, defaultSettings)), []string{
+		"PingSource/unsafe-broker: neither the source nor its sink Broker 'unsafe' has a dead letter sink",
+		"PingSource/unknown-broker: neither the source nor its sink Broker 'unknown' has a dead letter sink",
+		"PingSource/service: neither the source nor its sink Service 'foo' has a dead letter sink",
+		"PingSource/uri: source has no dead letter sink",
+	})
+}
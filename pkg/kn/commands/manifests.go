@@ -0,0 +1,63 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// manifestExtensions are the file extensions of manifests picked up from directories
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// WalkManifests calls fn for the given manifest file, or for each manifest in the given
+// directory. Manifests in directories are picked up by their file extension, sub directories
+// are only processed if recursive is true.
+func WalkManifests(path string, recursive bool, fn func(file string) error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fn(path)
+	}
+	return filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if file != path && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isManifest(file) {
+			return nil
+		}
+		return fn(file)
+	})
+}
+
+func isManifest(file string) bool {
+	ext := strings.ToLower(filepath.Ext(file))
+	for _, e := range manifestExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWalkManifests(t *testing.T) {
+	dir := t.TempDir()
+	assert.NilError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0700))
+	for _, file := range []string{"a.yaml", "b.YML", "c.json", "d.txt", "sub/e.yaml"} {
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, file), []byte("{}"), 0600))
+	}
+	walk := func(path string, recursive bool) []string {
+		var files []string
+		assert.NilError(t, WalkManifests(path, recursive, func(file string) error {
+			rel, err := filepath.Rel(dir, file)
+			files = append(files, filepath.ToSlash(rel))
+			return err
+		}))
+		return files
+	}
+
+	assert.DeepEqual(t, walk(dir, false), []string{"a.yaml", "b.YML", "c.json"})
+	assert.DeepEqual(t, walk(dir, true), []string{"a.yaml", "b.YML", "c.json", "sub/e.yaml"})
+	// A file given explicitly is used regardless of its extension
+	assert.DeepEqual(t, walk(filepath.Join(dir, "d.txt"), false), []string{"d.txt"})
+
+	err := WalkManifests(filepath.Join(dir, "missing"), false, func(string) error { return nil })
+	assert.Assert(t, os.IsNotExist(err))
+}
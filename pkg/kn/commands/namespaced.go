@@ -20,6 +20,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"k8s.io/client-go/tools/clientcmd"
+
+	"knative.dev/client/pkg/kn/config"
 )
 
 // AddNamespaceFlags adds the namespace-related flags:
@@ -75,7 +77,8 @@ func (params *KnParams) GetNamespace(cmd *cobra.Command) (string, error) {
 	return namespace, nil
 }
 
-// CurrentNamespace returns the current namespace which is either provided as option or picked up from kubeconfig
+// CurrentNamespace returns the current namespace which is either provided as option, configured
+// in the kn configuration for the current context or picked up from kubeconfig
 func (params *KnParams) CurrentNamespace() (string, error) {
 	var err error
 	if params.fixedCurrentNamespace != "" {
@@ -87,6 +90,36 @@ func (params *KnParams) CurrentNamespace() (string, error) {
 			return "", err
 		}
 	}
+	// An error reading kubeconfig is reported when looking up its namespace below
+	kubeContext, _, _ := params.CurrentContext()
+	if namespace := config.GlobalConfig.DefaultNamespace(kubeContext); namespace != "" {
+		return namespace, nil
+	}
 	name, _, err := params.ClientConfig.Namespace()
 	return name, err
 }
+
+// CurrentContext returns the name of the kubeconfig context in use and the name of the
+// cluster it refers to, taking the --context and --cluster options into account
+func (params *KnParams) CurrentContext() (string, string, error) {
+	var err error
+	if params.ClientConfig == nil {
+		params.ClientConfig, err = params.GetClientConfig()
+		if err != nil {
+			return "", "", err
+		}
+	}
+	rawConfig, err := params.ClientConfig.RawConfig()
+	if err != nil {
+		return "", "", err
+	}
+	name := params.KubeContext
+	if name == "" {
+		name = rawConfig.CurrentContext
+	}
+	cluster := params.KubeCluster
+	if context, ok := rawConfig.Contexts[name]; ok && cluster == "" {
+		cluster = context.Cluster
+	}
+	return name, cluster, nil
+}
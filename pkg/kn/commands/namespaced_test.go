@@ -25,6 +25,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/config"
 )
 
 // testCommandGenerator generates a test cobra command
@@ -242,6 +244,68 @@ func TestCurrentNamespace(t *testing.T) {
 	})
 }
 
+func TestCurrentNamespaceFromConfig(t *testing.T) {
+	tempFile := filepath.Join(t.TempDir(), "mock")
+	err := os.WriteFile(tempFile, []byte(BASIC_KUBECONFIG), test.FileModeReadWrite)
+	assert.NilError(t, err)
+
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{TestDefaults: config.DefaultsConfig{
+		Namespace: "global",
+		Contexts: map[string]config.ContextDefaults{
+			"a":     {Namespace: "ctx-a"},
+			"other": {Namespace: "ctx-other"},
+		},
+	}}
+
+	kp := &KnParams{KubeCfgPath: tempFile}
+	actual, err := kp.CurrentNamespace()
+	assert.NilError(t, err)
+	assert.Equal(t, actual, "ctx-a")
+
+	kp = &KnParams{KubeCfgPath: tempFile, KubeContext: "other"}
+	actual, err = kp.CurrentNamespace()
+	assert.NilError(t, err)
+	assert.Equal(t, actual, "ctx-other")
+
+	kp = &KnParams{KubeCfgPath: tempFile, KubeContext: "unknown"}
+	actual, err = kp.CurrentNamespace()
+	assert.NilError(t, err)
+	assert.Equal(t, actual, "global")
+
+	// --namespace takes precedence over the configuration
+	testCmd := testCommandGenerator(false)
+	testCmd.SetArgs([]string{"--namespace", "flag"})
+	assert.NilError(t, testCmd.Execute())
+	kp = &KnParams{KubeCfgPath: tempFile}
+	actual, err = kp.GetNamespace(testCmd)
+	assert.NilError(t, err)
+	assert.Equal(t, actual, "flag")
+}
+
+func TestCurrentContext(t *testing.T) {
+	tempFile := filepath.Join(t.TempDir(), "mock")
+	err := os.WriteFile(tempFile, []byte(BASIC_KUBECONFIG), test.FileModeReadWrite)
+	assert.NilError(t, err)
+
+	kp := &KnParams{KubeCfgPath: tempFile}
+	context, cluster, err := kp.CurrentContext()
+	assert.NilError(t, err)
+	assert.Equal(t, context, "a")
+	assert.Equal(t, cluster, "a")
+
+	kp = &KnParams{KubeCfgPath: tempFile, KubeContext: "other", KubeCluster: "b"}
+	context, cluster, err = kp.CurrentContext()
+	assert.NilError(t, err)
+	assert.Equal(t, context, "other")
+	assert.Equal(t, cluster, "b")
+
+	kp = &KnParams{KubeCfgPath: filepath.Join(t.TempDir(), "missing")}
+	_, _, err = kp.CurrentContext()
+	assert.ErrorContains(t, err, "can not be found")
+}
+
 func assertNamespaceInCluster(t *testing.T, actual, expected string) {
 	// Fallback to Prow CI "test-pods" namespace
 	inCluster := actual == "test-pods"
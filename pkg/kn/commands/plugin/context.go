@@ -0,0 +1,156 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+	"knative.dev/client/pkg/printers"
+)
+
+// NewPluginContextCommand creates the 'kn plugin context' command group
+func NewPluginContextCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Inspect the context data shared between plugins",
+		Long: `Inspect the context data shared between plugins
+
+Plugins declare in their manifest which keys of the context data they produce
+and consume. Context sharing is enabled with the 'features.context-sharing'
+configuration option.`,
+	}
+	cmd.AddCommand(NewPluginContextShowCommand(p))
+	return cmd
+}
+
+// NewPluginContextShowCommand creates the 'kn plugin context show' command
+func NewPluginContextShowCommand(p *commands.KnParams) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the current context data and the plugins producing and consuming it",
+		Example: `
+  # Show the context data with its producers and consumers
+  kn plugin context show
+
+  # Show the context data as JSON
+  kn plugin context show -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn plugin context show' does not accept any arguments")
+			}
+			if output != "" && output != "json" {
+				return fmt.Errorf("invalid value '%s' for '--output', only 'json' is supported", output)
+			}
+			manager := plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+			if err := manager.ConfigureSignatures(config.GlobalConfig.PluginSignatures()); err != nil {
+				return err
+			}
+			ctxManager, err := plugin.NewContextManager(manager)
+			if err != nil {
+				return err
+			}
+			// Plugins are only run for their manifests if context sharing is enabled
+			contextSharing := config.GlobalConfig.ContextSharing()
+			var data map[string]string
+			if contextSharing {
+				data, err = ctxManager.FetchContextData()
+			} else {
+				data, err = ctxManager.CachedContextData()
+			}
+			if err != nil {
+				return err
+			}
+			if contextSharing {
+				// The cache is an optimization only, e.g. the configuration directory might not exist
+				_ = ctxManager.WriteCache()
+			}
+			if output == "json" {
+				return printContextJSON(cmd.OutOrStdout(), ctxManager, data)
+			}
+			if !contextSharing {
+				fmt.Fprintln(cmd.OutOrStdout(), "Context sharing is disabled, enable it with 'features.context-sharing: true' in the configuration.")
+			}
+			return printContext(cmd.OutOrStdout(), ctxManager, data)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json.")
+	return cmd
+}
+
+// contextInfo is the context data printed by 'kn plugin context show -o json'
+type contextInfo struct {
+	Data      map[string]string   `json:"data"`
+	Producers map[string][]string `json:"producers"`
+	Consumers map[string][]string `json:"consumers"`
+}
+
+// printContextJSON prints the context data with the producers and consumers of each key as JSON
+func printContextJSON(out io.Writer, ctxManager *plugin.ContextDataManager, data map[string]string) error {
+	b, err := json.MarshalIndent(contextInfo{
+		Data:      data,
+		Producers: ctxManager.Producers,
+		Consumers: ctxManager.Consumers,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(b))
+	return nil
+}
+
+// printContext prints a table with the value of each key and the plugins producing and consuming it
+func printContext(out io.Writer, ctxManager *plugin.ContextDataManager, data map[string]string) error {
+	keys := contextKeys(ctxManager, data)
+	if len(keys) == 0 {
+		fmt.Fprintln(out, "No context data found.")
+		return nil
+	}
+	w := printers.NewTabWriter(out)
+	fmt.Fprintln(w, "KEY\tVALUE\tPRODUCERS\tCONSUMERS")
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key, data[key], strings.Join(ctxManager.Producers[key], ","), strings.Join(ctxManager.Consumers[key], ","))
+	}
+	return w.Flush()
+}
+
+// contextKeys returns the sorted keys which have a value or are produced or consumed by a plugin
+func contextKeys(ctxManager *plugin.ContextDataManager, data map[string]string) []string {
+	seen := map[string]bool{}
+	for _, m := range []map[string][]string{ctxManager.Producers, ctxManager.Consumers} {
+		for key := range m {
+			seen[key] = true
+		}
+	}
+	for key := range data {
+		seen[key] = true
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,131 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+	"knative.dev/client/pkg/util"
+)
+
+func TestPluginContextShow(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugins are bash scripts")
+	}
+	pluginDir := t.TempDir()
+	configDir := t.TempDir()
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{
+		TestPluginsDir:     pluginDir,
+		TestConfigFile:     filepath.Join(configDir, "config.yaml"),
+		TestContextSharing: true,
+	}
+	defer func() {
+		config.GlobalConfig = oldConfig
+		plugin.CtxManager = nil
+	}()
+	plugin.CtxManager = nil
+
+	writeScript(t, filepath.Join(pluginDir, "kn-producer"), `#!/bin/bash
+echo '{"producesKeys":["service"]}'
+`)
+	writeScript(t, filepath.Join(pluginDir, "kn-consumer"), `#!/bin/bash
+echo '{"consumesKeys":["service","revision"]}'
+`)
+	err := os.WriteFile(filepath.Join(configDir, "context.json"), []byte(`{"data":{"service":"hello"}}`), 0644)
+	assert.NilError(t, err)
+
+	out, err := executePluginCommand("context", "show")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "KEY", "VALUE", "PRODUCERS", "CONSUMERS"))
+	assert.Assert(t, util.ContainsAll(out, "revision", "kn-consumer"))
+	assert.Assert(t, util.ContainsAll(out, "service", "hello", "kn-producer", "kn-consumer"))
+	// The fetched manifests are stored in the cache
+	cache, err := os.ReadFile(filepath.Join(configDir, "context.json"))
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(string(cache), "kn-producer", "kn-consumer", "hello"))
+
+	out, err = executePluginCommand("context", "show", "-o", "json")
+	assert.NilError(t, err)
+	var info contextInfo
+	assert.NilError(t, json.Unmarshal([]byte(out), &info))
+	assert.DeepEqual(t, info, contextInfo{
+		Data:      map[string]string{"service": "hello"},
+		Producers: map[string][]string{"service": {"kn-producer"}},
+		Consumers: map[string][]string{"service": {"kn-consumer"}, "revision": {"kn-consumer"}},
+	})
+
+	_, err = executePluginCommand("context", "show", "foo")
+	assert.ErrorContains(t, err, "does not accept any arguments")
+	_, err = executePluginCommand("context", "show", "-o", "yaml")
+	assert.ErrorContains(t, err, "invalid value 'yaml' for '--output'")
+}
+
+func TestPluginContextShowEmpty(t *testing.T) {
+	_, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+	defer func() { plugin.CtxManager = nil }()
+	plugin.CtxManager = nil
+
+	out, err := executePluginCommand("context", "show")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Context sharing is disabled", "No context data found."))
+}
+
+func TestPluginContextShowDisabled(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugins are bash scripts")
+	}
+	pluginDir := t.TempDir()
+	configDir := t.TempDir()
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{
+		TestPluginsDir: pluginDir,
+		TestConfigFile: filepath.Join(configDir, "config.yaml"),
+	}
+	defer func() {
+		config.GlobalConfig = oldConfig
+		plugin.CtxManager = nil
+	}()
+	plugin.CtxManager = nil
+
+	marker := filepath.Join(pluginDir, "called")
+	writeScript(t, filepath.Join(pluginDir, "kn-producer"), `#!/bin/bash
+touch `+marker+`
+echo '{"producesKeys":["service"]}'
+`)
+	err := os.WriteFile(filepath.Join(configDir, "context.json"), []byte(`{"data":{"service":"hello"},"producers":{"service":["kn-cached"]}}`), 0644)
+	assert.NilError(t, err)
+
+	out, err := executePluginCommand("context", "show")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Context sharing is disabled", "service", "hello", "kn-cached"))
+	assert.Assert(t, util.ContainsNone(out, "kn-producer"))
+	// Plugins are not run for their manifests
+	_, err = os.Stat(marker)
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func writeScript(t *testing.T, path string, script string) {
+	assert.NilError(t, os.WriteFile(path, []byte(script), 0777))
+}
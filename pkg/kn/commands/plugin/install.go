@@ -0,0 +1,127 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+)
+
+// pluginInstallFlags contains the flags of 'kn plugin install'
+type pluginInstallFlags struct {
+	index   string
+	version string
+	sha256  string
+	force   bool
+}
+
+// NewPluginInstallCommand creates a new `kn plugin install` command
+func NewPluginInstallCommand(p *commands.KnParams) *cobra.Command {
+	flags := pluginInstallFlags{}
+	cmd := &cobra.Command{
+		Use:   "install SOURCE|NAME",
+		Short: "Install a plugin",
+		Long: `Install a plugin into the plugins directory.
+
+A plugin is installed from a local gzip compressed tar archive, a directory or a
+plugin binary, which all plugin binaries starting with "kn-" are taken from.
+With --index, the plugin with the given name is installed from a plugin index,
+which is a YAML file or a file://, http:// or https:// URL listing the versions
+of plugins with the artifacts and their SHA256 checksums for each platform.
+The index configured as 'plugins.index' is used if no index is given.
+
+The installed plugins and their versions are recorded in the plugins directory,
+so that they can be upgraded and uninstalled later.`,
+		Example: `
+  # Install the plugins of a local archive and verify its checksum
+  kn plugin install ./kn-admin-linux-amd64.tar.gz --sha256 4bf5...e1c0
+
+  # Install the plugins of a directory
+  kn plugin install ./build
+
+  # Install the latest version of the plugin 'admin' from a plugin index
+  kn plugin install admin --index https://plugins.example.com/index.yaml
+
+  # Install version v1.2.0 of the plugin 'admin' from a plugin index
+  kn plugin install admin --index ./index.yaml --version v1.2.0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn plugin install' requires the source or the name of the plugin as single argument")
+			}
+			manager := plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+			if err := manager.ConfigureSignatures(config.GlobalConfig.PluginSignatures()); err != nil {
+				return err
+			}
+			options := plugin.InstallOptions{Version: flags.version, SHA256: flags.sha256, Force: flags.force}
+
+			index := flags.index
+			if index == "" && !isLocalSource(args[0]) {
+				index = config.GlobalConfig.PluginIndex()
+				if index == "" {
+					return fmt.Errorf("cannot install plugin %s: no such file or directory, use --index to install a plugin from a plugin index", args[0])
+				}
+			}
+			var installed *plugin.InstalledPlugin
+			var err error
+			if index != "" {
+				if flags.sha256 != "" {
+					return errors.New("--sha256 can't be used when installing from a plugin index, which contains the checksums")
+				}
+				installed, err = manager.InstallFromIndex(index, args[0], options)
+			} else {
+				installed, err = manager.InstallFromPath(args[0], options)
+			}
+			if err != nil {
+				return err
+			}
+			printSignatureWarnings(cmd, installed)
+			fmt.Fprintf(cmd.OutOrStdout(), "Plugin '%s'%s installed in %s.\n", installed.Name, versionLabel(installed.Version), manager.PluginsDir())
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&flags.index, "index", "", "plugin index to install the plugin with the given name from, a file or a file://, http:// or https:// URL")
+	cmd.Flags().StringVar(&flags.version, "version", "", "version of the plugin to install from the index (default: latest version), or to record for a local source")
+	cmd.Flags().StringVar(&flags.sha256, "sha256", "", "expected SHA256 checksum of a local archive or binary")
+	cmd.Flags().BoolVar(&flags.force, "force", false, "replace a plugin which is already installed")
+	return cmd
+}
+
+// isLocalSource checks whether the source of a plugin is an existing file or directory
+func isLocalSource(source string) bool {
+	_, err := os.Stat(source)
+	return err == nil
+}
+
+// versionLabel formats a version for being appended to the name of a plugin
+func versionLabel(version string) string {
+	if version == "" {
+		return ""
+	}
+	return " " + version
+}
+
+// printSignatureWarnings prints the warnings about unsigned binaries of an installed plugin
+func printSignatureWarnings(cmd *cobra.Command, installed *plugin.InstalledPlugin) {
+	for _, warning := range installed.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: %s\n", warning)
+	}
+}
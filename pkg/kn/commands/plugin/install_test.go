@@ -0,0 +1,136 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/util"
+)
+
+func TestPluginInstallUpgradeUninstall(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+
+	sourceDir := t.TempDir()
+	binary := filepath.Join(sourceDir, "kn-admin")
+	assert.NilError(t, os.WriteFile(binary, []byte("local"), 0755))
+
+	out, err := executePluginCommand("install", binary, "--version", "v0.9.0")
+	assert.NilError(t, err)
+	assert.Equal(t, out, fmt.Sprintf("Plugin 'kn-admin' v0.9.0 installed in %s.\n", pluginDir))
+
+	_, err = executePluginCommand("install", binary)
+	assert.ErrorContains(t, err, "already installed, use --force")
+
+	_, err = executePluginCommand("install", "admin")
+	assert.ErrorContains(t, err, "cannot install plugin admin: no such file or directory, use --index")
+
+	_, err = executePluginCommand("install")
+	assert.ErrorContains(t, err, "requires the source or the name of the plugin")
+
+	indexed := []byte("indexed")
+	sum := sha256.Sum256(indexed)
+	assert.NilError(t, os.WriteFile(filepath.Join(sourceDir, "kn-admin-v1"), indexed, 0644))
+	indexFile := filepath.Join(sourceDir, "index.yaml")
+	assert.NilError(t, os.WriteFile(indexFile, []byte(fmt.Sprintf(`
+plugins:
+- name: kn-admin
+  versions:
+  - version: v1.0.0
+    platforms:
+    - os: %s
+      arch: %s
+      uri: kn-admin-v1
+      sha256: %s
+`, runtime.GOOS, runtime.GOARCH, hex.EncodeToString(sum[:]))), 0644))
+
+	_, err = executePluginCommand("install", "admin", "--index", indexFile, "--sha256", "abcd")
+	assert.ErrorContains(t, err, "--sha256 can't be used when installing from a plugin index")
+
+	out, err = executePluginCommand("upgrade", "--all")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "No plugins installed from a plugin index.\n")
+
+	out, err = executePluginCommand("upgrade", "admin", "--index", indexFile)
+	assert.NilError(t, err)
+	assert.Equal(t, out, "Plugin 'kn-admin' upgraded from v0.9.0 to v1.0.0.\n")
+
+	out, err = executePluginCommand("upgrade", "--all")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "Plugin 'kn-admin' is up to date (v1.0.0).\n")
+
+	_, err = executePluginCommand("upgrade", "admin", "--all")
+	assert.ErrorContains(t, err, "requires the name of the plugin as single argument or --all")
+
+	out, err = executePluginCommand("uninstall", "admin")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "Plugin 'kn-admin' v1.0.0 uninstalled.\n")
+	_, err = os.Stat(filepath.Join(pluginDir, "kn-admin"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	_, err = executePluginCommand("uninstall", "admin")
+	assert.ErrorContains(t, err, "has not been installed with 'kn plugin install'")
+}
+
+func TestPluginInstallFromConfiguredIndex(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+
+	sourceDir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(sourceDir, "admin"), []byte("indexed"), 0644))
+	indexFile := filepath.Join(sourceDir, "index.yaml")
+	assert.NilError(t, os.WriteFile(indexFile, []byte(fmt.Sprintf(`
+plugins:
+- name: admin
+  versions:
+  - version: v1.0.0
+    platforms:
+    - os: %s
+      arch: %s
+      uri: admin
+      sha256: 2ff2a8ad0b2cb56b0e5e4c0a9d3bc6f1fd82e1dc2ce9c53aef9a1ba76a3ec1b0
+`, runtime.GOOS, runtime.GOARCH)), 0644))
+	config.GlobalConfig.(*config.TestConfig).TestPluginIndex = indexFile
+
+	_, err := executePluginCommand("install", "admin")
+	assert.ErrorContains(t, err, "checksum mismatch")
+	_, err = os.Stat(filepath.Join(pluginDir, "kn-admin"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	_, err = executePluginCommand("install", "other")
+	assert.Assert(t, util.ContainsAll(err.Error(), "no plugin kn-other found in plugin index", indexFile))
+}
+
+func executePluginCommand(args ...string) (string, error) {
+	out := new(bytes.Buffer)
+	cmd := NewPluginCommand(&commands.KnParams{})
+	cmd.SetArgs(args)
+	cmd.SetOut(out)
+	cmd.SilenceErrors = true
+	err := cmd.Execute()
+	return out.String(), err
+}
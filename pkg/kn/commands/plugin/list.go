@@ -15,6 +15,7 @@
 package plugin
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -32,6 +33,16 @@ var ValidPluginFilenamePrefixes = []string{"kn"}
 // pluginListFlags contains all plugin commands flags
 type pluginListFlags struct {
 	verbose bool
+	output  string
+}
+
+// pluginInfo is the description of a plugin printed by 'kn plugin list -o json'
+type pluginInfo struct {
+	Name        string                `json:"name"`
+	Command     string                `json:"command"`
+	Path        string                `json:"path,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Flags       []plugin.ManifestFlag `json:"flags,omitempty"`
 }
 
 // NewPluginListCommand creates a new `kn plugin list` command
@@ -49,13 +60,20 @@ Available plugins are those that are:
 - begin with "kn-"
 - Kn's plugin directory
 - Anywhere in the execution $PATH`,
+		Example: `
+  # List all plugins with their description and the flags declared in their manifest as JSON
+  kn plugin list -o json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if plFlags.output != "" && plFlags.output != "json" {
+				return fmt.Errorf("invalid value '%s' for '--output', only 'json' is supported", plFlags.output)
+			}
 			return listPlugins(cmd, plFlags)
 		},
 	}
 
 	// Plugin flags
 	pluginListCommand.Flags().BoolVar(&plFlags.verbose, "verbose", false, "verbose output")
+	pluginListCommand.Flags().StringVarP(&plFlags.output, "output", "o", "", "Output format. One of: json.")
 
 	return pluginListCommand
 }
@@ -63,17 +81,42 @@ Available plugins are those that are:
 // List plugins by looking up in plugin directory and path
 func listPlugins(cmd *cobra.Command, flags pluginListFlags) error {
 	factory := plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+	if err := factory.ConfigureSignatures(config.GlobalConfig.PluginSignatures()); err != nil {
+		return err
+	}
+	verifier := factory.SignatureVerifier()
 
 	pluginsFound, err := factory.ListPlugins()
 	if err != nil {
 		return fmt.Errorf("cannot list plugins in %s (lookup plugins in $PATH: %t): %w", factory.PluginsDir(), factory.LookupInPath(), err)
 	}
 
+	// Plugins are only run for their manifests if asked for details, the manifests known
+	// afterwards are shown by the help and the shell completion without running plugins
+	var manifests map[string]plugin.Manifest
+	if flags.verbose || flags.output == "json" {
+		manifests, err = factory.RefreshManifests()
+		if err != nil {
+			return err
+		}
+	}
+	manifestOf := func(pl plugin.Plugin) *plugin.Manifest {
+		if manifests != nil {
+			return plugin.ManifestOf(manifests, pl)
+		}
+		manifest, _ := factory.CachedManifest(pl)
+		return manifest
+	}
+
 	out := cmd.OutOrStdout()
+	if flags.output == "json" {
+		return printPluginsJSON(cmd, factory, pluginsFound, manifestOf)
+	}
 	if flags.verbose {
 		fmt.Fprintf(out, "The following plugins are available, using options:\n")
 		fmt.Fprintf(out, "  plugins dir: '%s'%s\n", factory.PluginsDir(), extraLabelIfPathNotExists(factory.PluginsDir()))
-		fmt.Fprintf(out, "  lookup plugins in $PATH: %t\n\n", factory.LookupInPath())
+		fmt.Fprintf(out, "  lookup plugins in $PATH: %t\n", factory.LookupInPath())
+		fmt.Fprintf(out, "  signature policy: %s%s\n\n", verifier.Policy(), trustedKeysLabel(verifier))
 	}
 
 	if len(pluginsFound) == 0 {
@@ -89,14 +132,14 @@ func listPlugins(cmd *cobra.Command, flags pluginListFlags) error {
 	eaw = addErrorIfOverwritingExistingCommand(eaw, cmd.Root(), pluginsFound)
 
 	for _, pl := range pluginsFound {
-		desc, _ := pl.Description()
+		desc := plugin.DescriptionOf(pl, manifestOf(pl))
 		if desc != "" {
 			fmt.Fprintf(out, "- %s : %s", pl.Name(), desc)
 		} else {
 			fmt.Fprintf(out, "- %s", pl.Name())
 		}
 		if flags.verbose {
-			fmt.Fprintf(out, "  (%s)\n", pl.Path())
+			fmt.Fprintf(out, "  (%s%s)\n", pl.Path(), signatureLabel(verifier, pl))
 		} else {
 			fmt.Fprintln(out, "")
 		}
@@ -111,6 +154,66 @@ func listPlugins(cmd *cobra.Command, flags pluginListFlags) error {
 	return nil
 }
 
+// printPluginsJSON prints the plugins found as JSON, together with the description and flags
+// declared in their manifests. Validation issues are printed to stderr to keep the output parsable.
+func printPluginsJSON(cmd *cobra.Command, factory *plugin.Manager, pluginsFound plugin.PluginList, manifestOf func(plugin.Plugin) *plugin.Manifest) error {
+	infos := make([]pluginInfo, 0, len(pluginsFound))
+	for _, pl := range pluginsFound {
+		manifest := manifestOf(pl)
+		info := pluginInfo{
+			Name:        pl.Name(),
+			Command:     strings.Join(pl.CommandParts(), " "),
+			Path:        pl.Path(),
+			Description: plugin.DescriptionOf(pl, manifest),
+		}
+		if manifest != nil {
+			info.Flags = manifest.Flags
+		}
+		infos = append(infos, info)
+	}
+	b, err := json.MarshalIndent(infos, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(b))
+
+	if len(pluginsFound) == 0 {
+		return nil
+	}
+	eaw := factory.Verify()
+	eaw = addErrorIfOverwritingExistingCommand(eaw, cmd.Root(), pluginsFound)
+	if !eaw.IsEmpty() {
+		eaw.PrintWarningsAndErrors(cmd.ErrOrStderr())
+	}
+	if eaw.HasErrors() {
+		return fmt.Errorf("plugin validation errors")
+	}
+	return nil
+}
+
+// create a label listing the trusted keys, which can be appended to the signature policy
+func trustedKeysLabel(verifier *plugin.SignatureVerifier) string {
+	if verifier.Policy() == config.SignaturePolicyOff {
+		return ""
+	}
+	names := verifier.KeyNames()
+	if len(names) == 0 {
+		return " (no trusted keys)"
+	}
+	return fmt.Sprintf(" (trusted keys: %s)", strings.Join(names, ", "))
+}
+
+// create a label with the key a plugin is signed with, which can be appended to its path
+func signatureLabel(verifier *plugin.SignatureVerifier, pl plugin.Plugin) string {
+	if verifier.Policy() == config.SignaturePolicyOff || pl.Path() == "" {
+		return ""
+	}
+	if key, err := verifier.Verify(pl.Path()); err == nil {
+		return fmt.Sprintf(", signed by '%s'", key)
+	}
+	return ", not verified"
+}
+
 // create an info label which can be appended to an verbose output
 func extraLabelIfPathNotExists(path string) string {
 	_, err := os.Stat(path)
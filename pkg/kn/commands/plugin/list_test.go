@@ -16,6 +16,10 @@ package plugin
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -24,6 +28,7 @@ import (
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
 	"knative.dev/client/pkg/util"
 
 	"github.com/spf13/cobra"
@@ -129,6 +134,115 @@ func TestPluginListExtendingBuiltinCommandGroup(t *testing.T) {
 	assert.Assert(t, !strings.Contains(out, "ERROR"))
 }
 
+func TestPluginListSignatures(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t, "kn-signed", 0777, "kn-unsigned", 0777)
+	defer cleanupFunc()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NilError(t, err)
+	signed := filepath.Join(pluginDir, "kn-signed")
+	if runtime.GOOS == "windows" {
+		signed += ".bat"
+	}
+	data, err := os.ReadFile(signed)
+	assert.NilError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data))
+	assert.NilError(t, os.WriteFile(signed+plugin.SignatureExtension, []byte(signature), 0644))
+	config.GlobalConfig.(*config.TestConfig).TestPluginSignatures = config.SignaturesConfig{
+		Policy:      config.SignaturePolicyWarn,
+		TrustedKeys: []config.TrustedKey{{Name: "team", Key: base64.StdEncoding.EncodeToString(publicKey)}},
+	}
+
+	outBuf := bytes.Buffer{}
+	testCmd := cobra.Command{Use: "kn"}
+	testCmd.SetOut(&outBuf)
+	testCmd.AddCommand(&cobra.Command{Use: "children"})
+	err = listPlugins(&testCmd, pluginListFlags{verbose: true})
+	assert.NilError(t, err, outBuf.String())
+	out := outBuf.String()
+	assert.Assert(t, util.ContainsAll(out, "signature policy: warn (trusted keys: team)", "signed by 'team'", "not verified", "WARNING", "kn-unsigned", "is not signed"))
+	assert.Assert(t, !strings.Contains(out, ".sig :"))
+
+	config.GlobalConfig.(*config.TestConfig).TestPluginSignatures.Policy = config.SignaturePolicyEnforce
+	outBuf.Reset()
+	err = listPlugins(&testCmd, pluginListFlags{verbose: false})
+	assert.ErrorContains(t, err, "plugin validation errors")
+	assert.Assert(t, util.ContainsAll(outBuf.String(), "ERROR", "kn-unsigned", "is not signed"))
+
+	config.GlobalConfig.(*config.TestConfig).TestPluginSignatures.TrustedKeys[0].Key = "broken"
+	err = listPlugins(&testCmd, pluginListFlags{verbose: false})
+	assert.ErrorContains(t, err, "invalid trusted key 'team'")
+}
+
+func TestPluginListJSON(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugin with a manifest is a bash script")
+	}
+	pluginDir, cleanupFunc := prepareTestSetup(t, "kn-plain", 0777)
+	defer cleanupFunc()
+	err := os.WriteFile(filepath.Join(pluginDir, "kn-func"), []byte(`#!/bin/bash
+echo '{"description":"Manage functions","flags":[{"name":"path","shorthand":"p","description":"Path to the function"}]}'
+`), 0777)
+	assert.NilError(t, err)
+
+	outBuf := bytes.Buffer{}
+	testCmd := cobra.Command{
+		Use: "kn",
+	}
+	testCmd.SetOut(&outBuf)
+	testCmd.AddCommand(&cobra.Command{Use: "children"})
+
+	// Plugins are not run for their manifests by a plain list
+	err = listPlugins(&testCmd, pluginListFlags{})
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(outBuf.String(), "Manage functions"))
+
+	outBuf.Reset()
+	err = listPlugins(&testCmd, pluginListFlags{output: "json"})
+	assert.NilError(t, err)
+
+	var infos []pluginInfo
+	assert.NilError(t, json.Unmarshal(outBuf.Bytes(), &infos))
+	assert.DeepEqual(t, infos, []pluginInfo{
+		{
+			Name:        "kn-func",
+			Command:     "func",
+			Path:        filepath.Join(pluginDir, "kn-func"),
+			Description: "Manage functions",
+			Flags:       []plugin.ManifestFlag{{Name: "path", Shorthand: "p", Description: "Path to the function"}},
+		},
+		{
+			Name:        "kn-plain",
+			Command:     "plain",
+			Path:        filepath.Join(pluginDir, "kn-plain"),
+			Description: filepath.Join(pluginDir, "kn-plain"),
+		},
+	})
+
+	outBuf.Reset()
+	err = listPlugins(&testCmd, pluginListFlags{verbose: true})
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(outBuf.String(), "- kn-func : Manage functions"))
+
+	// but show the manifests cached since
+	outBuf.Reset()
+	err = listPlugins(&testCmd, pluginListFlags{})
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(outBuf.String(), "- kn-func : Manage functions"))
+}
+
+func TestPluginListJSONNoPlugins(t *testing.T) {
+	_, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+
+	out, err := executePluginCommand("list", "-o", "json")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "[]\n")
+
+	_, err = executePluginCommand("list", "-o", "yaml")
+	assert.ErrorContains(t, err, "invalid value 'yaml' for '--output'")
+}
+
 // Private
 
 func prepareTestSetup(t *testing.T, args ...interface{}) (string, func()) {
@@ -139,6 +253,7 @@ func prepareTestSetup(t *testing.T, args ...interface{}) (string, func()) {
 	config.GlobalConfig = &config.TestConfig{
 		TestPluginsDir:          tmpPathDir,
 		TestLookupPluginsInPath: false,
+		TestConfigFile:          filepath.Join(t.TempDir(), "config.yaml"),
 	}
 
 	for i := 0; i < len(args); i += 2 {
@@ -32,6 +32,10 @@ Please refer to the documentation and examples for more information about how to
 	}
 
 	pluginCmd.AddCommand(NewPluginListCommand(p))
+	pluginCmd.AddCommand(NewPluginInstallCommand(p))
+	pluginCmd.AddCommand(NewPluginUninstallCommand(p))
+	pluginCmd.AddCommand(NewPluginUpgradeCommand(p))
+	pluginCmd.AddCommand(NewPluginContextCommand(p))
 
 	return pluginCmd
 }
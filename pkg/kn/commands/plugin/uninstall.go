@@ -0,0 +1,53 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+)
+
+// NewPluginUninstallCommand creates a new `kn plugin uninstall` command
+func NewPluginUninstallCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall NAME",
+		Short: "Uninstall a plugin",
+		Long: `Uninstall a plugin which has been installed with 'kn plugin install'.
+
+All binaries installed with the plugin are removed from the plugins directory.`,
+		Example: `
+  # Uninstall the plugin 'admin'
+  kn plugin uninstall admin`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn plugin uninstall' requires the name of the plugin as single argument")
+			}
+			manager := plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+			uninstalled, err := manager.Uninstall(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Plugin '%s'%s uninstalled.\n", uninstalled.Name, versionLabel(uninstalled.Version))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,101 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+)
+
+// pluginUpgradeFlags contains the flags of 'kn plugin upgrade'
+type pluginUpgradeFlags struct {
+	index string
+	all   bool
+}
+
+// NewPluginUpgradeCommand creates a new `kn plugin upgrade` command
+func NewPluginUpgradeCommand(p *commands.KnParams) *cobra.Command {
+	flags := pluginUpgradeFlags{}
+	cmd := &cobra.Command{
+		Use:   "upgrade NAME|--all",
+		Short: "Upgrade a plugin",
+		Long: `Upgrade a plugin to the latest version of a plugin index.
+
+The plugin is upgraded from the index it has been installed from, unless another
+index is given with --index. Plugins installed from a local source can be upgraded
+from an index with --index, or be installed again with 'kn plugin install --force'.`,
+		Example: `
+  # Upgrade the plugin 'admin' to its latest version
+  kn plugin upgrade admin
+
+  # Upgrade all plugins installed from a plugin index
+  kn plugin upgrade --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager := plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+			if err := manager.ConfigureSignatures(config.GlobalConfig.PluginSignatures()); err != nil {
+				return err
+			}
+			var names []string
+			switch {
+			case flags.all && len(args) == 0:
+				installed, err := manager.InstalledPlugins()
+				if err != nil {
+					return err
+				}
+				for _, pl := range installed {
+					if pl.Index != "" || flags.index != "" {
+						names = append(names, pl.Name)
+					}
+				}
+			case !flags.all && len(args) == 1:
+				names = args
+			default:
+				return errors.New("'kn plugin upgrade' requires the name of the plugin as single argument or --all")
+			}
+
+			out := cmd.OutOrStdout()
+			if len(names) == 0 {
+				fmt.Fprintln(out, "No plugins installed from a plugin index.")
+				return nil
+			}
+			for _, name := range names {
+				previous, upgraded, err := manager.Upgrade(name, flags.index)
+				if err != nil {
+					return err
+				}
+				if upgraded == nil {
+					fmt.Fprintf(out, "Plugin '%s' is up to date (%s).\n", previous.Name, previous.Version)
+					continue
+				}
+				printSignatureWarnings(cmd, upgraded)
+				if previous.Version == "" {
+					fmt.Fprintf(out, "Plugin '%s' upgraded to %s.\n", upgraded.Name, upgraded.Version)
+				} else {
+					fmt.Fprintf(out, "Plugin '%s' upgraded from %s to %s.\n", upgraded.Name, previous.Version, upgraded.Version)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&flags.index, "index", "", "plugin index to upgrade from instead of the index the plugin has been installed from")
+	cmd.Flags().BoolVar(&flags.all, "all", false, "upgrade all plugins installed from a plugin index")
+	return cmd
+}
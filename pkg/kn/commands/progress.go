@@ -0,0 +1,119 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"knative.dev/client/pkg/wait"
+)
+
+// Supported values for the global --progress option
+const (
+	ProgressText = "text"
+	ProgressJSON = "json"
+	ProgressNone = "none"
+)
+
+// ProgressModes are all supported progress modes
+var ProgressModes = []string{ProgressText, ProgressJSON, ProgressNone}
+
+// Progress reports the progress of mutating and waiting operations in the
+// format selected with the global --progress option:
+//
+//   - text: human-readable messages and wait progress (default)
+//   - json: one JSON object per line for every wait event plus a final summary,
+//     human-readable messages are suppressed
+//   - none: human-readable messages only, no intermediate wait progress
+type Progress struct {
+	mode string
+	out  io.Writer
+}
+
+// NewProgress creates a progress reporter writing to out according to the
+// configured progress mode
+func (params *KnParams) NewProgress(out io.Writer) *Progress {
+	mode := params.Progress
+	if mode == "" {
+		mode = ProgressText
+	}
+	return &Progress{mode: mode, out: out}
+}
+
+// ValidateProgressMode checks whether the given mode is a supported progress mode
+func ValidateProgressMode(mode string) error {
+	for _, m := range ProgressModes {
+		if mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value '%s' for --progress, allowed values: %s", mode, strings.Join(ProgressModes, ", "))
+}
+
+// Out returns the writer for human-readable messages. In json mode these
+// messages are discarded so that the output stays parseable.
+func (p *Progress) Out() io.Writer {
+	if p.mode == ProgressJSON {
+		return io.Discard
+	}
+	return p.out
+}
+
+// MessageCallback returns the wait callback for intermediate progress messages
+// of the given resource
+func (p *Progress) MessageCallback(resource string, name string) wait.MessageCallback {
+	switch p.mode {
+	case ProgressJSON:
+		return wait.JSONMessageCallback(p.out, resource, name)
+	case ProgressNone:
+		return wait.NoopMessageCallback()
+	default:
+		return wait.SimpleMessageCallback(p.out)
+	}
+}
+
+// ConditionCallback returns the wait callback for intermediate progress messages of the
+// given resource which also reports the condition carrying them. It is only returned in
+// json mode, the text modes use the message callback.
+func (p *Progress) ConditionCallback(resource string, name string) wait.ConditionCallback {
+	if p.mode != ProgressJSON {
+		return nil
+	}
+	return wait.JSONConditionCallback(p.out, resource, name)
+}
+
+// Summary writes the final record of an operation. It is only written in
+// json mode, as the text modes print their own human-readable summary.
+func (p *Progress) Summary(resource string, name string, namespace string, operation string, elapsed time.Duration, err error) {
+	if p.mode != ProgressJSON {
+		return
+	}
+	summary := wait.ProgressSummary{
+		Resource:  resource,
+		Name:      name,
+		Namespace: namespace,
+		Operation: operation,
+		Phase:     wait.PhaseDone,
+		Elapsed:   float64(elapsed.Round(time.Millisecond)) / float64(time.Second),
+	}
+	if err != nil {
+		summary.Phase = wait.PhaseFailed
+		summary.Error = err.Error()
+	}
+	wait.WriteProgressJSON(p.out, summary)
+}
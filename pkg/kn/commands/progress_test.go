@@ -0,0 +1,76 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/client/pkg/util"
+	"knative.dev/pkg/apis"
+)
+
+func TestValidateProgressMode(t *testing.T) {
+	for _, mode := range ProgressModes {
+		assert.NilError(t, ValidateProgressMode(mode))
+	}
+	err := ValidateProgressMode("xml")
+	assert.ErrorContains(t, err, "invalid value 'xml' for --progress")
+	assert.Assert(t, util.ContainsAll(err.Error(), "text", "json", "none"))
+}
+
+func TestProgressText(t *testing.T) {
+	buf := &bytes.Buffer{}
+	progress := (&KnParams{}).NewProgress(buf)
+	fmt.Fprintln(progress.Out(), "Creating service 'foo'")
+	progress.MessageCallback("service", "foo")(time.Second, "waiting")
+	progress.Summary("service", "foo", "default", "create", time.Second, nil)
+	assert.Equal(t, buf.String(), "Creating service 'foo'\n  1.000s waiting\n")
+}
+
+func TestProgressNone(t *testing.T) {
+	buf := &bytes.Buffer{}
+	progress := (&KnParams{Progress: ProgressNone}).NewProgress(buf)
+	fmt.Fprintln(progress.Out(), "Service 'foo' created")
+	progress.MessageCallback("service", "foo")(time.Second, "waiting")
+	progress.Summary("service", "foo", "default", "create", time.Second, nil)
+	assert.Equal(t, buf.String(), "Service 'foo' created\n")
+}
+
+func TestProgressJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	progress := (&KnParams{Progress: ProgressJSON}).NewProgress(buf)
+	fmt.Fprintln(progress.Out(), "Creating service 'foo'")
+	progress.MessageCallback("service", "foo")(time.Second, "waiting")
+	progress.Summary("service", "foo", "default", "create", 2*time.Second, errors.New("RevisionFailed: boom"))
+	assert.Equal(t, buf.String(),
+		`{"resource":"service","name":"foo","phase":"waiting","condition":"Ready","message":"waiting","elapsed":1}`+"\n"+
+			`{"resource":"service","name":"foo","namespace":"default","operation":"create","phase":"failed","error":"RevisionFailed: boom","elapsed":2}`+"\n")
+}
+
+func TestProgressConditionCallback(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.Assert(t, (&KnParams{}).NewProgress(buf).ConditionCallback("service", "foo") == nil)
+	assert.Assert(t, (&KnParams{Progress: ProgressNone}).NewProgress(buf).ConditionCallback("service", "foo") == nil)
+
+	progress := (&KnParams{Progress: ProgressJSON}).NewProgress(buf)
+	progress.ConditionCallback("service", "foo")(time.Second, apis.Condition{Type: apis.ConditionReady, Reason: "Deploying", Message: "waiting"})
+	assert.Equal(t, buf.String(),
+		`{"resource":"service","name":"foo","phase":"waiting","condition":"Ready","reason":"Deploying","message":"waiting","elapsed":1}`+"\n")
+}
@@ -80,17 +80,20 @@ func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
 				}
 			}
 
+			progress := p.NewProgress(cmd.OutOrStdout())
 			errs := []string{}
 			for _, name := range args {
 				timeout := time.Duration(0)
 				if waitFlags.Wait {
 					timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
 				}
+				start := time.Now()
 				err = client.DeleteRevision(cmd.Context(), name, timeout)
+				progress.Summary("revision", name, namespace, "delete", time.Since(start), err)
 				if err != nil {
 					errs = append(errs, err.Error())
 				} else {
-					fmt.Fprintf(cmd.OutOrStdout(), "Revision '%s' deleted in namespace '%s'.\n", name, namespace)
+					fmt.Fprintf(progress.Out(), "Revision '%s' deleted in namespace '%s'.\n", name, namespace)
 				}
 			}
 			if len(errs) > 0 {
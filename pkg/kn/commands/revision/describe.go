@@ -15,6 +15,7 @@
 package revision
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -24,10 +25,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
 	clientserving "knative.dev/client/pkg/serving"
@@ -63,12 +68,21 @@ func NewRevisionDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(revision, cmd.OutOrStdout())
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, revision, func() (runtime.Object, error) {
+					var service *servingv1.Service
+					if serviceName, ok := revision.Labels[serving.ServiceLabelKey]; ok {
+						service, err = client.GetService(cmd.Context(), serviceName)
+						if err != nil {
+							return nil, err
+						}
+					}
+					var scale *clientserving.RevisionScale
+					if lookup := NewScaleLookup(p, namespace); lookup != nil {
+						scale = lookup.Lookup(cmd.Context(), revision)
+					}
+					return revisionDescription(revision, service, scale), nil
+				})
 			}
 			printDetails, err := cmd.Flags().GetBool("verbose")
 			if err != nil {
@@ -83,25 +97,94 @@ func NewRevisionDescribeCommand(p *commands.KnParams) *cobra.Command {
 				}
 			}
 			// Do the human-readable printing thing.
-			return describe(cmd.OutOrStdout(), revision, service, printDetails)
+			var scale *clientserving.RevisionScale
+			if lookup := NewScaleLookup(p, namespace); lookup != nil {
+				scale = lookup.Lookup(cmd.Context(), revision)
+			}
+			err = describe(cmd.OutOrStdout(), revision, service, scale, printDetails)
+			if err != nil {
+				return err
+			}
+			return commands.WriteEventsIfRequested(cmd, p, namespace, revisionEventObjects(namespace, revision.Name))
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	machineReadablePrintFlags.AddFlags(command)
+	commands.AddModelFlag(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
+	commands.AddEventsFlag(flags)
 	return command
 }
 
-func describe(w io.Writer, revision *servingv1.Revision, service *servingv1.Service, printDetails bool) error {
+// revisionEventObjects returns the revision together with the deployments and pods created for it
+func revisionEventObjects(namespace string, name string) commands.EventObjectsFunc {
+	return func(ctx context.Context, client kubernetes.Interface) ([]commands.EventObject, error) {
+		workloads, err := commands.WorkloadEventObjects(ctx, client, namespace, serving.RevisionLabelKey, name)
+		if err != nil {
+			return nil, err
+		}
+		return append([]commands.EventObject{{Kind: "Revision", Name: name}}, workloads...), nil
+	}
+}
+
+// Description returns the describe model of the given revision, without the information about
+// the service's traffic. The scale is taken from the revision's status if none is given.
+func Description(revision *servingv1.Revision, scale *clientserving.RevisionScale) *v1alpha1.RevisionDescription {
+	desc := &v1alpha1.RevisionDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("RevisionDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(revision.ObjectMeta),
+		Service:    revision.Labels[serving.ServiceLabelKey],
+		Conditions: revision.Status.Conditions,
+	}
+	desc.ConfigurationGeneration, _ = strconv.Atoi(revision.Labels[serving.ConfigurationGenerationLabelKey])
+	if c := clientserving.ContainerOfRevisionSpec(&revision.Spec); c != nil {
+		desc.Image = c.Image
+	}
+	if userImage := clientserving.UserImage(&revision.ObjectMeta); userImage != "" {
+		desc.Image = userImage
+	}
+	if len(revision.Status.ContainerStatuses) > 0 {
+		desc.ImageDigest = revision.Status.ContainerStatuses[0].ImageDigest
+	}
+	if scale == nil {
+		scale = &clientserving.RevisionScale{Desired: revision.Status.DesiredReplicas, Actual: revision.Status.ActualReplicas}
+	}
+	if scale.Desired != nil || scale.Actual != nil {
+		desc.Scale = &v1alpha1.ScaleDescription{Desired: scale.Desired, Actual: scale.Actual}
+	}
+	return desc
+}
+
+// revisionDescription returns the describe model of the given revision including the traffic
+// routed to it by the given service, if any
+func revisionDescription(revision *servingv1.Revision, service *servingv1.Service, scale *clientserving.RevisionScale) *v1alpha1.RevisionDescription {
+	desc := Description(revision, scale)
+	if service == nil {
+		return desc
+	}
+	desc.TrafficPercent, desc.Tags = trafficAndTagsForRevision(revision.Name, service)
+	for _, target := range service.Status.Traffic {
+		if target.RevisionName == revision.Name && target.LatestRevision != nil && *target.LatestRevision {
+			desc.LatestTraffic = true
+		}
+	}
+	desc.LatestCreated = revision.Name == service.Status.LatestCreatedRevisionName
+	desc.LatestReady = revision.Name == service.Status.LatestReadyRevisionName
+	return desc
+}
+
+func describe(w io.Writer, revision *servingv1.Revision, service *servingv1.Service, scale *clientserving.RevisionScale, printDetails bool) error {
 	dw := printers.NewPrefixWriter(w)
 	commands.WriteMetadata(dw, &revision.ObjectMeta, printDetails)
 	WriteImage(dw, revision)
-	WriteReplicas(dw, revision)
+	WriteAutoscaling(dw, revision, scale)
 	WritePort(dw, revision)
 	WriteEnv(dw, revision, printDetails)
 	WriteEnvFrom(dw, revision, printDetails)
-	WriteScale(dw, revision)
+	if scale == nil {
+		WriteScale(dw, revision)
+	}
 	WriteConcurrencyOptions(dw, revision)
 	WriteResources(dw, revision)
 	serviceName, ok := revision.Labels[serving.ServiceLabelKey]
@@ -217,6 +300,56 @@ func WriteReplicas(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	}
 }
 
+// WriteAutoscaling writes the replica counts together with the effective autoscaling settings.
+// It falls back to WriteReplicas if no autoscaling state is available.
+func WriteAutoscaling(dw printers.PrefixWriter, revision *servingv1.Revision, scale *clientserving.RevisionScale) {
+	if scale == nil {
+		WriteReplicas(dw, revision)
+		return
+	}
+	if scale.Actual != nil && scale.Desired != nil {
+		replicas := fmt.Sprintf("%d/%d", *scale.Actual, *scale.Desired)
+		if scale.ScaledToZero {
+			replicas += " (scaled to zero)"
+		}
+		dw.WriteAttribute("Replicas", replicas)
+	}
+	section := dw.WriteAttribute("Autoscaling", "")
+	minScale := int(scale.Min)
+	var maxScale *int
+	if scale.Max > 0 {
+		m := int(scale.Max)
+		maxScale = &m
+	}
+	section.WriteAttribute("Scale", formatScale(&minScale, maxScale))
+	if scale.Target > 0 {
+		section.WriteAttribute("Target", fmt.Sprintf("%s %s (%s%% utilization)", strconv.FormatFloat(scale.Target, 'f', -1, 64), scale.Metric, strconv.FormatFloat(scale.TargetUtilization*100, 'f', -1, 64)))
+	} else {
+		section.WriteAttribute("Metric", scale.Metric)
+	}
+}
+
+// NewScaleLookup returns a lookup for the autoscaling state of revisions, or nil if
+// neither a Kubernetes nor a dynamic client can be created
+func NewScaleLookup(p *commands.KnParams, namespace string) *clientserving.RevisionScaleLookup {
+	var kubeClient kubernetes.Interface
+	if p.NewKubeClient != nil {
+		if kc, err := p.NewKubeClient(); err == nil {
+			kubeClient = kc
+		}
+	}
+	var dynamicClient dynamic.Interface
+	if p.NewDynamicClient != nil {
+		if dc, err := p.NewDynamicClient(namespace); err == nil {
+			dynamicClient = dc.RawClient()
+		}
+	}
+	if kubeClient == nil && dynamicClient == nil {
+		return nil
+	}
+	return clientserving.NewRevisionScaleLookup(kubeClient, dynamicClient)
+}
+
 func WriteScale(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	// Scale spec if given
 	scale, err := clientserving.ScalingInfo(&revision.ObjectMeta)
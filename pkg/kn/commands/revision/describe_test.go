@@ -27,6 +27,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	clienttesting "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -34,6 +36,7 @@ import (
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/yaml"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/util"
 )
@@ -126,6 +129,48 @@ func TestDescribeRevisionBasic(t *testing.T) {
 	assert.Assert(t, util.ContainsAll(data, "EnvFrom:", "cm:test1, cm:test2"))
 }
 
+func TestDescribeRevisionEvents(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3, ptr.Int32(1))
+	kubeClient := kubefake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-rev-deployment-abc", Namespace: commands.FakeNamespace, Labels: map[string]string{apiserving.RevisionLabelKey: "test-rev"}}},
+		&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: commands.FakeNamespace},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "test-rev-deployment-abc"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off pulling image",
+		},
+	)
+
+	knParams := &commands.KnParams{NewKubeClient: func() (kubernetes.Interface, error) { return kubeClient, nil }}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &expectedRevision, nil
+		})
+	cmd.SetArgs([]string{"revision", "describe", "test-rev", "--events"})
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, util.ContainsAll(buf.String(), "Image:", "Events:", "Warning", "BackOff", "pod/test-rev-deployment-abc", "Back-off pulling image"))
+}
+
+func TestDescribeRevisionAutoscaling(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3, ptr.Int32(0))
+	kubeClient := kubefake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config-autoscaler", Namespace: "knative-serving"},
+		Data:       map[string]string{"max-scale": "10"},
+	})
+
+	knParams := &commands.KnParams{NewKubeClient: func() (kubernetes.Interface, error) { return kubeClient, nil }}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &expectedRevision, nil
+		})
+	cmd.SetArgs([]string{"revision", "describe", "test-rev"})
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, util.ContainsAll(buf.String(), "Replicas:", "0/0 (scaled to zero)", "Autoscaling:", "Scale:", "0 ... 10", "Target:", "100 concurrency (70% utilization)"))
+}
+
 func TestDescribeRevisionReplicas(t *testing.T) {
 	expectedRevision := createTestRevision("test-rev", 3, ptr.Int32(1))
 	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
@@ -144,6 +189,50 @@ func TestDescribeRevisionReplicas(t *testing.T) {
 
 }
 
+func TestDescribeRevisionModel(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3, ptr.Int32(2))
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev", "--model", "-o", "json"}, &expectedRevision)
+	assert.NilError(t, err)
+
+	result := v1alpha1.RevisionDescription{}
+	assert.NilError(t, json.Unmarshal([]byte(data), &result))
+	assert.Equal(t, result.Kind, "RevisionDescription")
+	assert.Equal(t, result.Name, "test-rev")
+	assert.Equal(t, result.ConfigurationGeneration, 3)
+	assert.Equal(t, result.Image, "gcr.io/test/image")
+	assert.Equal(t, result.ImageDigest, imageDigest)
+	assert.DeepEqual(t, result.Scale, &v1alpha1.ScaleDescription{Desired: ptr.Int32(2), Actual: ptr.Int32(2)})
+	assert.Equal(t, len(result.Conditions), 2)
+}
+
+func TestRevisionDescriptionWithService(t *testing.T) {
+	revision := createTestRevision("test-rev", 3, nil)
+	service := &servingv1.Service{
+		Status: servingv1.ServiceStatus{
+			ConfigurationStatusFields: servingv1.ConfigurationStatusFields{
+				LatestCreatedRevisionName: "test-rev",
+				LatestReadyRevisionName:   "old-rev",
+			},
+			RouteStatusFields: servingv1.RouteStatusFields{
+				Traffic: []servingv1.TrafficTarget{
+					{RevisionName: "test-rev", Percent: ptr.Int64(20), LatestRevision: ptr.Bool(true)},
+					{RevisionName: "test-rev", Tag: "candidate"},
+					{RevisionName: "old-rev", Percent: ptr.Int64(80)},
+				},
+			},
+		},
+	}
+
+	desc := revisionDescription(&revision, service, nil)
+	assert.Equal(t, desc.TrafficPercent, int64(20))
+	assert.DeepEqual(t, desc.Tags, []string{"candidate"})
+	assert.Assert(t, desc.LatestTraffic)
+	assert.Assert(t, desc.LatestCreated)
+	assert.Assert(t, !desc.LatestReady)
+	assert.Assert(t, desc.Scale == nil)
+}
+
 func createTestRevision(revision string, gen int64, replicas *int32) servingv1.Revision {
 	labels := make(map[string]string)
 	labels[apiserving.ConfigurationGenerationLabelKey] = fmt.Sprintf("%d", gen)
@@ -27,6 +27,8 @@ import (
 const (
 	RevisionTrafficAnnotation = "client.knative.dev/traffic"
 	RevisionTagsAnnotation    = "client.knative.dev/tags"
+	RevisionDesiredAnnotation = "client.knative.dev/desired-scale"
+	RevisionActualAnnotation  = "client.knative.dev/actual-scale"
 )
 
 // Max column size
@@ -41,10 +43,13 @@ func RevisionListHandlers(h hprinters.PrintHandler) {
 		{Name: "Traffic", Type: "string", Description: "Percentage of traffic assigned to this revision.", Priority: 1},
 		{Name: "Tags", Type: "string", Description: "Set of tags assigned to this revision.", Priority: 1},
 		{Name: "Generation", Type: "string", Description: "Generation of the revision", Priority: 1},
+		{Name: "Desired", Type: "string", Description: "Number of pods the autoscaler wants for the revision.", Priority: 1},
+		{Name: "Actual", Type: "string", Description: "Number of pods running for the revision.", Priority: 1},
 		{Name: "Age", Type: "string", Description: "Age of the revision.", Priority: 1},
 		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of the revision.", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the revision.", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the revision.", Priority: 1},
+		{Name: "Image", Type: "string", Description: "Image of the revision, with its digest if resolved.", Priority: hprinters.WideColumnPriority},
 	}
 	h.TableHandler(RevisionColumnDefinitions, printRevision)
 	h.TableHandler(RevisionColumnDefinitions, printRevisionList)
@@ -74,6 +79,8 @@ func printRevision(revision *servingv1.Revision, options hprinters.PrintOptions)
 	traffic := revision.Annotations[RevisionTrafficAnnotation]
 	tags := revision.Annotations[RevisionTagsAnnotation]
 	generation := revision.Labels[serving.ConfigurationGenerationLabelKey]
+	desired := revision.Annotations[RevisionDesiredAnnotation]
+	actual := revision.Annotations[RevisionActualAnnotation]
 	age := commands.TranslateTimestampSince(revision.CreationTimestamp)
 	conditions := commands.ConditionsValue(revision.Status.Conditions)
 	ready := commands.ReadyCondition(revision.Status.Conditions)
@@ -93,13 +100,30 @@ func printRevision(revision *servingv1.Revision, options hprinters.PrintOptions)
 		trunc(traffic),
 		trunc(tags),
 		trunc(generation),
+		trunc(desired),
+		trunc(actual),
 		trunc(age),
 		trunc(conditions),
 		trunc(ready),
 		trunc(reason))
+	if options.Wide {
+		row.Cells = append(row.Cells, revisionImage(revision))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
+// revisionImage returns the image digest of the revision's first container, or
+// its image if the digest hasn't been resolved
+func revisionImage(revision *servingv1.Revision) string {
+	if len(revision.Status.ContainerStatuses) > 0 && revision.Status.ContainerStatuses[0].ImageDigest != "" {
+		return revision.Status.ContainerStatuses[0].ImageDigest
+	}
+	if len(revision.Spec.Containers) > 0 {
+		return revision.Spec.Containers[0].Image
+	}
+	return ""
+}
+
 func trunc(txt string) string {
 	if len(txt) <= ListColumnMaxLength {
 		return txt
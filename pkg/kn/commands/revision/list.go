@@ -24,11 +24,16 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	clientserving "knative.dev/client/pkg/serving"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 )
 
 // Service name filter, used with "-s"
@@ -54,7 +59,10 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
   kn revision list -o json
 
   # List revision 'web'
-  kn revision list web`,
+  kn revision list web
+
+  # List revisions for a service 'svc1' and watch for changes until interrupted
+  kn revision list -s svc1 --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -76,14 +84,24 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			labelSelector, fieldSelector, err := revisionListFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+
 			// Query for list with filters
-			revisionList, err := client.ListRevisions(cmd.Context(), params...)
+			revisionList, err := client.ListRevisions(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)), params...)
+			if err != nil {
+				return err
+			}
+
+			err = revisionListFlags.Filter(revisionList)
 			if err != nil {
 				return err
 			}
 
 			// Stop if nothing found
-			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() && len(revisionList.Items) == 0 {
+			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() && len(revisionList.Items) == 0 && !revisionListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No revisions found.\n")
 				return nil
 			}
@@ -94,22 +112,51 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			// Only add temporary annotations if human readable output is requested
+			scaleLookup := NewScaleLookup(p, namespace)
 			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() {
 				err = enrichRevisionAnnotationsWithServiceData(cmd.Context(), p.NewServingClient, revisionList)
 				if err != nil {
 					return err
 				}
+				enrichRevisionAnnotationsWithScale(cmd.Context(), scaleLookup, revisionList)
 			}
 
 			// Sort revisions by namespace, service, generation (in this order)
 			sortRevisions(revisionList)
 
 			// Print out infos via printer framework
-			return revisionListFlags.Print(revisionList, cmd.OutOrStdout())
+			err = revisionListFlags.Print(revisionList, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+
+			listOptions := clientservingv1.ListConfigs(params).ToListOptions()
+			listOptions.ResourceVersion = revisionList.ResourceVersion
+			toRevision := flags.ConvertTo(func() runtime.Object { return &servingv1.Revision{} })
+			return revisionListFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{servingv1.SchemeGroupVersion.WithResource("revisions")},
+				ListOptions: listOptions,
+				Convert: func(obj *unstructured.Unstructured) (runtime.Object, error) {
+					revision, err := toRevision(obj)
+					if err != nil {
+						return nil, err
+					}
+					// Print the revision as a list to show the same columns as the initial list
+					changed := &servingv1.RevisionList{Items: []servingv1.Revision{*revision.(*servingv1.Revision)}}
+					err = enrichRevisionAnnotationsWithServiceData(cmd.Context(), p.NewServingClient, changed)
+					if err != nil {
+						return nil, err
+					}
+					enrichRevisionAnnotationsWithScale(cmd.Context(), scaleLookup, changed)
+					return changed, nil
+				},
+			})
 		},
 	}
 	commands.AddNamespaceFlags(revisionListCommand.Flags(), true)
 	revisionListFlags.AddFlags(revisionListCommand)
+	revisionListFlags.AddWatchFlag(revisionListCommand)
 	revisionListCommand.Flags().StringVarP(&serviceNameFilter, "service", "s", "", "Service name")
 
 	return revisionListCommand
@@ -223,6 +270,29 @@ func enrichRevisionAnnotationsWithServiceData(ctx context.Context, serviceFactor
 
 }
 
+// Add the desired and actual number of pods, preferably as reported by the autoscaler.
+// Without a lookup, the numbers are taken from the revision's status.
+func enrichRevisionAnnotationsWithScale(ctx context.Context, lookup *clientserving.RevisionScaleLookup, revisionList *servingv1.RevisionList) {
+	for i := range revisionList.Items {
+		revision := &revisionList.Items[i]
+		var scale *clientserving.RevisionScale
+		if lookup != nil {
+			scale = lookup.Lookup(ctx, revision)
+		} else {
+			scale = &clientserving.RevisionScale{Desired: revision.Status.DesiredReplicas, Actual: revision.Status.ActualReplicas}
+		}
+		if revision.Annotations == nil {
+			revision.Annotations = map[string]string{}
+		}
+		if scale.Desired != nil {
+			revision.Annotations[RevisionDesiredAnnotation] = strconv.Itoa(int(*scale.Desired))
+		}
+		if scale.Actual != nil {
+			revision.Annotations[RevisionActualAnnotation] = strconv.Itoa(int(*scale.Actual))
+		}
+	}
+}
+
 // Create a function for being able to lookup a service for an arbitrary namespace
 func serviceLookup(ctx context.Context, serviceFactory serviceFactoryFunc) serviceGetFunc {
 
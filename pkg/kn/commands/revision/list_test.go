@@ -19,9 +19,11 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -29,7 +31,7 @@ import (
 	"knative.dev/client/pkg/util"
 )
 
-var revisionListHeader = []string{"NAME", "SERVICE", "TRAFFIC", "TAGS", "GENERATION", "AGE", "CONDITIONS", "READY", "REASON"}
+var revisionListHeader = []string{"NAME", "SERVICE", "TRAFFIC", "TAGS", "GENERATION", "DESIRED", "ACTUAL", "AGE", "CONDITIONS", "READY", "REASON"}
 
 func fakeRevisionList(args []string, response *servingv1.RevisionList) (action clienttesting.Action, output []string, err error) {
 	knParams := &commands.KnParams{}
@@ -113,6 +115,33 @@ func TestRevisionListDefaultOutput(t *testing.T) {
 	}
 }
 
+func TestRevisionListScale(t *testing.T) {
+	revision1 := createMockRevisionWithParams("foo-abcd", "foo", "2", "100", "")
+	revision1.Status.DesiredReplicas = ptr.Int32(3)
+	revision1.Status.ActualReplicas = ptr.Int32(2)
+	revision2 := createMockRevisionWithParams("foo-wxyz", "foo", "1", "100", "")
+	RevisionList := &servingv1.RevisionList{Items: []servingv1.Revision{*revision1, *revision2}}
+	_, output, err := fakeRevisionList([]string{"revision", "list"}, RevisionList)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[0], "GENERATION", "DESIRED", "ACTUAL", "AGE"))
+	assert.Check(t, util.ContainsAll(output[1], "foo-abcd", "2", "3"))
+	assert.Equal(t, len(strings.Fields(output[1])), len(strings.Fields(output[2]))+2)
+}
+
+func TestRevisionListWide(t *testing.T) {
+	revision1 := createMockRevisionWithParams("foo-abcd", "foo", "2", "100", "")
+	revision1.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo:latest"}}
+	revision1.Status.ContainerStatuses = []servingv1.ContainerStatus{{ImageDigest: "gcr.io/foo@sha256:1234"}}
+	revision2 := createMockRevisionWithParams("foo-wxyz", "foo", "1", "100", "")
+	revision2.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo:v1"}}
+	RevisionList := &servingv1.RevisionList{Items: []servingv1.Revision{*revision1, *revision2}}
+	_, output, err := fakeRevisionList([]string{"revision", "list", "-o", "wide"}, RevisionList)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "REASON", "IMAGE"))
+	assert.Check(t, util.ContainsAll(output[1], "foo-abcd", "gcr.io/foo@sha256:1234"))
+	assert.Check(t, util.ContainsAll(output[2], "foo-wxyz", "gcr.io/foo:v1"))
+}
+
 func TestRevisionListDefaultOutputNoHeaders(t *testing.T) {
 	revision1 := createMockRevisionWithParams("foo-abcd", "foo", "2", "100", "")
 	revision2 := createMockRevisionWithParams("bar-wxyz", "bar", "1", "100", "")
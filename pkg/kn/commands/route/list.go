@@ -20,8 +20,13 @@ import (
 
 	"knative.dev/client/pkg/kn/commands"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands/flags"
@@ -42,7 +47,10 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
   kn route list web -n dev
 
   # List all routes in YAML format
-  kn route list -o yaml`,
+  kn route list -o yaml
+
+  # List all routes and watch for changes until interrupted
+  kn route list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			namespace, err := p.GetNamespace(cmd)
@@ -54,19 +62,30 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			labelSelector, fieldSelector, err := routeListFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			ctx := util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector))
+
 			var routeList *servingv1.RouteList
 			switch len(args) {
 			case 0:
-				routeList, err = client.ListRoutes(cmd.Context())
+				routeList, err = client.ListRoutes(ctx)
 			case 1:
-				routeList, err = client.ListRoutes(cmd.Context(), clientservingv1.WithName(args[0]))
+				routeList, err = client.ListRoutes(ctx, clientservingv1.WithName(args[0]))
 			default:
 				return errors.New("'kn route list' accepts only one additional argument")
 			}
 			if err != nil {
 				return err
 			}
-			if !routeListFlags.GenericPrintFlags.OutputFlagSpecified() && len(routeList.Items) == 0 {
+			err = routeListFlags.Filter(routeList)
+			if err != nil {
+				return err
+			}
+
+			if !routeListFlags.GenericPrintFlags.OutputFlagSpecified() && len(routeList.Items) == 0 && !routeListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No routes found.\n")
 				return nil
 			}
@@ -74,10 +93,25 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return nil
+			return routeListFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{servingv1.SchemeGroupVersion.WithResource("routes")},
+				ListOptions: routeListOptions(routeList, args),
+				Convert:     flags.ConvertTo(func() runtime.Object { return &servingv1.Route{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(routeListCommand.Flags(), true)
 	routeListFlags.AddFlags(routeListCommand)
+	routeListFlags.AddWatchFlag(routeListCommand)
 	return routeListCommand
 }
+
+// routeListOptions returns the options for watching the listed routes
+func routeListOptions(routeList *servingv1.RouteList, args []string) metav1.ListOptions {
+	listOptions := metav1.ListOptions{ResourceVersion: routeList.ResourceVersion}
+	if len(args) == 1 {
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", args[0]).String()
+	}
+	return listOptions
+}
@@ -19,11 +19,11 @@ import (
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/client/pkg/kn/commands/flags"
 	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
 
 	"knative.dev/client/pkg/kn/commands"
 )
@@ -49,7 +49,16 @@ func NewSecretListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			list, err := client.CoreV1().Secrets(namespace).List(cmd.Context(), metav1.ListOptions{})
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors("type")
+			if err != nil {
+				return err
+			}
+			list, err := client.CoreV1().Secrets(namespace).List(cmd.Context(), util.ToListOptions(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector))))
+			if err != nil {
+				return err
+			}
+
+			err = listFlags.Filter(list)
 			if err != nil {
 				return err
 			}
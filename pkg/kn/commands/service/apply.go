@@ -17,6 +17,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -86,21 +87,28 @@ func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			progress := p.NewProgress(cmd.OutOrStdout())
+			start := time.Now()
 			hasChanged, err := client.ApplyService(cmd.Context(), service)
 			if err != nil {
+				progress.Summary("service", service.Name, namespace, "apply", time.Since(start), err)
 				return err
 			}
 			if !hasChanged {
-				fmt.Fprintf(cmd.OutOrStdout(), "No changes to apply to service '%s'.\n", service.Name)
+				fmt.Fprintf(progress.Out(), "No changes to apply to service '%s'.\n", service.Name)
+				progress.Summary("service", service.Name, namespace, "apply", time.Since(start), nil)
 
-				return showUrl(cmd.Context(), client, service.Name, "unchanged", "", cmd.OutOrStdout())
+				return showUrl(cmd.Context(), client, service.Name, "unchanged", "", progress.Out())
 			}
-			return waitIfRequested(cmd.Context(), client, waitFlags, service.Name, waitDoing, waitVerb, "", cmd.OutOrStdout())
+			err = waitIfRequested(cmd.Context(), client, waitFlags, service.Name, waitDoing, waitVerb, "", progress, diagnoserIfRequested(p, client, waitFlags))
+			progress.Summary("service", service.Name, namespace, "apply", time.Since(start), err)
+			return err
 		},
 	}
 	commands.AddNamespaceFlags(serviceApplyCommand.Flags(), false)
 	applyFlags.AddCreateFlags(serviceApplyCommand)
 	waitFlags.AddConditionWaitFlags(serviceApplyCommand, commands.WaitDefaultTimeout, "apply", "service", "ready")
+	waitFlags.AddDiagnoseFlag(serviceApplyCommand, "service")
 	return serviceApplyCommand
 }
 
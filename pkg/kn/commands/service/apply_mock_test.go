@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/kn/commands"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util/mock"
 	"knative.dev/client/pkg/wait"
@@ -133,6 +134,21 @@ func TestServiceApplyWithGetError(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceApplyJSONProgressErrorMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, apierrors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.ApplyService(mock.Any(), false, errors.New("boom!"))
+
+	knParams := &commands.KnParams{Progress: commands.ProgressJSON}
+	output, err := executeServiceCommandWithParams(knParams, client, "apply", "foo", "--image", "gcr.io/foo/bar:baz")
+	assert.ErrorContains(t, err, "boom!")
+	assertFailedSummary(t, output, "apply", "boom!")
+
+	r.Validate()
+}
+
 func setupServiceApplyRecorder(client *knclient.MockKnServingClient, name string, service *servingv1.Service, err error, hasChanged bool) *knclient.ServingRecorder {
 	// Recording:
 	r := client.Recorder()
@@ -96,6 +96,8 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 
 	command.Flags().IntVar(&p.MaxScale, "scale-max", 0, "Maximum number of replicas.")
 	p.markFlagMakesRevision("scale-max")
+	knflags.MarkFlagsConflicting(command.Flags(), "scale", "scale-min")
+	knflags.MarkFlagsConflicting(command.Flags(), "scale", "scale-max")
 
 	command.Flags().IntVar(&p.ScaleActivation, "scale-activation", 0, "Minimum non-zero value that a service should scale to.")
 	p.markFlagMakesRevision("scale-activation")
@@ -191,7 +193,7 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 	p.markFlagMakesRevision("timeout")
 
 	command.Flags().StringVar(&p.Profile, "profile", "",
-		"The profile name must be defined in config.yaml or part of the built-in profile, e.g. Istio. Related annotations and labels will be added to the service."+
+		"The profile name must be defined in config.yaml or part of the built-in profile, e.g. Istio. The settings of the profile like annotations, labels, env vars, resources and scaling will be added to the service, settings given with flags take precedence."+
 			"To unset, specify the profile name followed by a \"-\" (e.g., name-).")
 	p.markFlagMakesRevision("profile")
 }
@@ -251,6 +253,24 @@ func (p *ConfigurationEditFlags) Apply(
 
 	template := &service.Spec.Template
 
+	// Apply the profile first so that the settings given with flags take precedence
+	if cmd.Flags().Changed("profile") {
+		profileName := strings.TrimSuffix(p.Profile, "-")
+		profile := knconfig.GlobalConfig.Profile(profileName)
+		if profile.IsEmpty() {
+			return fmt.Errorf("profile %s doesn't exist", profileName)
+		}
+		var err error
+		if strings.HasSuffix(p.Profile, "-") {
+			err = removeProfile(service, profile)
+		} else {
+			err = applyProfile(service, profileName, profile)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	err := p.PodSpecFlags.ResolvePodSpec(&template.Spec.PodSpec, cmd.Flags(), os.Args)
 	if err != nil {
 		return err
@@ -486,63 +506,6 @@ func (p *ConfigurationEditFlags) Apply(
 		service.Spec.Template.Spec.TimeoutSeconds = &p.TimeoutSeconds
 	}
 
-	if cmd.Flags().Changed("profile") {
-		profileName := ""
-		deleteProfile := false
-		if strings.HasSuffix(p.Profile, "-") {
-			profileName = p.Profile[:len(p.Profile)-1]
-			deleteProfile = true
-		} else {
-			profileName = p.Profile
-			deleteProfile = false
-		}
-
-		if len(knconfig.GlobalConfig.Profile(profileName).Annotations) > 0 || len(knconfig.GlobalConfig.Profile(profileName).Labels) > 0 {
-			annotations := knconfig.GlobalConfig.Profile(profileName).Annotations
-			labels := knconfig.GlobalConfig.Profile(profileName).Labels
-
-			profileAnnotations := make(util.StringMap)
-			for _, value := range annotations {
-				profileAnnotations[value.Name] = value.Value
-			}
-
-			profileLabels := make(util.StringMap)
-			for _, value := range labels {
-				profileLabels[value.Name] = value.Value
-			}
-
-			if deleteProfile {
-				var annotationsToRemove []string
-				for _, value := range annotations {
-					annotationsToRemove = append(annotationsToRemove, value.Name)
-				}
-
-				var labelsToRemove []string
-				for _, value := range labels {
-					labelsToRemove = append(labelsToRemove, value.Name)
-				}
-
-				if err = servinglib.UpdateRevisionTemplateAnnotations(template, map[string]string{}, annotationsToRemove); err != nil {
-					return err
-				}
-				updatedLabels := servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{}, labelsToRemove)
-				service.ObjectMeta.Labels = updatedLabels // In case service.ObjectMeta.Labels was nil
-			} else {
-				if err = servinglib.UpdateRevisionTemplateAnnotations(template, profileAnnotations, []string{}); err != nil {
-					return err
-				}
-				updatedLabels := servinglib.UpdateLabels(service.ObjectMeta.Labels, profileLabels, []string{})
-				service.ObjectMeta.Labels = updatedLabels // In case service.ObjectMeta.Labels was nil
-			}
-
-			if err != nil {
-				return err
-			}
-		} else {
-			return fmt.Errorf("profile %s doesn't exist", profileName)
-		}
-	}
-
 	return nil
 }
 
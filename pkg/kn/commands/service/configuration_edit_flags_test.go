@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/viper"
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/config"
@@ -175,6 +176,100 @@ profiles:
 	assert.Equal(t, svc.ObjectMeta.Labels["environment"], "")
 }
 
+const richProfilesConfig = `
+profiles:
+  base:
+    env:
+    - name: LOG_LEVEL
+      value: info
+    resources:
+      requests:
+        cpu: 100m
+      limits:
+        memory: 256Mi
+    scale:
+      min: 1
+      max: 10
+    node-selector:
+      kubernetes.io/arch: amd64
+    probes:
+      readiness: http::8080:/ready
+  prod:
+    inherit: [base]
+    env:
+    - name: LOG_LEVEL
+      value: warn
+    - name: REGION
+      value: eu
+    concurrency-limit: 50
+    timeout: 120
+    security-context: strict
+    service-account: prod-runner
+`
+
+func TestApplyRichProfileFlag(t *testing.T) {
+	var editFlags ConfigurationEditFlags
+	knParams := &commands.KnParams{}
+	cmd, _, _ := commands.CreateTestKnCommand(NewServiceCreateCommand(knParams), knParams)
+	_, cleanup := setupConfig(t, richProfilesConfig)
+	defer cleanup()
+
+	editFlags.AddCreateFlags(cmd)
+	assert.NilError(t, config.BootstrapConfig())
+
+	svc := createTestService("test-svc", []string{"test-svc-00001"}, goodConditions())
+	cmd.SetArgs([]string{"--profile", "prod", "--scale-min", "3"})
+	cmd.Execute()
+	assert.NilError(t, editFlags.Apply(&svc, nil, cmd))
+
+	template := svc.Spec.Template
+	container := template.Spec.Containers[0]
+	assert.DeepEqual(t, container.Env, []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "warn"}, {Name: "REGION", Value: "eu"}})
+	assert.Equal(t, container.Resources.Requests.Cpu().String(), "100m")
+	assert.Equal(t, container.Resources.Limits.Memory().String(), "256Mi")
+	// Flags take precedence over the profile
+	assert.Equal(t, template.Annotations[autoscaling.MinScaleAnnotationKey], "3")
+	assert.Equal(t, template.Annotations[autoscaling.MaxScaleAnnotationKey], "10")
+	assert.Equal(t, *template.Spec.ContainerConcurrency, int64(50))
+	assert.Equal(t, *template.Spec.TimeoutSeconds, int64(120))
+	assert.Assert(t, container.SecurityContext != nil && *container.SecurityContext.RunAsNonRoot)
+	assert.DeepEqual(t, template.Spec.NodeSelector, map[string]string{"kubernetes.io/arch": "amd64"})
+	assert.Equal(t, template.Spec.ServiceAccountName, "prod-runner")
+	assert.Equal(t, container.ReadinessProbe.HTTPGet.Path, "/ready")
+
+	cmd.SetArgs([]string{"--profile", "prod-"})
+	cmd.Execute()
+	assert.NilError(t, editFlags.Apply(&svc, nil, cmd))
+	template = svc.Spec.Template
+	container = template.Spec.Containers[0]
+	assert.Equal(t, len(container.Env), 0)
+	assert.Equal(t, len(container.Resources.Requests), 0)
+	assert.Equal(t, template.Annotations[autoscaling.MaxScaleAnnotationKey], "")
+	assert.Assert(t, template.Spec.ContainerConcurrency == nil)
+	assert.Assert(t, template.Spec.TimeoutSeconds == nil)
+	assert.Assert(t, container.SecurityContext == nil)
+	assert.Assert(t, container.ReadinessProbe == nil)
+	assert.Equal(t, len(template.Spec.NodeSelector), 0)
+	assert.Equal(t, template.Spec.ServiceAccountName, "")
+}
+
+func TestApplyProfileFlagInvalidSetting(t *testing.T) {
+	var editFlags ConfigurationEditFlags
+	knParams := &commands.KnParams{}
+	cmd, _, _ := commands.CreateTestKnCommand(NewServiceCreateCommand(knParams), knParams)
+	_, cleanup := setupConfig(t, "profiles:\n  broken:\n    resources:\n      limits:\n        memory: lots\n")
+	defer cleanup()
+
+	editFlags.AddCreateFlags(cmd)
+	assert.NilError(t, config.BootstrapConfig())
+
+	svc := createTestService("test-svc", []string{"test-svc-00001"}, goodConditions())
+	cmd.SetArgs([]string{"--profile", "broken"})
+	cmd.Execute()
+	err := editFlags.Apply(&svc, nil, cmd)
+	assert.ErrorContains(t, err, "invalid resource limit 'memory: lots' in profile 'broken'")
+}
+
 func TestApplyProfileFlagError(t *testing.T) {
 	var editFlags ConfigurationEditFlags
 	knParams := &commands.KnParams{}
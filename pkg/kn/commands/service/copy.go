@@ -0,0 +1,362 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/serving/pkg/apis/serving"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+// copyTargetParams returns the parameters for accessing the cluster of the given
+// kubeconfig context, or the given parameters if no context is given
+var copyTargetParams = func(p *commands.KnParams, kubeContext string) *commands.KnParams {
+	if kubeContext == "" {
+		return p
+	}
+	return p.ForContext(kubeContext)
+}
+
+// NewServiceCopyCommand returns a new command for copying a service to another namespace or cluster.
+func NewServiceCopyCommand(p *commands.KnParams) *cobra.Command {
+	var (
+		waitFlags     commands.WaitFlags
+		toNamespace   string
+		toContext     string
+		newName       string
+		withRevisions bool
+		withConfig    bool
+	)
+
+	command := &cobra.Command{
+		Use:   "copy NAME",
+		Short: "Copy a service to another namespace or cluster",
+		Long: `Copy a service to another namespace or cluster
+
+The service is read from the current namespace and context and created in the namespace given with
+'--to-namespace' of the cluster of the kubeconfig context given with '--to-context'. ConfigMaps,
+Secrets, the service account and image pull secrets the service refers to are looked up in the
+target namespace. Use '--with-config' for copying the referenced ConfigMaps and Secrets as well.
+Cluster local addresses of services in the source namespace given in environment variables are
+changed to the target namespace.`,
+		Example: `
+  # Copy service 'hello' from namespace 'staging' to namespace 'prod'
+  kn service copy hello -n staging --to-namespace prod
+
+  # Copy service 'hello' with its routed revisions and the ConfigMaps and Secrets it refers to
+  # into namespace 'prod' of the cluster of kubeconfig context 'prod-cluster'
+  kn service copy hello --to-context prod-cluster --to-namespace prod --with-revisions --with-config
+
+  # Copy service 'hello' to service 'hello-canary' in the same namespace
+  kn service copy hello --name hello-canary`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn service copy' requires name of the service as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			if toNamespace == "" {
+				toNamespace = namespace
+			}
+			if newName == "" {
+				newName = name
+			}
+			if toContext == "" && toNamespace == namespace && newName == name {
+				return errors.New("'kn service copy' requires a different target given with '--to-namespace', '--to-context' or '--name'")
+			}
+
+			source, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			targetParams := copyTargetParams(p, toContext)
+			target, err := targetParams.NewServingClient(toNamespace)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			start := time.Now()
+			progress := p.NewProgress(cmd.OutOrStdout())
+
+			service, err := source.GetService(ctx, name)
+			if err != nil {
+				return err
+			}
+			svcExists, err := serviceExists(ctx, target, newName)
+			if err != nil {
+				return err
+			}
+			if svcExists {
+				return fmt.Errorf("cannot copy service '%s' to namespace '%s' because the service already exists", newName, toNamespace)
+			}
+			export, err := ExportService(ctx, service, source, withRevisions)
+			if err != nil {
+				return err
+			}
+			renameExportedService(export, name, newName)
+			rewriteClusterLocalAddresses(export, namespace, toNamespace)
+
+			configMaps, secrets := configReferences(export)
+			if withConfig {
+				sourceKube, err := p.NewKubeClient()
+				if err != nil {
+					return err
+				}
+				targetKube, err := targetParams.NewKubeClient()
+				if err != nil {
+					return err
+				}
+				err = copyConfig(ctx, sourceKube, targetKube, namespace, toNamespace, configMaps, secrets, progress.Out())
+				if err != nil {
+					return err
+				}
+			} else if len(configMaps)+len(secrets) > 0 && (toContext != "" || toNamespace != namespace) {
+				fmt.Fprintf(progress.Out(), "The service refers to %s, which must exist in namespace '%s'. Use '--with-config' to copy them.\n",
+					strings.Join(append(quoteAll("ConfigMap", configMaps), quoteAll("Secret", secrets)...), ", "), toNamespace)
+			}
+			if sa := export.Spec.Service.Spec.Template.Spec.ServiceAccountName; sa != "" && (toContext != "" || toNamespace != namespace) {
+				fmt.Fprintf(progress.Out(), "The service runs with service account '%s', which must exist in namespace '%s'.\n", sa, toNamespace)
+			}
+
+			err = ImportService(ctx, target, export)
+			if err != nil {
+				return err
+			}
+			err = waitIfRequested(ctx, target, waitFlags, newName, "Copying", "copied", "", progress, nil)
+			progress.Summary("service", newName, toNamespace, "copy", time.Since(start), err)
+			return err
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&toNamespace, "to-namespace", "", "Namespace to copy the service to. Defaults to the namespace of the service.")
+	flags.StringVar(&toContext, "to-context", "", "Kubeconfig context of the cluster to copy the service to. Defaults to the current context.")
+	flags.StringVar(&newName, "name", "", "Name of the copy. Defaults to the name of the service.")
+	flags.BoolVar(&withRevisions, "with-revisions", false, "Copy all routed revisions and the traffic split between them.")
+	flags.BoolVar(&withConfig, "with-config", false, "Copy the ConfigMaps and Secrets the service refers to. Existing ConfigMaps and Secrets are not changed.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "copy", "service", "ready")
+	return command
+}
+
+// renameExportedService changes the name of the exported service and the names of its revisions,
+// which are prefixed by the service name
+func renameExportedService(export *clientv1alpha1.Export, from, to string) {
+	if from == to {
+		return
+	}
+	renameRevision := func(name string) string {
+		if strings.HasPrefix(name, from+"-") {
+			return to + strings.TrimPrefix(name, from)
+		}
+		return name
+	}
+
+	svc := &export.Spec.Service
+	svc.Name = to
+	svc.Spec.Template.Name = renameRevision(svc.Spec.Template.Name)
+	for i := range svc.Spec.Traffic {
+		svc.Spec.Traffic[i].RevisionName = renameRevision(svc.Spec.Traffic[i].RevisionName)
+	}
+	for i := range export.Spec.Revisions {
+		revision := &export.Spec.Revisions[i]
+		revision.Name = renameRevision(revision.Name)
+		for _, label := range []string{serving.ServiceLabelKey, serving.ConfigurationLabelKey} {
+			if revision.Labels[label] == from {
+				revision.Labels[label] = to
+			}
+		}
+	}
+}
+
+// rewriteClusterLocalAddresses changes addresses like 'foo.from.svc.cluster.local' in the
+// values of environment variables to refer to services in the target namespace
+func rewriteClusterLocalAddresses(export *clientv1alpha1.Export, from, to string) {
+	rewritePodSpecAddresses(&export.Spec.Service.Spec.Template.Spec.PodSpec, from, to)
+	for i := range export.Spec.Revisions {
+		rewritePodSpecAddresses(&export.Spec.Revisions[i].Spec.PodSpec, from, to)
+	}
+}
+
+// rewritePodSpecAddresses changes the cluster local addresses of services in namespace from
+// to namespace to in the environment variables of all containers of the pod spec
+func rewritePodSpecAddresses(spec *corev1.PodSpec, from, to string) {
+	if from == "" || from == to {
+		return
+	}
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			for j := range containers[i].Env {
+				env := &containers[i].Env[j]
+				env.Value = strings.ReplaceAll(env.Value, "."+from+".svc", "."+to+".svc")
+			}
+		}
+	}
+}
+
+// configReferences returns the sorted names of the ConfigMaps and Secrets, including image pull
+// secrets, the service and its revisions refer to
+func configReferences(export *clientv1alpha1.Export) ([]string, []string) {
+	configMaps := map[string]bool{}
+	secrets := map[string]bool{}
+	collect := func(spec *corev1.PodSpec) {
+		for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+			for _, container := range containers {
+				for _, envFrom := range container.EnvFrom {
+					if envFrom.ConfigMapRef != nil {
+						configMaps[envFrom.ConfigMapRef.Name] = true
+					}
+					if envFrom.SecretRef != nil {
+						secrets[envFrom.SecretRef.Name] = true
+					}
+				}
+				for _, env := range container.Env {
+					if env.ValueFrom == nil {
+						continue
+					}
+					if env.ValueFrom.ConfigMapKeyRef != nil {
+						configMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+					}
+					if env.ValueFrom.SecretKeyRef != nil {
+						secrets[env.ValueFrom.SecretKeyRef.Name] = true
+					}
+				}
+			}
+		}
+		for _, volume := range spec.Volumes {
+			if volume.ConfigMap != nil {
+				configMaps[volume.ConfigMap.Name] = true
+			}
+			if volume.Secret != nil {
+				secrets[volume.Secret.SecretName] = true
+			}
+			if volume.Projected != nil {
+				for _, source := range volume.Projected.Sources {
+					if source.ConfigMap != nil {
+						configMaps[source.ConfigMap.Name] = true
+					}
+					if source.Secret != nil {
+						secrets[source.Secret.Name] = true
+					}
+				}
+			}
+		}
+		for _, pullSecret := range spec.ImagePullSecrets {
+			secrets[pullSecret.Name] = true
+		}
+	}
+	collect(&export.Spec.Service.Spec.Template.Spec.PodSpec)
+	for i := range export.Spec.Revisions {
+		collect(&export.Spec.Revisions[i].Spec.PodSpec)
+	}
+	return sortedKeys(configMaps), sortedKeys(secrets)
+}
+
+// copyConfig copies the given ConfigMaps and Secrets to the target namespace. ConfigMaps and
+// Secrets which don't exist in the source namespace or already exist in the target are skipped.
+func copyConfig(ctx context.Context, source, target kubernetes.Interface, from, to string, configMaps, secrets []string, out io.Writer) error {
+	for _, name := range configMaps {
+		configMap, err := source.CoreV1().ConfigMaps(from).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			fmt.Fprintf(out, "ConfigMap '%s' not found in namespace '%s', not copied.\n", name, from)
+			continue
+		}
+		if err != nil {
+			return knerrors.GetError(err)
+		}
+		_, err = target.CoreV1().ConfigMaps(to).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: util.CopyObjectMeta(configMap.ObjectMeta, corev1.LastAppliedConfigAnnotation),
+			Data:       configMap.Data,
+			BinaryData: configMap.BinaryData,
+			Immutable:  configMap.Immutable,
+		}, metav1.CreateOptions{})
+		if err := reportConfigCopy(out, "ConfigMap", name, to, err); err != nil {
+			return err
+		}
+	}
+	for _, name := range secrets {
+		secret, err := source.CoreV1().Secrets(from).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			fmt.Fprintf(out, "Secret '%s' not found in namespace '%s', not copied.\n", name, from)
+			continue
+		}
+		if err != nil {
+			return knerrors.GetError(err)
+		}
+		if secret.Type == corev1.SecretTypeServiceAccountToken {
+			fmt.Fprintf(out, "Secret '%s' holds a service account token, not copied.\n", name)
+			continue
+		}
+		_, err = target.CoreV1().Secrets(to).Create(ctx, &corev1.Secret{
+			ObjectMeta: util.CopyObjectMeta(secret.ObjectMeta, corev1.LastAppliedConfigAnnotation),
+			Type:       secret.Type,
+			Data:       secret.Data,
+			Immutable:  secret.Immutable,
+		}, metav1.CreateOptions{})
+		if err := reportConfigCopy(out, "Secret", name, to, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func reportConfigCopy(out io.Writer, kind, name, namespace string, err error) error {
+	if apierrors.IsAlreadyExists(err) {
+		fmt.Fprintf(out, "%s '%s' already exists in namespace '%s', not copied.\n", kind, name, namespace)
+		return nil
+	}
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	fmt.Fprintf(out, "%s '%s' copied to namespace '%s'.\n", kind, name, namespace)
+	return nil
+}
+
+func quoteAll(kind string, names []string) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%s '%s'", kind, name)
+	}
+	return quoted
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,205 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingfake "knative.dev/serving/pkg/client/clientset/versioned/fake"
+
+	"knative.dev/client/pkg/kn/commands"
+	knflags "knative.dev/client/pkg/kn/flags"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+// copyTestCluster holds fake clients for the cluster of a kubeconfig context
+type copyTestCluster struct {
+	serving *servingfake.Clientset
+	kube    *kubefake.Clientset
+}
+
+func newCopyTestCluster(servingObjects []runtime.Object, kubeObjects ...runtime.Object) *copyTestCluster {
+	cluster := &copyTestCluster{
+		serving: servingfake.NewSimpleClientset(servingObjects...),
+		kube:    kubefake.NewSimpleClientset(kubeObjects...),
+	}
+	// The configuration of a service is created by the controller
+	cluster.serving.PrependReactor("create", "services", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		svc := action.(clienttesting.CreateAction).GetObject().(*servingv1.Service)
+		conf := &servingv1.Configuration{ObjectMeta: metav1.ObjectMeta{Name: svc.Name, Namespace: action.GetNamespace()}}
+		return false, nil, cluster.serving.Tracker().Add(conf)
+	})
+	return cluster
+}
+
+func (c *copyTestCluster) params() *commands.KnParams {
+	return &commands.KnParams{
+		ClientConfig: blankConfig,
+		NewServingClient: func(namespace string) (clientservingv1.KnServingClient, error) {
+			return clientservingv1.NewKnServingClient(c.serving.ServingV1(), namespace), nil
+		},
+		NewKubeClient: func() (kubernetes.Interface, error) {
+			return c.kube, nil
+		},
+	}
+}
+
+// executeCopyCommand runs 'kn service copy' with the given clusters for the current
+// and other kubeconfig contexts
+func executeCopyCommand(t *testing.T, current *copyTestCluster, contexts map[string]*copyTestCluster, args ...string) (string, error) {
+	oldTargetParams := copyTargetParams
+	t.Cleanup(func() { copyTargetParams = oldTargetParams })
+	copyTargetParams = func(p *commands.KnParams, kubeContext string) *commands.KnParams {
+		if kubeContext == "" {
+			return p
+		}
+		return contexts[kubeContext].params()
+	}
+
+	output := new(bytes.Buffer)
+	cmd := NewServiceCommand(current.params())
+	cmd.SetArgs(append([]string{"copy"}, args...))
+	cmd.SetOutput(output)
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return knflags.ReconcileBoolFlags(cmd.Flags())
+	}
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func newCopySourceService() []runtime.Object {
+	svc := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "staging"}}
+	svc.Spec.Template.Name = "foo-00002"
+	svc.Spec.Template.Spec.ServiceAccountName = "runner"
+	svc.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry"}}
+	svc.Spec.Template.Spec.Containers = []corev1.Container{{
+		Image:   "gcr.io/foo/bar:v2",
+		EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "cfg"}}}},
+		Env: []corev1.EnvVar{
+			{Name: "BACKEND", Value: "http://backend.staging.svc.cluster.local"},
+			{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "token"}, Key: "token"}}},
+		},
+	}}
+	svc.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(20)},
+		{RevisionName: "foo-00002", Percent: ptr.Int64(80), Tag: "current"},
+	}
+	revisions := make([]runtime.Object, 2)
+	for i, generation := range []string{"1", "2"} {
+		revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo-0000" + generation,
+			Namespace: "staging",
+			Labels: map[string]string{
+				serving.ServiceLabelKey:                 "foo",
+				serving.ConfigurationLabelKey:           "foo",
+				serving.ConfigurationGenerationLabelKey: generation,
+			},
+		}}
+		revision.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v" + generation}}
+		revisions[i] = revision
+	}
+	return append([]runtime.Object{svc}, revisions...)
+}
+
+func TestServiceCopyToNamespace(t *testing.T) {
+	cluster := newCopyTestCluster(newCopySourceService())
+
+	output, err := executeCopyCommand(t, cluster, nil, "foo", "-n", "staging", "--to-namespace", "prod", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' copied in namespace 'prod'."))
+	assert.Assert(t, util.ContainsAll(output, "refers to ConfigMap 'cfg', Secret 'registry', Secret 'token', which must exist in namespace 'prod'"))
+	assert.Assert(t, util.ContainsAll(output, "service account 'runner', which must exist in namespace 'prod'"))
+
+	svc, err := cluster.serving.ServingV1().Services("prod").Get(context.Background(), "foo", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, svc.Spec.Template.Name, "foo-00002")
+	assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Env[0].Value, "http://backend.prod.svc.cluster.local")
+	// Without revisions, the traffic is not copied
+	assert.Equal(t, len(svc.Spec.Traffic), 0)
+}
+
+func TestServiceCopyToContext(t *testing.T) {
+	sourceConfig := []runtime.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cfg", Namespace: "staging", ResourceVersion: "3"}, Data: map[string]string{"a": "b"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "staging"}, Data: map[string][]byte{"token": []byte("secret")}},
+	}
+	current := newCopyTestCluster(newCopySourceService(), sourceConfig...)
+	prod := newCopyTestCluster(nil, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "prod"}})
+
+	output, err := executeCopyCommand(t, current, map[string]*copyTestCluster{"prod-cluster": prod},
+		"foo", "-n", "staging", "--to-context", "prod-cluster", "--to-namespace", "prod", "--name", "bar",
+		"--with-revisions", "--with-config", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "ConfigMap 'cfg' copied to namespace 'prod'."))
+	assert.Assert(t, util.ContainsAll(output, "Secret 'registry' not found in namespace 'staging', not copied."))
+	assert.Assert(t, util.ContainsAll(output, "Secret 'token' already exists in namespace 'prod', not copied."))
+	assert.Assert(t, util.ContainsAll(output, "Service 'bar' copied in namespace 'prod'."))
+
+	ctx := context.Background()
+	_, err = current.serving.ServingV1().Services("prod").Get(ctx, "bar", metav1.GetOptions{})
+	assert.ErrorContains(t, err, "not found")
+
+	svc, err := prod.serving.ServingV1().Services("prod").Get(ctx, "bar", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, svc.Spec.Template.Name, "bar-00002")
+	assert.Equal(t, svc.Spec.Traffic[0].RevisionName, "bar-00001")
+	assert.Equal(t, svc.Spec.Traffic[1].RevisionName, "bar-00002")
+	assert.Equal(t, svc.Spec.Traffic[1].Tag, "current")
+
+	revision, err := prod.serving.ServingV1().Revisions("prod").Get(ctx, "bar-00001", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, revision.Labels[serving.ServiceLabelKey], "bar")
+	assert.Equal(t, revision.OwnerReferences[0].Name, "bar")
+
+	configMap, err := prod.kube.CoreV1().ConfigMaps("prod").Get(ctx, "cfg", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, configMap.Data, map[string]string{"a": "b"})
+	assert.Equal(t, configMap.ResourceVersion, "")
+	secret, err := prod.kube.CoreV1().Secrets("prod").Get(ctx, "token", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, secret.Data == nil)
+}
+
+func TestServiceCopyErrors(t *testing.T) {
+	cluster := newCopyTestCluster(newCopySourceService())
+
+	_, err := executeCopyCommand(t, cluster, nil)
+	assert.ErrorContains(t, err, "requires name of the service as single argument")
+
+	_, err = executeCopyCommand(t, cluster, nil, "foo", "-n", "staging")
+	assert.ErrorContains(t, err, "requires a different target given with '--to-namespace', '--to-context' or '--name'")
+
+	_, err = executeCopyCommand(t, cluster, nil, "bar", "-n", "staging", "--to-namespace", "prod")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = executeCopyCommand(t, cluster, nil, "foo", "-n", "staging", "--name", "foo-00001", "--no-wait")
+	assert.NilError(t, err)
+	_, err = executeCopyCommand(t, cluster, nil, "foo", "-n", "staging", "--name", "foo-00001", "--no-wait")
+	assert.ErrorContains(t, err, "cannot copy service 'foo-00001' to namespace 'staging' because the service already exists")
+}
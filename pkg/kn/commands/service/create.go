@@ -18,7 +18,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"time"
 
@@ -38,6 +37,7 @@ import (
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/serving/diagnose"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 )
 
@@ -148,17 +148,21 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			out := cmd.OutOrStdout()
+			progress := p.NewProgress(cmd.OutOrStdout())
+			start := time.Now()
 			if serviceExists {
 				if !editFlags.ForceCreate {
-					return fmt.Errorf(
+					err = fmt.Errorf(
 						"cannot create service '%s' in namespace '%s' "+
 							"because the service already exists and no --force option was given", service.Name, namespace)
+					progress.Summary("service", service.Name, namespace, "create", time.Since(start), err)
+					return err
 				}
-				err = replaceService(cmd.Context(), client, service, waitFlags, out, targetFlag)
+				err = replaceService(cmd.Context(), client, service, waitFlags, progress, targetFlag, diagnoserIfRequested(p, client, waitFlags))
 			} else {
-				err = createService(cmd.Context(), client, service, waitFlags, out, targetFlag)
+				err = createService(cmd.Context(), client, service, waitFlags, progress, targetFlag, diagnoserIfRequested(p, client, waitFlags))
 			}
+			progress.Summary("service", service.Name, namespace, "create", time.Since(start), err)
 			if err != nil {
 				return err
 			}
@@ -170,31 +174,33 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	editFlags.AddCreateFlags(serviceCreateCommand)
 	trafficFlags.AddTagFlag(serviceCreateCommand)
 	waitFlags.AddConditionWaitFlags(serviceCreateCommand, commands.WaitDefaultTimeout, "create", "service", "ready")
+	waitFlags.AddDiagnoseFlag(serviceCreateCommand, "service")
 	return serviceCreateCommand
 }
 
-func createService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, out io.Writer, targetFlag string) error {
+func createService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, progress *commands.Progress, targetFlag string, diagnoser *diagnose.Diagnoser) error {
 	err := client.CreateService(ctx, service)
 	if err != nil {
 		return err
 	}
 
-	return waitIfRequested(ctx, client, waitFlags, service.Name, "Creating", "created", targetFlag, out)
+	return waitIfRequested(ctx, client, waitFlags, service.Name, "Creating", "created", targetFlag, progress, diagnoser)
 }
 
-func replaceService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, out io.Writer, targetFlag string) error {
+func replaceService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, progress *commands.Progress, targetFlag string, diagnoser *diagnose.Diagnoser) error {
 	changed, err := prepareAndUpdateService(ctx, client, service)
 	if err != nil {
 		return err
 	}
 	if !changed {
-		fmt.Fprintf(out, "Service '%s' replaced in namespace '%s' (unchanged).\n", service.Name, client.Namespace())
+		fmt.Fprintf(progress.Out(), "Service '%s' replaced in namespace '%s' (unchanged).\n", service.Name, client.Namespace())
 		return nil
 	}
-	return waitIfRequested(ctx, client, waitFlags, service.Name, "Replacing", "replaced", targetFlag, out)
+	return waitIfRequested(ctx, client, waitFlags, service.Name, "Replacing", "replaced", targetFlag, progress, diagnoser)
 }
 
-func waitIfRequested(ctx context.Context, client clientservingv1.KnServingClient, waitFlags commands.WaitFlags, serviceName string, verbDoing string, verbDone string, targetFlag string, out io.Writer) error {
+func waitIfRequested(ctx context.Context, client clientservingv1.KnServingClient, waitFlags commands.WaitFlags, serviceName string, verbDoing string, verbDone string, targetFlag string, progress *commands.Progress, diagnoser *diagnose.Diagnoser) error {
+	out := progress.Out()
 	if !waitFlags.Wait || targetFlag != "" {
 		fmt.Fprintf(out, "Service '%s' %s in namespace '%s'.\n", serviceName, verbDone, client.Namespace())
 		return nil
@@ -204,7 +210,7 @@ func waitIfRequested(ctx context.Context, client clientservingv1.KnServingClient
 		Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
 		ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
 	}
-	return waitForServiceToGetReady(ctx, client, serviceName, wconfig, verbDone, out)
+	return waitForServiceToGetReady(ctx, client, serviceName, wconfig, verbDone, progress, diagnoser)
 }
 
 func prepareAndUpdateService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service) (bool, error) {
@@ -237,9 +243,10 @@ func prepareAndUpdateService(ctx context.Context, client clientservingv1.KnServi
 
 }
 
-func waitForServiceToGetReady(ctx context.Context, client clientservingv1.KnServingClient, name string, wconfig clientservingv1.WaitConfig, verbDone string, out io.Writer) error {
+func waitForServiceToGetReady(ctx context.Context, client clientservingv1.KnServingClient, name string, wconfig clientservingv1.WaitConfig, verbDone string, progress *commands.Progress, diagnoser *diagnose.Diagnoser) error {
+	out := progress.Out()
 	fmt.Fprintln(out, "")
-	err := waitForService(ctx, client, name, out, wconfig)
+	_, err := waitForService(ctx, client, name, progress, wconfig, diagnoser)
 	if err != nil {
 		return err
 	}
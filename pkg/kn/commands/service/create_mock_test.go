@@ -28,6 +28,7 @@ import (
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/kn/commands"
 	servinglib "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util/mock"
@@ -62,6 +63,20 @@ func TestServiceCreateImageMock(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceCreateExistingJSONProgressMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", &servingv1.Service{}, nil)
+
+	knParams := &commands.KnParams{Progress: commands.ProgressJSON}
+	output, err := executeServiceCommandWithParams(knParams, client, "create", "foo", "--image", "gcr.io/foo/bar:baz")
+	assert.ErrorContains(t, err, "already exists")
+	assertFailedSummary(t, output, "create", "already exists")
+
+	r.Validate()
+}
+
 func TestServiceCreateEnvMock(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 
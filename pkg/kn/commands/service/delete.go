@@ -84,17 +84,20 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 				}
 			}
 
+			progress := p.NewProgress(cmd.OutOrStdout())
 			errs := []string{}
 			for _, name := range args {
 				timeout := time.Duration(0)
 				if waitFlags.Wait {
 					timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
 				}
+				start := time.Now()
 				err = client.DeleteService(cmd.Context(), name, timeout)
+				progress.Summary("service", name, namespace, "delete", time.Since(start), err)
 				if err != nil {
 					errs = append(errs, err.Error())
 				} else {
-					fmt.Fprintf(cmd.OutOrStdout(), "Service '%s' successfully deleted in namespace '%s'.\n", name, namespace)
+					fmt.Fprintf(progress.Out(), "Service '%s' successfully deleted in namespace '%s'.\n", name, namespace)
 				}
 			}
 			if len(errs) > 0 {
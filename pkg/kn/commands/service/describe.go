@@ -23,13 +23,18 @@ import (
 	"strconv"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
 	"knative.dev/serving/pkg/apis/serving"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands/revision"
 	"knative.dev/client/pkg/kn/plugin"
 	"knative.dev/client/pkg/printers"
+	clientserving "knative.dev/client/pkg/serving"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 
 	"github.com/spf13/cobra"
@@ -63,6 +68,9 @@ type revisionDesc struct {
 	// status info
 	latestCreated bool
 	latestReady   bool
+
+	// autoscaling state, nil if not available
+	scale *clientserving.RevisionScale
 }
 
 // [REMOVE COMMENT WHEN MOVING TO 0.7.0]
@@ -83,10 +91,16 @@ var describe_example = `
   # Print only service URL
   kn service describe svc -o url
 
+  # Print the traffic share of each revision of service 'svc', as computed by describe
+  kn service describe svc --model -o jsonpath='{range .revisions[*]}{.metadata.name}={.trafficPercent}{"\n"}{end}'
+
   # Describe the services in offline mode instead of kubernetes cluster (Beta)
   kn service describe test -n test-ns --target=/user/knfiles
   kn service describe test --target=/user/knfiles/test.yaml
-  kn service describe test --target=/user/knfiles/test.json`
+  kn service describe test --target=/user/knfiles/test.json
+
+  # Show the resources Knative created for service 'hello', e.g. revisions, deployments and pods
+  kn service describe hello --tree`
 
 // NewServiceDescribeCommand returns a new command for describing a service.
 func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -131,18 +145,17 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			// Print out machine readable output if requested
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				out := cmd.OutOrStdout()
-				if strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
-					fmt.Fprintf(out, "%s\n", extractURL(service))
-					return nil
-				}
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
+			if tree, _ := cmd.Flags().GetBool("tree"); tree {
+				if cmd.Flag("target").Value.String() != "" {
+					return errors.New("'--tree' can't be used together with '--target'")
 				}
-				return printer.PrintObj(service, out)
+				return describeTree(cmd, p, namespace, service)
+			}
+
+			// Print only the URL if requested, other machine readable output follows below
+			if machineReadablePrintFlags.OutputFlagSpecified() && strings.ToLower(*machineReadablePrintFlags.OutputFormat) == "url" {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", extractURL(service))
+				return nil
 			}
 
 			printDetails, err = cmd.Flags().GetBool("verbose")
@@ -150,23 +163,123 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			revisionDescs, err := getRevisionDescriptions(cmd.Context(), client, service, printDetails)
+			getRevisions := func() ([]*revisionDesc, error) {
+				revisionDescs, err := getRevisionDescriptions(cmd.Context(), client, service, printDetails)
+				if err != nil {
+					return nil, err
+				}
+				if cmd.Flag("target").Value.String() == "" {
+					addRevisionScales(cmd.Context(), p, namespace, revisionDescs)
+				}
+				return revisionDescs, nil
+			}
+
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, service, func() (runtime.Object, error) {
+					revisionDescs, err := getRevisions()
+					if err != nil {
+						return nil, err
+					}
+					return serviceDescription(service, revisionDescs), nil
+				})
+			}
+
+			revisionDescs, err := getRevisions()
 			if err != nil {
 				return err
 			}
 
-			return describe(cmd.OutOrStdout(), service, revisionDescs, printDetails)
+			err = describe(cmd.OutOrStdout(), service, revisionDescs, printDetails)
+			if err != nil {
+				return err
+			}
+			return commands.WriteEventsIfRequested(cmd, p, namespace, serviceEventObjects(namespace, service, revisionDescs))
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	commands.AddGitOpsFlags(flags)
 	flags.BoolP("verbose", "v", false, "More output.")
+	commands.AddEventsFlag(flags)
+	flags.Bool("tree", false, "Show the tree of resources owned by the service, with their readiness and age.")
 	machineReadablePrintFlags.AddFlags(command)
+	commands.AddModelFlag(flags)
 	command.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	return command
 }
 
+// addRevisionScales adds the autoscaling state to the given revision descriptions, if it can be looked up
+func addRevisionScales(ctx context.Context, p *commands.KnParams, namespace string, revisions []*revisionDesc) {
+	lookup := revision.NewScaleLookup(p, namespace)
+	if lookup == nil {
+		return
+	}
+	for _, desc := range revisions {
+		desc.scale = lookup.Lookup(ctx, desc.revision)
+	}
+}
+
+// serviceEventObjects returns the service, its configuration, route and revisions
+// together with the deployments and pods created for the service
+func serviceEventObjects(namespace string, service *servingv1.Service, revisions []*revisionDesc) commands.EventObjectsFunc {
+	return func(ctx context.Context, client kubernetes.Interface) ([]commands.EventObject, error) {
+		objects := []commands.EventObject{
+			{Kind: "Service", Name: service.Name},
+			{Kind: "Configuration", Name: service.Name},
+			{Kind: "Route", Name: service.Name},
+		}
+		for _, desc := range revisions {
+			objects = append(objects, commands.EventObject{Kind: "Revision", Name: desc.revision.Name})
+		}
+		workloads, err := commands.WorkloadEventObjects(ctx, client, namespace, serving.ServiceLabelKey, service.Name)
+		if err != nil {
+			return nil, err
+		}
+		return append(objects, workloads...), nil
+	}
+}
+
+// serviceDescription returns the describe model of the service with the given revisions
+func serviceDescription(service *servingv1.Service, revisions []*revisionDesc) *v1alpha1.ServiceDescription {
+	desc := &v1alpha1.ServiceDescription{
+		TypeMeta:       commands.DescriptionTypeMeta("ServiceDescription"),
+		ObjectMeta:     commands.DescriptionObjectMeta(service.ObjectMeta),
+		URL:            extractURL(service),
+		ServiceAccount: service.Spec.Template.Spec.ServiceAccountName,
+		Revisions:      make([]v1alpha1.RevisionDescription, 0, len(revisions)),
+		Conditions:     service.Status.Conditions,
+	}
+	if service.Status.Address != nil && service.Status.Address.URL != nil {
+		desc.ClusterURL = service.Status.Address.URL.String()
+	}
+	for _, secret := range service.Spec.Template.Spec.ImagePullSecrets {
+		desc.ImagePullSecrets = append(desc.ImagePullSecrets, secret.Name)
+	}
+	// A revision targeted by several traffic targets, e.g. under different tags, is included once
+	// with the traffic and the tags of all its targets
+	indexByName := map[string]int{}
+	for _, rd := range revisions {
+		i, seen := indexByName[rd.revision.Name]
+		if !seen {
+			revisionDesc := revision.Description(rd.revision, rd.scale)
+			revisionDesc.TypeMeta = metav1.TypeMeta{}
+			revisionDesc.ConfigurationGeneration = rd.configurationGeneration
+			revisionDesc.LatestCreated = rd.latestCreated
+			revisionDesc.LatestReady = rd.latestReady
+			i = len(desc.Revisions)
+			indexByName[rd.revision.Name] = i
+			desc.Revisions = append(desc.Revisions, *revisionDesc)
+		}
+		revisionDesc := &desc.Revisions[i]
+		revisionDesc.TrafficPercent += rd.percent
+		if rd.tag != "" {
+			revisionDesc.Tags = append(revisionDesc.Tags, rd.tag)
+		}
+		revisionDesc.LatestTraffic = revisionDesc.LatestTraffic || (rd.latestTraffic != nil && *rd.latestTraffic)
+	}
+	return desc
+}
+
 // Main action describing the service
 func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc, printDetails bool) error {
 	dw := printers.NewPrefixWriter(w)
@@ -234,12 +347,14 @@ func writeRevisions(dw printers.PrefixWriter, revisions []*revisionDesc, printDe
 			section.WriteAttribute("Error", ready.Reason)
 		}
 		revision.WriteImage(section, revisionDesc.revision)
-		revision.WriteReplicas(section, revisionDesc.revision)
+		revision.WriteAutoscaling(section, revisionDesc.revision, revisionDesc.scale)
 		if printDetails {
 			revision.WritePort(section, revisionDesc.revision)
 			revision.WriteEnv(section, revisionDesc.revision, printDetails)
 			revision.WriteEnvFrom(section, revisionDesc.revision, printDetails)
-			revision.WriteScale(section, revisionDesc.revision)
+			if revisionDesc.scale == nil {
+				revision.WriteScale(section, revisionDesc.revision)
+			}
 			revision.WriteConcurrencyOptions(section, revisionDesc.revision)
 			revision.WriteResources(section, revisionDesc.revision)
 		}
@@ -268,9 +383,10 @@ func revisionHeader(desc *revisionDesc) string {
 		"(" + commands.Age(desc.revision.CreationTimestamp.Time) + ")"
 }
 
-// Format target percentage that it fits in the revision table
+// Format target percentage that it fits in the revision table. Use colors if enabled.
 func formatBullet(percentage int64, status corev1.ConditionStatus) string {
 	symbol := "+"
+	color := printers.ColorGreen
 	switch status {
 	case corev1.ConditionTrue:
 		if percentage > 0 {
@@ -278,13 +394,15 @@ func formatBullet(percentage int64, status corev1.ConditionStatus) string {
 		}
 	case corev1.ConditionFalse:
 		symbol = "!"
+		color = printers.ColorRed
 	default:
 		symbol = "?"
+		color = printers.ColorYellow
 	}
 	if percentage == 0 {
-		return fmt.Sprintf("   %s", symbol)
+		return "   " + printers.Colorize(symbol, color)
 	}
-	return fmt.Sprintf("%3d%s", percentage, symbol)
+	return printers.Colorize(fmt.Sprintf("%3d%s", percentage, symbol), color)
 }
 
 // Call the backend to query revisions for the given service and build up
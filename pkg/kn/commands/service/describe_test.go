@@ -33,6 +33,7 @@ import (
 	api_serving "knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	client_serving "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
@@ -527,6 +528,81 @@ func TestServiceDescribeMachineReadable(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceDescribeModel(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	expectedService := createTestServiceWithServiceAccount("foo", []string{"rev1", "rev2"}, "default-sa", goodConditions())
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	rev2 := createTestRevision("rev2", 2, goodConditions())
+
+	t.Run("json format output", func(t *testing.T) {
+		r.GetService("foo", &expectedService, nil)
+		r.GetRevision("rev1", &rev1, nil)
+		r.GetRevision("rev2", &rev2, nil)
+
+		output, err := executeServiceCommand(client, "describe", "foo", "--model", "-o", "json")
+		assert.NilError(t, err)
+
+		result := v1alpha1.ServiceDescription{}
+		err = json.Unmarshal([]byte(output), &result)
+		assert.NilError(t, err)
+		assert.Equal(t, result.Kind, "ServiceDescription")
+		assert.Equal(t, result.APIVersion, "client.knative.dev/v1alpha1")
+		assert.Equal(t, result.Name, "foo")
+		assert.Equal(t, result.URL, "https://foo.default.example.com")
+		assert.Equal(t, result.ClusterURL, "https://foo.default.svc.cluster.local")
+		assert.Equal(t, result.ServiceAccount, "default-sa")
+		assert.Equal(t, len(result.Revisions), 2)
+		assert.Equal(t, result.Revisions[0].Name, "rev2")
+		assert.Equal(t, result.Revisions[0].TrafficPercent, int64(50))
+		assert.Equal(t, result.Revisions[0].ConfigurationGeneration, 2)
+		assert.Assert(t, result.Revisions[0].LatestReady)
+		assert.Equal(t, result.Revisions[0].Image, "gcr.io/test/image")
+		assert.Equal(t, result.Revisions[1].Name, "rev1")
+		assert.Assert(t, !result.Revisions[1].LatestReady)
+		assert.Equal(t, len(result.Conditions), len(goodConditions()))
+	})
+
+	t.Run("jsonpath output", func(t *testing.T) {
+		r.GetService("foo", &expectedService, nil)
+		r.GetRevision("rev1", &rev1, nil)
+		r.GetRevision("rev2", &rev2, nil)
+
+		output, err := executeServiceCommand(client, "describe", "foo", "--model", "-o", `jsonpath={range .revisions[*]}{.metadata.name}={.trafficPercent} {end}`)
+		assert.NilError(t, err)
+		assert.Equal(t, output, "rev2=50 rev1=50 ")
+	})
+
+	t.Run("revision targeted under several tags", func(t *testing.T) {
+		taggedService := createTestService("foo", []string{"rev1", "rev1"}, goodConditions())
+		taggedService.Status.Traffic[0].Tag = "current"
+		taggedService.Status.Traffic[1].Tag = "stable"
+		r.GetService("foo", &taggedService, nil)
+		r.GetRevision("rev1", &rev1, nil)
+		r.GetRevision("rev1", &rev1, nil)
+
+		output, err := executeServiceCommand(client, "describe", "foo", "--model", "-o", "json")
+		assert.NilError(t, err)
+
+		result := v1alpha1.ServiceDescription{}
+		assert.NilError(t, json.Unmarshal([]byte(output), &result))
+		assert.Equal(t, len(result.Revisions), 1)
+		assert.Equal(t, result.Revisions[0].Name, "rev1")
+		assert.Equal(t, result.Revisions[0].TrafficPercent, int64(100))
+		assert.DeepEqual(t, result.Revisions[0].Tags, []string{"current", "stable"})
+	})
+
+	t.Run("without output format", func(t *testing.T) {
+		r.GetService("foo", &expectedService, nil)
+
+		_, err := executeServiceCommand(client, "describe", "foo", "--model")
+		assert.ErrorContains(t, err, "'--model' requires an output format")
+	})
+
+	r.Validate()
+}
+
 func TestServiceDescribeURL(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 
@@ -0,0 +1,71 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/serving"
+)
+
+// describeTree prints the resources owned by the given service as a tree
+func describeTree(cmd *cobra.Command, p *commands.KnParams, namespace string, service *servingv1.Service) error {
+	dynamicClient, err := p.NewDynamicClient(namespace)
+	if err != nil {
+		return err
+	}
+	root, err := serving.BuildOwnerTree(cmd.Context(), dynamicClient.RawClient(), namespace, service)
+	if err != nil {
+		return fmt.Errorf("cannot look up resources owned by service '%s' in namespace '%s': %w", service.Name, namespace, err)
+	}
+	return writeOwnerTree(cmd.OutOrStdout(), root)
+}
+
+// writeOwnerTree prints a table with one row per resource, the names are indented to show ownership
+func writeOwnerTree(w io.Writer, root *serving.OwnedResource) error {
+	dw := printers.NewPrefixWriter(w)
+	dw.WriteColsLn("NAME", "READY", "REASON", "AGE")
+	root.Walk(func(resource *serving.OwnedResource, depth int, last []bool) {
+		name := treePrefix(last) + resource.Kind + "/" + resource.Name
+		dw.WriteColsLn(name, resource.Ready, resource.Reason, commands.Age(resource.Created))
+	})
+	return dw.Flush()
+}
+
+// treePrefix returns the branches to print in front of a resource, given for each
+// of its ancestors below the root whether it is the last child of its owner
+func treePrefix(last []bool) string {
+	var prefix strings.Builder
+	for i, isLast := range last {
+		switch {
+		case i < len(last)-1 && isLast:
+			prefix.WriteString("  ")
+		case i < len(last)-1:
+			prefix.WriteString("│ ")
+		case isLast:
+			prefix.WriteString("└─")
+		default:
+			prefix.WriteString("├─")
+		}
+	}
+	return prefix.String()
+}
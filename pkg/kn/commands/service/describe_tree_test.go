@@ -0,0 +1,50 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/serving"
+)
+
+func TestWriteOwnerTree(t *testing.T) {
+	root := &serving.OwnedResource{Kind: "Service", Name: "foo", Ready: "True", Children: []*serving.OwnedResource{
+		{Kind: "Configuration", Name: "foo", Ready: "True", Children: []*serving.OwnedResource{
+			{Kind: "Revision", Name: "foo-00001", Ready: "False", Reason: "ContainerMissing", Children: []*serving.OwnedResource{
+				{Kind: "Deployment", Name: "foo-00001-deployment", Ready: "False"},
+			}},
+		}},
+		{Kind: "Route", Name: "foo", Ready: "True", Children: []*serving.OwnedResource{
+			{Kind: "Ingress", Name: "foo", Ready: "True"},
+		}},
+	}}
+
+	out := &strings.Builder{}
+	assert.NilError(t, writeOwnerTree(out, root))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, len(lines), 7)
+	assert.Assert(t, strings.HasPrefix(lines[0], "NAME"))
+	assert.Assert(t, strings.HasPrefix(lines[1], "Service/foo "))
+	assert.Assert(t, strings.HasPrefix(lines[2], "├─Configuration/foo "))
+	assert.Assert(t, strings.HasPrefix(lines[3], "│ └─Revision/foo-00001 "))
+	assert.Assert(t, strings.Contains(lines[3], "ContainerMissing"))
+	assert.Assert(t, strings.HasPrefix(lines[4], "│   └─Deployment/foo-00001-deployment "))
+	assert.Assert(t, strings.HasPrefix(lines[5], "└─Route/foo "))
+	assert.Assert(t, strings.HasPrefix(lines[6], "  └─Ingress/foo "))
+}
@@ -0,0 +1,102 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/serving/diagnose"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var diagnoseExample = `
+  # Show likely causes why service 'svc' is not ready
+  kn service diagnose svc
+
+  # Append the diagnosis to the error when waiting for a new service times out
+  kn service create svc --image knativesamples/helloworld --wait-timeout 60 --wait-diagnose`
+
+// NewServiceDiagnoseCommand represents 'kn service diagnose' command
+func NewServiceDiagnoseCommand(p *commands.KnParams) *cobra.Command {
+	command := &cobra.Command{
+		Use:               "diagnose NAME",
+		Short:             "Show likely causes why a service is not ready",
+		Example:           diagnoseExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service diagnose' requires the service name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			findings, err := newDiagnoser(p, client).Diagnose(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if len(findings) == 0 {
+				fmt.Fprintf(out, "No problems found for Service '%s' in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Likely causes why Service '%s' in namespace '%s' is not ready:\n\n", name, namespace)
+			diagnose.WriteFindings(out, findings)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	return command
+}
+
+// newDiagnoser creates a diagnoser for the given serving client. The Kubernetes and
+// dynamic clients are optional, checks which need them are skipped if they can't be created.
+func newDiagnoser(p *commands.KnParams, client clientservingv1.KnServingClient) *diagnose.Diagnoser {
+	var kubeClient kubernetes.Interface
+	if p.NewKubeClient != nil {
+		if kc, err := p.NewKubeClient(); err == nil {
+			kubeClient = kc
+		}
+	}
+	var dynamicClient dynamic.Interface
+	if p.NewDynamicClient != nil {
+		if dc, err := p.NewDynamicClient(client.Namespace()); err == nil {
+			dynamicClient = dc.RawClient()
+		}
+	}
+	return diagnose.NewDiagnoser(client, kubeClient, dynamicClient)
+}
+
+// diagnoserIfRequested returns a diagnoser when --wait-diagnose has been given, nil otherwise
+func diagnoserIfRequested(p *commands.KnParams, client clientservingv1.KnServingClient, waitFlags commands.WaitFlags) *diagnose.Diagnoser {
+	if !waitFlags.Diagnose {
+		return nil
+	}
+	return newDiagnoser(p, client)
+}
@@ -0,0 +1,89 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServiceDiagnose(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getNotReadyService("foo"), nil)
+	r.GetRoute("foo", &servingv1.Route{}, nil)
+
+	output, err := executeServiceCommand(client, "diagnose", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Likely causes", "'foo'", "1. [high] Service foo: no revision has been created yet", "2. [info] Service foo: condition Ready is False (RevisionMissing)"))
+	r.Validate()
+}
+
+func TestServiceDiagnoseNoProblems(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := getServiceWithUrl("foo", "http://foo.example.com")
+	service.Status.LatestCreatedRevisionName = "foo-00001"
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00001", &servingv1.Revision{}, nil)
+	r.GetRoute("foo", &servingv1.Route{}, nil)
+
+	output, err := executeServiceCommand(client, "diagnose", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No problems found", "'foo'"))
+	r.Validate()
+}
+
+func TestServiceDiagnoseNoName(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "diagnose")
+	assert.ErrorContains(t, err, "requires the service name")
+}
+
+func TestServiceCreateWaitDiagnose(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), fmt.Errorf("%w: service 'foo' not ready after 1 seconds", wait.ErrTimeout), time.Second)
+	r.GetService("foo", getNotReadyService("foo"), nil)
+	r.GetRoute("foo", &servingv1.Route{}, nil)
+
+	_, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--wait-timeout", "1", "--wait-diagnose")
+	assert.ErrorContains(t, err, "not ready after 1 seconds")
+	assert.Assert(t, util.ContainsAll(err.Error(), "Diagnosis:", "no revision has been created yet"))
+	r.Validate()
+}
+
+func getNotReadyService(name string) *servingv1.Service {
+	service := getServiceWithUrl(name, "http://foo.example.com")
+	service.Status.Conditions = duckv1.Conditions{
+		{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Reason: "RevisionMissing"},
+	}
+	return service
+}
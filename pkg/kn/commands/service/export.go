@@ -160,6 +160,12 @@ func exportService(cmd *cobra.Command, service *servingv1.Service, client client
 	return printer.PrintObj(knExport, cmd.OutOrStdout())
 }
 
+// ExportService returns the service together with its routed revisions, if withRevisions is true,
+// in the format written by 'kn service export --mode=export' and read by 'kn service import'
+func ExportService(ctx context.Context, service *servingv1.Service, client clientservingv1.KnServingClient, withRevisions bool) (*clientv1alpha1.Export, error) {
+	return exportForKNImport(ctx, service.DeepCopy(), client, withRevisions)
+}
+
 func exportLatestService(latestSvc *servingv1.Service, withRoutes bool) *servingv1.Service {
 	exportedSvc := servingv1.Service{
 		ObjectMeta: metav1.ObjectMeta{
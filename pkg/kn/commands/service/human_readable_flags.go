@@ -34,6 +34,7 @@ func ServiceListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of service components.", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the service.", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the service.", Priority: 1},
+		{Name: "Image", Type: "string", Description: "Image of the service's container.", Priority: hprinters.WideColumnPriority},
 	}
 
 	h.TableHandler(kServiceColumnDefinitions, printKService)
@@ -83,5 +84,17 @@ func printKService(kService *servingv1.Service, options hprinters.PrintOptions)
 		conditions,
 		ready,
 		reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, serviceImage(kService))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
+
+// serviceImage returns the image of the service's first container
+func serviceImage(service *servingv1.Service) string {
+	containers := service.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return ""
+	}
+	return containers[0].Image
+}
@@ -15,39 +15,57 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/util/retry"
 
 	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	"knative.dev/client/pkg/config"
 	"knative.dev/client/pkg/kn/commands"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/pkg/kmeta"
+	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
 // NewServiceImportCommand returns a new command for importing a service.
 func NewServiceImportCommand(p *commands.KnParams) *cobra.Command {
-	var waitFlags commands.WaitFlags
+	var (
+		waitFlags commands.WaitFlags
+		force     bool
+	)
 
 	command := &cobra.Command{
 		Use:   "import FILENAME",
-		Short: "Import a service and its revisions (experimental)",
+		Short: "Import a service and its revisions",
+		Long: `Import a service and its revisions from a file written by 'kn service export'
+
+Files written with '--mode=export' contain the service and its routed revisions, which are created
+with their original names. Files written with '--mode=replay' contain the service once for every
+revision, which is applied one after the other so that each revision is created by the controller.
+In both modes the traffic split including tags is restored as exported. Resources are imported into
+the namespace given with '--namespace', also if the file refers to another namespace.`,
 		Example: `
- # Import a service from YAML file (Beta)
- kn service import /path/to/file.yaml
+  # Import a service from YAML file
+  kn service import /path/to/file.yaml
+
+  # Import a service from JSON file
+  kn service import /path/to/file.json
 
- # Import a service from JSON file (Beta)
- kn service import /path/to/file.json`,
+  # Import a service into namespace 'prod', updating the service if it already exists
+  kn service import /path/to/file.yaml -n prod --force`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return errors.New("'kn service import' requires filename of import file as single argument")
@@ -64,73 +82,239 @@ func NewServiceImportCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return importWithOwnerRef(cmd.Context(), client, filename, cmd.OutOrStdout(), waitFlags)
+			imported, err := readImportFile(filename)
+			if err != nil {
+				return err
+			}
+			return importWithOwnerRef(cmd.Context(), client, imported, force, p.NewProgress(cmd.OutOrStdout()), waitFlags)
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	flags.BoolVar(&force, "force", false, "Update the service if it already exists. Revisions which already exist are kept.")
 	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "import", "service", "ready")
 
 	return command
 }
 
-func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingClient, filename string, out io.Writer, waitFlags commands.WaitFlags) error {
-	var export clientv1alpha1.Export
-	file, err := os.Open(filename)
-	if err != nil {
-		return err
+// serviceImport is the content of an import file, either a service with its revisions as
+// exported with '--mode=export' or the service for every revision as exported with '--mode=replay'
+type serviceImport struct {
+	export *clientv1alpha1.Export
+	replay []servingv1.Service
+}
+
+func (i *serviceImport) name() string {
+	if i.export != nil {
+		return i.export.Spec.Service.Name
 	}
-	decoder := yaml.NewYAMLOrJSONDecoder(file, 512)
-	err = decoder.Decode(&export)
+	return i.replay[0].Name
+}
+
+// readImportFile reads an export of kind Export, a single Service or a List of services
+func readImportFile(filename string) (*serviceImport, error) {
+	data, err := os.ReadFile(filename)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if export.Spec.Service.Name == "" {
-		return fmt.Errorf("provided import file doesn't contain service name, please note that only kn's custom export format is supported")
+	decode := func(into interface{}) error {
+		return yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 512).Decode(into)
+	}
+	var typeMeta metav1.TypeMeta
+	if err := decode(&typeMeta); err != nil {
+		return nil, err
 	}
 
-	serviceName := export.Spec.Service.Name
+	switch typeMeta.Kind {
+	case "Export":
+		var export clientv1alpha1.Export
+		if err := decode(&export); err != nil {
+			return nil, err
+		}
+		if export.Spec.Service.Name == "" {
+			return nil, fmt.Errorf("provided import file doesn't contain service name, please note that only kn's custom export format is supported")
+		}
+		return &serviceImport{export: &export}, nil
+	case "Service":
+		var service servingv1.Service
+		if err := decode(&service); err != nil {
+			return nil, err
+		}
+		if service.Name == "" {
+			return nil, fmt.Errorf("import file '%s' doesn't contain the name of the service", filename)
+		}
+		return &serviceImport{export: &clientv1alpha1.Export{Spec: clientv1alpha1.ExportSpec{Service: service}}}, nil
+	case "List", "ServiceList":
+		var list servingv1.ServiceList
+		if err := decode(&list); err != nil {
+			return nil, err
+		}
+		if len(list.Items) == 0 {
+			return nil, fmt.Errorf("import file '%s' doesn't contain any service", filename)
+		}
+		for _, service := range list.Items {
+			if service.Name != list.Items[0].Name {
+				return nil, fmt.Errorf("import file '%s' contains the services '%s' and '%s', but only a single service can be imported",
+					filename, list.Items[0].Name, service.Name)
+			}
+		}
+		return &serviceImport{replay: list.Items}, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind '%s' in import file '%s', only files written by 'kn service export' can be imported", typeMeta.Kind, filename)
+	}
+}
 
-	// Return error if service already exists
+// setNamespace moves the imported resources to the given namespace. Cluster local addresses
+// of services in the namespace given in the file are changed to the new namespace.
+func (i *serviceImport) setNamespace(namespace string) {
+	if i.export != nil {
+		rewriteClusterLocalAddresses(i.export, i.export.Spec.Service.Namespace, namespace)
+		i.export.Spec.Service.Namespace = namespace
+		for j := range i.export.Spec.Revisions {
+			i.export.Spec.Revisions[j].Namespace = namespace
+		}
+		return
+	}
+	for j := range i.replay {
+		service := &i.replay[j]
+		rewritePodSpecAddresses(&service.Spec.Template.Spec.PodSpec, service.Namespace, namespace)
+		service.Namespace = namespace
+	}
+}
+
+func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingClient, imported *serviceImport, force bool, progress *commands.Progress, waitFlags commands.WaitFlags) error {
+	start := time.Now()
+	serviceName := imported.name()
+
+	// Return error if service already exists, unless it should be updated
 	svcExists, err := serviceExists(ctx, client, serviceName)
 	if err != nil {
 		return err
 	}
-	if svcExists {
-		return fmt.Errorf("cannot import service '%s' in namespace '%s' because the service already exists",
+	if svcExists && !force {
+		err = fmt.Errorf("cannot import service '%s' in namespace '%s' because the service already exists, use '--force' to update it",
 			serviceName, client.Namespace())
+		progress.Summary("service", serviceName, client.Namespace(), "import", time.Since(start), err)
+		return err
 	}
 
-	err = client.CreateService(ctx, &export.Spec.Service)
+	imported.setNamespace(client.Namespace())
+	if imported.export != nil {
+		err = importExport(ctx, client, imported.export, svcExists, progress.Out())
+	} else {
+		timeout := time.Duration(waitFlags.TimeoutInSeconds) * time.Second
+		err = importReplay(ctx, client, imported.replay, svcExists, timeout, progress.Out())
+	}
+	if err != nil {
+		progress.Summary("service", serviceName, client.Namespace(), "import", time.Since(start), err)
+		return err
+	}
+
+	err = waitIfRequested(ctx, client, waitFlags, serviceName, "Importing", "imported", "", progress, nil)
+	progress.Summary("service", serviceName, client.Namespace(), "import", time.Since(start), err)
+	return err
+}
+
+// ImportService creates the service of an export and its revisions, which are owned
+// by the service's configuration like the revisions created by the controller
+func ImportService(ctx context.Context, client clientservingv1.KnServingClient, export *clientv1alpha1.Export) error {
+	return importExport(ctx, client, export, false, io.Discard)
+}
+
+// importExport creates or updates the service of an export and creates its revisions.
+// When updating, revisions which already exist are skipped.
+func importExport(ctx context.Context, client clientservingv1.KnServingClient, export *clientv1alpha1.Export, update bool, out io.Writer) error {
+	err := createOrReplaceService(ctx, client, &export.Spec.Service, update)
 	if err != nil {
 		return err
 	}
 
 	// Retrieve current Configuration to be use in OwnerReference
-	currentConf, err := getConfigurationWithRetry(ctx, client, serviceName)
+	currentConf, err := getConfigurationWithRetry(ctx, client, export.Spec.Service.Name)
 	if err != nil {
 		return err
 	}
 
 	// Create revision with current Configuration's OwnerReference
-	if len(export.Spec.Revisions) > 0 {
-		for _, r := range export.Spec.Revisions {
-			tmp := r.DeepCopy()
-			// OwnerRef ensures that Revisions are recognized by controller
-			tmp.OwnerReferences = []metav1.OwnerReference{*kmeta.NewControllerRef(currentConf)}
-			if err = client.CreateRevision(ctx, tmp); err != nil {
-				return err
-			}
+	for i, r := range export.Spec.Revisions {
+		tmp := r.DeepCopy()
+		// OwnerRef ensures that Revisions are recognized by controller
+		tmp.OwnerReferences = []metav1.OwnerReference{*kmeta.NewControllerRef(currentConf)}
+		err = client.CreateRevision(ctx, tmp)
+		if update && apierrors.IsAlreadyExists(err) {
+			fmt.Fprintf(out, "Revision '%s' already exists (%d/%d).\n", tmp.Name, i+1, len(export.Spec.Revisions))
+			continue
+		}
+		if err != nil {
+			return err
 		}
+		fmt.Fprintf(out, "Revision '%s' created (%d/%d).\n", tmp.Name, i+1, len(export.Spec.Revisions))
 	}
+	return nil
+}
 
-	err = waitIfRequested(ctx, client, waitFlags, serviceName, "Importing", "imported", "", out)
-	if err != nil {
-		return err
+// importReplay applies the service of every revision one after the other. Before applying the
+// next one, it waits for the revision to be created, as the controller only creates a revision
+// for the latest generation of the configuration.
+func importReplay(ctx context.Context, client clientservingv1.KnServingClient, services []servingv1.Service, update bool, timeout time.Duration, out io.Writer) error {
+	for i := range services {
+		service := &services[i]
+		err := createOrReplaceService(ctx, client, service, update || i > 0)
+		if err != nil {
+			return err
+		}
+		revisionName := service.Spec.Template.Name
+		if i == len(services)-1 || revisionName == "" {
+			continue
+		}
+		if err := waitForRevision(ctx, client, revisionName, timeout); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Revision '%s' created (%d/%d).\n", revisionName, i+1, len(services)-1)
+	}
+	return nil
+}
+
+// createOrReplaceService creates the service or replaces the spec, labels and annotations
+// of the existing service
+func createOrReplaceService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, replace bool) error {
+	if !replace {
+		return client.CreateService(ctx, service)
 	}
+	_, err := client.UpdateServiceWithRetry(ctx, service.Name, func(origService *servingv1.Service) (*servingv1.Service, error) {
+		updated := origService.DeepCopy()
+		updated.Spec = service.Spec
+		updated.Labels = service.Labels
+		updated.Annotations = map[string]string{}
+		for key, value := range service.Annotations {
+			updated.Annotations[key] = value
+		}
+		// The creator and last modifier are maintained by the server
+		for _, key := range []string{serving.CreatorAnnotation, serving.UpdaterAnnotation} {
+			if value, ok := origService.Annotations[key]; ok {
+				updated.Annotations[key] = value
+			}
+		}
+		return updated, nil
+	}, config.DefaultRetry.Steps)
 	return err
 }
 
+// waitForRevision waits until the revision with the given name has been created by the controller
+func waitForRevision(ctx context.Context, client clientservingv1.KnServingClient, name string, timeout time.Duration) error {
+	err := k8swait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		_, err := client.GetRevision(ctx, name)
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return fmt.Errorf("revision '%s' of service has not been created: %w", name, err)
+	}
+	return nil
+}
+
 func getConfigurationWithRetry(ctx context.Context, client clientservingv1.KnServingClient, name string) (*servingv1.Configuration, error) {
 	var conf *servingv1.Configuration
 	var err error
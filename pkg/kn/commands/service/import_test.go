@@ -15,6 +15,7 @@
 package service
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -23,10 +24,12 @@ import (
 	"gotest.tools/v3/assert"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/client/pkg/kn/commands"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 	"knative.dev/client/pkg/wait"
+	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
@@ -270,3 +273,205 @@ spec:
         revisionName: foo-rev-3
     status: {}
 `
+
+func TestServiceImportReplay(t *testing.T) {
+	file, err := generateFile(t, []byte(exportReplayYAML))
+	assert.NilError(t, err)
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Spec.Template.Name, "foo-rev-1")
+		assert.Equal(t, svc.Namespace, "default")
+	}, nil)
+	r.GetRevision("foo-rev-1", &servingv1.Revision{}, nil)
+	r.GetService("foo", &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Spec.Template.Name, "foo-rev-2")
+		assert.Equal(t, len(svc.Spec.Traffic), 0)
+	}, true, nil)
+	r.GetRevision("foo-rev-2", &servingv1.Revision{}, nil)
+	r.GetService("foo", &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Spec.Template.Name, "foo-rev-3")
+		assert.Equal(t, svc.Spec.Traffic[0].RevisionName, "foo-rev-1")
+		assert.Equal(t, svc.Spec.Traffic[0].Tag, "old")
+		assert.Equal(t, *svc.Spec.Traffic[2].Percent, int64(50))
+	}, true, nil)
+
+	out, err := executeServiceCommand(client, "import", file, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Revision 'foo-rev-1' created (1/2)", "Revision 'foo-rev-2' created (2/2)", "Service 'foo' imported"))
+	r.Validate()
+}
+
+func TestServiceImportForce(t *testing.T) {
+	file, err := generateFile(t, []byte(exportWithRevisionsYAML))
+	assert.NilError(t, err)
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	existing := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:        "foo",
+		Annotations: map[string]string{serving.CreatorAnnotation: "admin", "other": "value"},
+	}}
+	r.GetService("foo", existing, nil)
+	r.GetService("foo", existing, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Spec.Template.Name, "foo-rev-3")
+		assert.Equal(t, len(svc.Spec.Traffic), 3)
+		assert.DeepEqual(t, svc.Annotations, map[string]string{serving.CreatorAnnotation: "admin"})
+	}, true, nil)
+	r.GetConfiguration("foo", getConfiguration("foo"), nil)
+	r.CreateRevision(mock.Any(), errors.NewAlreadyExists(servingv1.Resource("revision"), "foo-rev-1"))
+	r.CreateRevision(mock.Any(), nil)
+
+	out, err := executeServiceCommand(client, "import", file, "--force", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Revision 'foo-rev-1' already exists (1/2)", "Revision 'foo-rev-2' created (2/2)", "Service 'foo' imported"))
+	r.Validate()
+}
+
+func TestServiceImportExistErrorWithoutForce(t *testing.T) {
+	file, err := generateFile(t, []byte(exportReplayYAML))
+	assert.NilError(t, err)
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", &servingv1.Service{}, nil)
+
+	_, err = executeServiceCommand(client, "import", file)
+	assert.ErrorContains(t, err, "already exists, use '--force' to update it")
+	r.Validate()
+}
+
+func TestServiceImportJSONProgressFailures(t *testing.T) {
+	file, err := generateFile(t, []byte(importServiceYAML))
+	assert.NilError(t, err)
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", &servingv1.Service{}, nil)
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), fmt.Errorf("quota exceeded"))
+
+	knParams := &commands.KnParams{Progress: commands.ProgressJSON}
+	output, err := executeServiceCommandWithParams(knParams, client, "import", file)
+	assert.ErrorContains(t, err, "already exists")
+	assertFailedSummary(t, output, "import", "already exists")
+
+	output, err = executeServiceCommandWithParams(knParams, client, "import", file)
+	assert.ErrorContains(t, err, "quota exceeded")
+	assertFailedSummary(t, output, "import", "quota exceeded")
+	r.Validate()
+}
+
+func TestServiceImportIntoOtherNamespace(t *testing.T) {
+	file, err := generateFile(t, []byte(importServiceYAML))
+	assert.NilError(t, err)
+
+	client := knclient.NewMockKnServiceClient(t, "prod")
+	r := client.Recorder()
+
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Namespace, "prod")
+		assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Env[0].Value, "http://backend.prod.svc.cluster.local")
+	}, nil)
+	r.GetConfiguration("foo", getConfiguration("foo"), nil)
+
+	out, err := executeServiceCommand(client, "import", file, "-n", "prod", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Service 'foo' imported in namespace 'prod'"))
+	r.Validate()
+}
+
+func TestServiceImportUnsupportedFile(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	for _, tc := range []struct {
+		content string
+		err     string
+	}{
+		{"apiVersion: v1\nkind: ConfigMap\n", "unsupported kind 'ConfigMap'"},
+		{"apiVersion: v1\nkind: List\nitems: []\n", "doesn't contain any service"},
+		{"apiVersion: v1\nkind: List\nitems:\n- metadata:\n    name: foo\n- metadata:\n    name: bar\n", "contains the services 'foo' and 'bar'"},
+		{"apiVersion: serving.knative.dev/v1\nkind: Service\n", "doesn't contain the name of the service"},
+	} {
+		file, err := generateFile(t, []byte(tc.content))
+		assert.NilError(t, err)
+		_, err = executeServiceCommand(client, "import", file)
+		assert.ErrorContains(t, err, tc.err)
+	}
+	r.Validate()
+}
+
+var importServiceYAML = `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: foo
+  namespace: staging
+spec:
+  template:
+    spec:
+      containers:
+      - env:
+        - name: BACKEND
+          value: http://backend.staging.svc.cluster.local
+        image: gcr.io/foo/bar:baz
+`
+
+var exportReplayYAML = `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: serving.knative.dev/v1
+  kind: Service
+  metadata:
+    name: foo
+  spec:
+    template:
+      metadata:
+        name: foo-rev-1
+      spec:
+        containers:
+        - image: gcr.io/foo/bar:v1
+- apiVersion: serving.knative.dev/v1
+  kind: Service
+  metadata:
+    name: foo
+  spec:
+    template:
+      metadata:
+        name: foo-rev-2
+      spec:
+        containers:
+        - image: gcr.io/foo/bar:v2
+- apiVersion: serving.knative.dev/v1
+  kind: Service
+  metadata:
+    name: foo
+  spec:
+    template:
+      metadata:
+        name: foo-rev-3
+      spec:
+        containers:
+        - image: gcr.io/foo/bar:v3
+    traffic:
+    - latestRevision: false
+      percent: 25
+      revisionName: foo-rev-1
+      tag: old
+    - latestRevision: false
+      percent: 25
+      revisionName: foo-rev-2
+    - latestRevision: false
+      percent: 50
+      revisionName: foo-rev-3
+`
@@ -20,11 +20,16 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 )
 
 // NewServiceListCommand represents 'kn service list' command
@@ -45,6 +50,9 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
   # List service 'web'
   kn service list web
 
+  # List all services and watch for changes until interrupted
+  kn service list --watch
+
   # List the services in offline mode instead of kubernetes cluster (Beta)
   kn service list --target=/user/knfiles
   kn service list --target=/user/knfiles/test.json
@@ -56,17 +64,30 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			client, err := newServingClient(p, namespace, cmd.Flag("target").Value.String())
+			target := cmd.Flag("target").Value.String()
+			if serviceListFlags.Watch && target != "" {
+				return fmt.Errorf("--watch can't be used together with --target")
+			}
+			client, err := newServingClient(p, namespace, target)
+			if err != nil {
+				return err
+			}
+			labelSelector, fieldSelector, err := serviceListFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			serviceList, err := getServiceInfo(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)), args, client)
 			if err != nil {
 				return err
 			}
-			serviceList, err := getServiceInfo(cmd.Context(), args, client)
+
+			err = serviceListFlags.Filter(serviceList)
 			if err != nil {
 				return err
 			}
 
 			// Stop if nothing found
-			if !serviceListFlags.GenericPrintFlags.OutputFlagSpecified() && len(serviceList.Items) == 0 {
+			if !serviceListFlags.GenericPrintFlags.OutputFlagSpecified() && len(serviceList.Items) == 0 && !serviceListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No services found.\n")
 				return nil
 			}
@@ -87,12 +108,26 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 				return a.ObjectMeta.Name < b.ObjectMeta.Name
 			})
 
-			return serviceListFlags.Print(serviceList, cmd.OutOrStdout())
+			err = serviceListFlags.Print(serviceList, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			listOptions := metav1.ListOptions{ResourceVersion: serviceList.ResourceVersion}
+			if len(args) == 1 {
+				listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", args[0]).String()
+			}
+			return serviceListFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{servingv1.SchemeGroupVersion.WithResource("services")},
+				ListOptions: listOptions,
+				Convert:     flags.ConvertTo(func() runtime.Object { return &servingv1.Service{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(serviceListCommand.Flags(), true)
 	commands.AddGitOpsFlags(serviceListCommand.Flags())
 	serviceListFlags.AddFlags(serviceListCommand)
+	serviceListFlags.AddWatchFlag(serviceListCommand)
 	return serviceListCommand
 }
 
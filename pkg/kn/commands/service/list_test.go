@@ -20,12 +20,18 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	clienttesting "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/util"
 )
@@ -221,3 +227,109 @@ func createMockServiceWithParams(name, namespace, urlS string, revision string)
 	}
 	return service
 }
+
+func TestServiceListWatch(t *testing.T) {
+	service1 := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	serviceList := &servingv1.ServiceList{ListMeta: metav1.ListMeta{ResourceVersion: "7"}, Items: []servingv1.Service{*service1}}
+
+	watcher := watch.NewFake()
+	var watchAction clienttesting.WatchAction
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+	dynamicClient.RawClient().(*fakedynamic.FakeDynamicClient).PrependWatchReactor("services",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			watchAction = a.(clienttesting.WatchAction)
+			return true, watcher, nil
+		})
+	go func() {
+		changed := createMockServiceWithParams("bar", "default", "http://bar.default.example.com", "bar-xyz")
+		unstructuredService, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(changed)
+		watcher.Add(&unstructured.Unstructured{Object: unstructuredService})
+		watcher.Stop()
+	}()
+
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewServiceCommand(knParams), knParams)
+	knParams.NewDynamicClient = func(namespace string) (clientdynamic.KnDynamicClient, error) {
+		return dynamicClient, nil
+	}
+	fakeServing.AddReactor("list", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, serviceList, nil
+		})
+	cmd.SetArgs([]string{"service", "list", "--watch"})
+	assert.NilError(t, cmd.Execute())
+
+	assert.Equal(t, watchAction.GetWatchRestrictions().ResourceVersion, "7")
+	output := strings.Split(buf.String(), "\n")
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "URL", "LATEST"))
+	assert.Check(t, util.ContainsAll(output[1], "foo", "foo.default.example.com", "foo-xyz"))
+	assert.Check(t, util.ContainsAll(output[2], "bar", "bar.default.example.com", "bar-xyz"))
+	assert.Check(t, util.ContainsNone(output[2], "NAME"))
+}
+
+func TestServiceListWatchWithTarget(t *testing.T) {
+	_, _, err := fakeServiceList([]string{"service", "list", "--watch", "--target", "/tmp"}, &servingv1.ServiceList{})
+	assert.ErrorContains(t, err, "--watch")
+}
+
+func TestServiceListSortAndSelect(t *testing.T) {
+	service1 := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	service1.Labels = map[string]string{"team": "a"}
+	service2 := createMockServiceWithParams("bar", "default", "http://bar.default.example.com", "bar-xyz")
+	service2.Labels = map[string]string{"team": "b"}
+	service3 := createMockServiceWithParams("sss", "default", "http://sss.default.example.com", "aaa-xyz")
+	service3.Labels = map[string]string{"team": "a"}
+	newList := func() *servingv1.ServiceList {
+		return &servingv1.ServiceList{Items: []servingv1.Service{*service1.DeepCopy(), *service2.DeepCopy(), *service3.DeepCopy()}}
+	}
+
+	_, output, err := fakeServiceList([]string{"service", "list", "--sort-by", "LATEST"}, newList())
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[1], "sss", "aaa-xyz"))
+	assert.Check(t, util.ContainsAll(output[2], "bar"))
+	assert.Check(t, util.ContainsAll(output[3], "foo"))
+
+	_, output, err = fakeServiceList([]string{"service", "list", "-l", "team=a", "--columns", "NAME,URL"}, newList())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, strings.Fields(output[0]), []string{"NAME", "URL"})
+	assert.DeepEqual(t, strings.Fields(output[1]), []string{"foo", "http://foo.default.example.com"})
+	assert.DeepEqual(t, strings.Fields(output[2]), []string{"sss", "http://sss.default.example.com"})
+	assert.Equal(t, output[3], "")
+
+	_, output, err = fakeServiceList([]string{"service", "list", "--field-selector", "metadata.name=nope"}, newList())
+	assert.NilError(t, err)
+	assert.Equal(t, output[0], "No services found.")
+}
+
+func TestServiceListServerSelectors(t *testing.T) {
+	service1 := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	service1.Labels = map[string]string{"team": "a"}
+	service2 := createMockServiceWithParams("bar", "default", "http://bar.default.example.com", "bar-xyz")
+	service2.Labels = map[string]string{"team": "a"}
+	// The fake server ignores the selectors, so that the client side filtering is still applied
+	list := &servingv1.ServiceList{Items: []servingv1.Service{*service1, *service2}}
+
+	action, output, err := fakeServiceList([]string{"service", "list", "-l", "team=a", "--field-selector", "metadata.name!=bar,status.url=http://foo.default.example.com"}, list)
+	assert.NilError(t, err)
+	listAction, ok := action.(clienttesting.ListAction)
+	assert.Assert(t, ok, "expected a list action, got %v", action)
+	assert.Equal(t, listAction.GetListRestrictions().Labels.String(), "team=a")
+	assert.Equal(t, listAction.GetListRestrictions().Fields.String(), "metadata.name!=bar")
+	assert.Check(t, util.ContainsAll(output[1], "foo"))
+	assert.Equal(t, output[2], "")
+
+	action, _, err = fakeServiceList([]string{"service", "list", "foo", "-l", "team=a"}, list)
+	assert.NilError(t, err)
+	listAction = action.(clienttesting.ListAction)
+	assert.Equal(t, listAction.GetListRestrictions().Labels.String(), "team=a")
+	assert.Equal(t, listAction.GetListRestrictions().Fields.String(), "metadata.name=foo")
+}
+
+func TestServiceListWide(t *testing.T) {
+	service := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	service.Spec.Template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v1"}}
+	_, output, err := fakeServiceList([]string{"service", "list", "-o", "wide"}, &servingv1.ServiceList{Items: []servingv1.Service{*service}})
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "URL", "IMAGE"))
+	assert.Check(t, util.ContainsAll(output[1], "foo", "gcr.io/foo/bar:v1"))
+}
@@ -0,0 +1,241 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knconfig "knative.dev/client/pkg/kn/config"
+	knflags "knative.dev/client/pkg/kn/flags"
+	servinglib "knative.dev/client/pkg/serving"
+	"knative.dev/client/pkg/util"
+)
+
+// applyProfile sets the settings of the profile on the service. Settings given with
+// flags are applied afterwards, so that they take precedence over the profile.
+func applyProfile(service *servingv1.Service, name string, profile knconfig.Profile) error {
+	template := &service.Spec.Template
+	spec := &template.Spec.PodSpec
+
+	annotations := make(util.StringMap)
+	for _, value := range profile.Annotations {
+		annotations[value.Name] = value.Value
+	}
+	if err := servinglib.UpdateRevisionTemplateAnnotations(template, annotations, []string{}); err != nil {
+		return err
+	}
+	labels := make(util.StringMap)
+	for _, value := range profile.Labels {
+		labels[value.Name] = value.Value
+	}
+	service.ObjectMeta.Labels = servinglib.UpdateLabels(service.ObjectMeta.Labels, labels, []string{})
+
+	if len(profile.Env) > 0 {
+		container := firstContainer(spec)
+		for _, value := range profile.Env {
+			container.Env = setEnvVar(container.Env, corev1.EnvVar{Name: value.Name, Value: value.Value})
+		}
+	}
+
+	if len(profile.Resources.Requests) > 0 || len(profile.Resources.Limits) > 0 {
+		requests, err := resourceList(name, "request", profile.Resources.Requests)
+		if err != nil {
+			return err
+		}
+		limits, err := resourceList(name, "limit", profile.Resources.Limits)
+		if err != nil {
+			return err
+		}
+		resources := corev1.ResourceRequirements{Requests: requests, Limits: limits}
+		if err := knflags.UpdateResources(spec, resources, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	if profile.Scale.Min != nil {
+		if err := servinglib.UpdateMinScale(template, *profile.Scale.Min); err != nil {
+			return err
+		}
+	}
+	if profile.Scale.Max != nil {
+		if err := servinglib.UpdateMaxScale(template, *profile.Scale.Max); err != nil {
+			return err
+		}
+	}
+	if profile.Scale.Target != nil {
+		if err := servinglib.UpdateScaleTarget(template, *profile.Scale.Target); err != nil {
+			return err
+		}
+	}
+	if profile.ConcurrencyLimit != nil {
+		if err := servinglib.UpdateConcurrencyLimit(template, int64(*profile.ConcurrencyLimit)); err != nil {
+			return err
+		}
+	}
+	if profile.Timeout != nil {
+		timeout := *profile.Timeout
+		template.Spec.TimeoutSeconds = &timeout
+	}
+
+	if profile.SecurityContext != "" {
+		if err := knflags.UpdateSecurityContext(spec, profile.SecurityContext); err != nil {
+			return fmt.Errorf("invalid security context in profile '%s': %w", name, err)
+		}
+	}
+	if len(profile.NodeSelector) > 0 {
+		if spec.NodeSelector == nil {
+			spec.NodeSelector = map[string]string{}
+		}
+		for key, value := range profile.NodeSelector {
+			spec.NodeSelector[key] = value
+		}
+	}
+	if profile.ServiceAccount != "" {
+		knflags.UpdateServiceAccountName(spec, profile.ServiceAccount)
+	}
+
+	return applyProfileProbes(spec, name, profile.Probes)
+}
+
+// removeProfile removes the settings of the profile from the service
+func removeProfile(service *servingv1.Service, profile knconfig.Profile) error {
+	template := &service.Spec.Template
+	spec := &template.Spec.PodSpec
+
+	var annotationsToRemove []string
+	for _, value := range profile.Annotations {
+		annotationsToRemove = append(annotationsToRemove, value.Name)
+	}
+	if profile.Scale.Min != nil {
+		annotationsToRemove = append(annotationsToRemove, autoscaling.MinScaleAnnotationKey)
+	}
+	if profile.Scale.Max != nil {
+		annotationsToRemove = append(annotationsToRemove, autoscaling.MaxScaleAnnotationKey)
+	}
+	if profile.Scale.Target != nil {
+		annotationsToRemove = append(annotationsToRemove, autoscaling.TargetAnnotationKey)
+	}
+	if err := servinglib.UpdateRevisionTemplateAnnotations(template, map[string]string{}, annotationsToRemove); err != nil {
+		return err
+	}
+	var labelsToRemove []string
+	for _, value := range profile.Labels {
+		labelsToRemove = append(labelsToRemove, value.Name)
+	}
+	service.ObjectMeta.Labels = servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{}, labelsToRemove)
+
+	if len(spec.Containers) > 0 {
+		container := &spec.Containers[0]
+		for _, value := range profile.Env {
+			container.Env = removeEnvVar(container.Env, value.Name)
+		}
+		for key := range profile.Resources.Requests {
+			delete(container.Resources.Requests, corev1.ResourceName(key))
+		}
+		for key := range profile.Resources.Limits {
+			delete(container.Resources.Limits, corev1.ResourceName(key))
+		}
+		if profile.SecurityContext != "" {
+			container.SecurityContext = nil
+		}
+		if profile.Probes.Liveness != "" || profile.Probes.LivenessOpts != "" {
+			container.LivenessProbe = nil
+		}
+		if profile.Probes.Readiness != "" || profile.Probes.ReadinessOpts != "" {
+			container.ReadinessProbe = nil
+		}
+	}
+
+	if profile.ConcurrencyLimit != nil {
+		template.Spec.ContainerConcurrency = nil
+	}
+	if profile.Timeout != nil {
+		template.Spec.TimeoutSeconds = nil
+	}
+	for key := range profile.NodeSelector {
+		delete(spec.NodeSelector, key)
+	}
+	if profile.ServiceAccount != "" && spec.ServiceAccountName == profile.ServiceAccount {
+		spec.ServiceAccountName = ""
+	}
+	return nil
+}
+
+func applyProfileProbes(spec *corev1.PodSpec, name string, probes knconfig.ProfileProbes) error {
+	if probes.Liveness != "" {
+		if err := knflags.UpdateLivenessProbe(spec, probes.Liveness); err != nil {
+			return fmt.Errorf("invalid liveness probe in profile '%s': %w", name, err)
+		}
+	}
+	if probes.LivenessOpts != "" {
+		if err := knflags.UpdateLivenessProbeOpts(spec, probes.LivenessOpts); err != nil {
+			return fmt.Errorf("invalid liveness probe options in profile '%s': %w", name, err)
+		}
+	}
+	if probes.Readiness != "" {
+		if err := knflags.UpdateReadinessProbe(spec, probes.Readiness); err != nil {
+			return fmt.Errorf("invalid readiness probe in profile '%s': %w", name, err)
+		}
+	}
+	if probes.ReadinessOpts != "" {
+		if err := knflags.UpdateReadinessProbeOpts(spec, probes.ReadinessOpts); err != nil {
+			return fmt.Errorf("invalid readiness probe options in profile '%s': %w", name, err)
+		}
+	}
+	return nil
+}
+
+func resourceList(profile, kind string, values map[string]string) (corev1.ResourceList, error) {
+	list := corev1.ResourceList{}
+	for key, value := range values {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource %s '%s: %s' in profile '%s': %w", kind, key, value, profile, err)
+		}
+		list[corev1.ResourceName(key)] = quantity
+	}
+	return list, nil
+}
+
+func firstContainer(spec *corev1.PodSpec) *corev1.Container {
+	if len(spec.Containers) == 0 {
+		spec.Containers = append(spec.Containers, corev1.Container{})
+	}
+	return &spec.Containers[0]
+}
+
+func setEnvVar(env []corev1.EnvVar, envVar corev1.EnvVar) []corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == envVar.Name {
+			env[i] = envVar
+			return env
+		}
+	}
+	return append(env, envVar)
+}
+
+func removeEnvVar(env []corev1.EnvVar, name string) []corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			return append(env[:i], env[i+1:]...)
+		}
+	}
+	return env
+}
@@ -16,11 +16,13 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"time"
 
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/serving/diagnose"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/wait"
 
@@ -41,17 +43,23 @@ func NewServiceCommand(p *commands.KnParams) *cobra.Command {
 	serviceCmd.AddCommand(NewServiceApplyCommand(p))
 	serviceCmd.AddCommand(NewServiceExportCommand(p))
 	serviceCmd.AddCommand(NewServiceImportCommand(p))
+	serviceCmd.AddCommand(NewServiceCopyCommand(p))
 	serviceCmd.AddCommand(NewServiceWaitCommand(p))
+	serviceCmd.AddCommand(NewServiceDiagnoseCommand(p))
 	return serviceCmd
 }
 
-func waitForService(ctx context.Context, client clientservingv1.KnServingClient, serviceName string, out io.Writer, wconfig clientservingv1.WaitConfig) error {
-	err, duration := client.WaitForService(ctx, serviceName, wconfig, wait.SimpleMessageCallback(out))
+func waitForService(ctx context.Context, client clientservingv1.KnServingClient, serviceName string, progress *commands.Progress, wconfig clientservingv1.WaitConfig, diagnoser *diagnose.Diagnoser) (time.Duration, error) {
+	wconfig.ConditionCallback = progress.ConditionCallback("service", serviceName)
+	err, duration := client.WaitForService(ctx, serviceName, wconfig, progress.MessageCallback("service", serviceName))
 	if err != nil {
-		return err
+		if diagnoser != nil && errors.Is(err, wait.ErrTimeout) {
+			err = diagnoser.DiagnoseError(ctx, serviceName, err)
+		}
+		return duration, err
 	}
-	fmt.Fprintf(out, "%7.3fs Ready to serve.\n", float64(duration.Round(time.Millisecond))/float64(time.Second))
-	return nil
+	fmt.Fprintf(progress.Out(), "%7.3fs Ready to serve.\n", float64(duration.Round(time.Millisecond))/float64(time.Second))
+	return duration, nil
 }
 
 func showUrl(ctx context.Context, client clientservingv1.KnServingClient, serviceName string, originalRevision string, what string, out io.Writer) error {
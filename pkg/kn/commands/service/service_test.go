@@ -16,14 +16,19 @@ package service
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
+	"strings"
+	"testing"
 
 	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"knative.dev/client/pkg/kn/commands"
 	knflags "knative.dev/client/pkg/kn/flags"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/wait"
 )
 
 // Helper methods
@@ -52,7 +57,10 @@ current-context: x
 }
 
 func executeServiceCommand(client clientservingv1.KnServingClient, args ...string) (string, error) {
-	knParams := &commands.KnParams{}
+	return executeServiceCommandWithParams(&commands.KnParams{}, client, args...)
+}
+
+func executeServiceCommandWithParams(knParams *commands.KnParams, client clientservingv1.KnServingClient, args ...string) (string, error) {
 	knParams.ClientConfig = blankConfig
 
 	// we need to temporary reset os.Args, becase it is being used for evaluation
@@ -76,3 +84,17 @@ func executeServiceCommand(client clientservingv1.KnServingClient, args ...strin
 	err := cmd.Execute()
 	return output.String(), err
 }
+
+// assertFailedSummary checks that the output contains the JSON summary of a failed
+// operation, which is followed by the error and the usage printed by cobra
+func assertFailedSummary(t *testing.T, output string, operation string, errMessage string) {
+	var summary wait.ProgressSummary
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "{") {
+			assert.NilError(t, json.Unmarshal([]byte(line), &summary), line)
+		}
+	}
+	assert.Equal(t, summary.Operation, operation)
+	assert.Equal(t, summary.Phase, wait.PhaseFailed)
+	assert.Assert(t, strings.Contains(summary.Error, errMessage), summary.Error)
+}
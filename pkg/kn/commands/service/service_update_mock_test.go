@@ -25,12 +25,27 @@ import (
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/kn/commands"
 	clientserving "knative.dev/client/pkg/serving"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
 	"knative.dev/pkg/ptr"
 )
 
+func TestServiceUpdateJSONProgressErrorMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+
+	knParams := &commands.KnParams{Progress: commands.ProgressJSON}
+	output, err := executeServiceCommandWithParams(knParams, client, "update", "foo", "--env", "a=b")
+	assert.Assert(t, errors.IsNotFound(err))
+	assertFailedSummary(t, output, "update", "not found")
+
+	r.Validate()
+}
+
 func TestServiceUpdateEnvMock(t *testing.T) {
 	client := clientservingv1.NewMockKnServiceClient(t)
 
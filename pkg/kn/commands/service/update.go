@@ -120,16 +120,20 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			// Do the actual update with retry in case of conflicts
+			progress := p.NewProgress(cmd.OutOrStdout())
+			start := time.Now()
 			changed, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
 			if err != nil {
+				progress.Summary("service", name, namespace, "update", time.Since(start), err)
 				return err
 			}
-			out := cmd.OutOrStdout()
+			out := progress.Out()
 
 			// No need to wait if not changed
 			if !changed {
 				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", args[0], namespace)
 				fmt.Fprintln(out, "No new revision has been created.")
+				progress.Summary("service", name, namespace, "update", time.Since(start), nil)
 				return nil
 			}
 
@@ -140,7 +144,8 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 					Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
 					ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
 				}
-				err := waitForService(cmd.Context(), client, name, out, wconfig)
+				_, err := waitForService(cmd.Context(), client, name, progress, wconfig, diagnoserIfRequested(p, client, waitFlags))
+				progress.Summary("service", name, namespace, "update", time.Since(start), err)
 				if err != nil {
 					return err
 				}
@@ -148,6 +153,7 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return showUrl(cmd.Context(), client, name, latestRevisionBeforeUpdate, "updated", out)
 			} else {
 				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", args[0], namespace)
+				progress.Summary("service", name, namespace, "update", time.Since(start), nil)
 			}
 
 			return nil
@@ -162,6 +168,7 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddGitOpsFlags(serviceUpdateCommand.Flags())
 	editFlags.AddUpdateFlags(serviceUpdateCommand)
 	waitFlags.AddConditionWaitFlags(serviceUpdateCommand, commands.WaitDefaultTimeout, "update", "service", "ready")
+	waitFlags.AddDiagnoseFlag(serviceUpdateCommand, "service")
 	trafficFlags.Add(serviceUpdateCommand)
 	return serviceUpdateCommand
 }
@@ -57,7 +57,8 @@ func NewServiceWaitCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			name := args[0]
-			out := cmd.OutOrStdout()
+			progress := p.NewProgress(cmd.OutOrStdout())
+			out := progress.Out()
 
 			fmt.Fprintf(out, "Waiting for Service '%s' in namespace '%s':\n", args[0], namespace)
 			fmt.Fprintln(out, "")
@@ -65,7 +66,8 @@ func NewServiceWaitCommand(p *commands.KnParams) *cobra.Command {
 				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
 				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
 			}
-			err = waitForService(cmd.Context(), client, name, out, wconfig)
+			duration, err := waitForService(cmd.Context(), client, name, progress, wconfig, diagnoserIfRequested(p, client, waitFlags))
+			progress.Summary("service", name, namespace, "wait", duration, err)
 			if err != nil {
 				return err
 			}
@@ -78,5 +80,6 @@ func NewServiceWaitCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(command.Flags(), false)
 	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "wait", "service", "ready")
+	waitFlags.AddDiagnoseFlag(command, "service")
 	return command
 }
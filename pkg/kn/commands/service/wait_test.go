@@ -15,7 +15,9 @@
 package service
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
@@ -32,7 +34,10 @@ import (
 )
 
 func fakeServiceWait(args []string) (action client_testing.Action, name string, output string, err error) {
-	knParams := &commands.KnParams{}
+	return fakeServiceWaitWithParams(&commands.KnParams{}, args)
+}
+
+func fakeServiceWaitWithParams(knParams *commands.KnParams, args []string) (action client_testing.Action, name string, output string, err error) {
 	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewServiceCommand(knParams), knParams)
 	fakeServing.AddReactor("get", "services",
 		func(a clienttesting.Action) (bool, runtime.Object, error) {
@@ -93,3 +98,21 @@ func TestServiceWaitWithFlags(t *testing.T) {
 	}
 	assert.Check(t, util.ContainsAll(output, "Service", sevName, "ready", "namespace", commands.FakeNamespace))
 }
+
+func TestServiceWaitJSONProgress(t *testing.T) {
+	sevName := "sev-4711"
+	knParams := &commands.KnParams{Progress: commands.ProgressJSON}
+	_, _, output, err := fakeServiceWaitWithParams(knParams, []string{"service", "wait", sevName})
+	assert.NilError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	var summary wait.ProgressSummary
+	assert.NilError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &summary))
+	assert.Equal(t, summary.Resource, "service")
+	assert.Equal(t, summary.Name, sevName)
+	assert.Equal(t, summary.Operation, "wait")
+	assert.Equal(t, summary.Phase, wait.PhaseDone)
+	for _, line := range lines {
+		assert.Assert(t, json.Valid([]byte(line)), "no JSON line: %s", line)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"knative.dev/client/lib/printing"
@@ -33,7 +34,10 @@ var describeExample = `
   kn source apiserver describe k8sevents
 
   # Describe an api-server source with name 'k8sevents' in YAML format
-  kn source apiserver describe k8sevents -o yaml`
+  kn source apiserver describe k8sevents -o yaml
+
+  # Print the URI the events of 'k8sevents' are sent to
+  kn source apiserver describe k8sevents --model -o jsonpath='{.sink.resolvedURI}'`
 
 // NewAPIServerDescribeCommand to describe an ApiServer source object
 func NewAPIServerDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -65,12 +69,10 @@ func NewAPIServerDescribeCommand(p *commands.KnParams) *cobra.Command {
 			out := cmd.OutOrStdout()
 
 			// Print out machine readable output if requested
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(apiSource, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, apiSource, func() (runtime.Object, error) {
+					return commands.SourceDescription("ApiServerSource", apiSource.ObjectMeta, &apiSource.Spec.SourceSpec, &apiSource.Status.SourceStatus), nil
+				})
 			}
 			dw := printers.NewPrefixWriter(out)
 
@@ -107,13 +109,15 @@ func NewAPIServerDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return nil
+			return commands.WriteEventsIfRequested(cmd, p, apiSource.Namespace, commands.StaticEventObjects(commands.EventObject{Kind: "ApiServerSource", Name: apiSource.Name}))
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	commands.AddEventsFlag(flags)
 	machineReadablePrintFlags.AddFlags(command)
+	commands.AddModelFlag(flags)
 	return command
 }
 
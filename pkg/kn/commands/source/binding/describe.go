@@ -20,6 +20,7 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/pkg/tracker"
@@ -34,7 +35,10 @@ var describeExample = `
   kn source binding describe mysinkbinding
 
   # Describe a sink binding 'mysinkbinding' in YAML format
-  kn source binding describe mysinkbinding -o yaml`
+  kn source binding describe mysinkbinding -o yaml
+
+  # Print the URI the events of 'mysinkbinding' are sent to
+  kn source binding describe mysinkbinding --model -o jsonpath='{.sink.resolvedURI}'`
 
 // NewBindingDescribeCommand returns a new command for describe a sink binding object
 func NewBindingDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -67,12 +71,10 @@ func NewBindingDescribeCommand(p *commands.KnParams) *cobra.Command {
 			dw := printers.NewPrefixWriter(out)
 
 			// Print out machine readable output if requested
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(binding, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, binding, func() (runtime.Object, error) {
+					return commands.SourceDescription("SinkBinding", binding.ObjectMeta, &binding.Spec.SourceSpec, &binding.Status.SourceStatus), nil
+				})
 			}
 
 			printDetails, err := cmd.Flags().GetBool("verbose")
@@ -92,13 +94,15 @@ func NewBindingDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return nil
+			return commands.WriteEventsIfRequested(cmd, p, binding.Namespace, commands.StaticEventObjects(commands.EventObject{Kind: "SinkBinding", Name: binding.Name}))
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	commands.AddEventsFlag(flags)
 	machineReadablePrintFlags.AddFlags(command)
+	commands.AddModelFlag(flags)
 	return command
 }
 
@@ -18,8 +18,13 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 )
 
 // NewBindingListCommand is for listing sink bindings
@@ -34,7 +39,10 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
   kn source binding list
 
   # List all sink bindings in YAML format
-  kn source binding list -o yaml`,
+  kn source binding list -o yaml
+
+  # List all sink bindings and watch for changes until interrupted
+  kn source binding list --watch`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given source name
@@ -44,12 +52,21 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			sourceList, err := bindingClient.ListSinkBindings(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			sourceList, err := bindingClient.ListSinkBindings(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
 
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 {
+			err = listFlags.Filter(sourceList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No sink binding found.\n")
 				return nil
 			}
@@ -62,11 +79,16 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-
-			return nil
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   bindingClient.Namespace(),
+				Resources:   []schema.GroupVersionResource{sourcesv1.SchemeGroupVersion.WithResource("sinkbindings")},
+				ListOptions: metav1.ListOptions{ResourceVersion: sourceList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &sourcesv1.SinkBinding{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
 	listFlags.AddFlags(cmd)
+	listFlags.AddWatchFlag(cmd)
 	return cmd
 }
@@ -22,6 +22,8 @@ import (
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"knative.dev/client/lib/printing"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
@@ -30,12 +32,21 @@ import (
 
 // NewContainerDescribeCommand to describe an Container source object
 func NewContainerDescribeCommand(p *commands.KnParams) *cobra.Command {
+	// For machine readable output
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+
 	containerDescribe := &cobra.Command{
 		Use:   "describe NAME",
 		Short: "Show details of a container source",
 		Example: `
   # Describe a container source with name 'k8sevents'
-  kn source container describe k8sevents`,
+  kn source container describe k8sevents
+
+  # Describe a container source with name 'k8sevents' in YAML format
+  kn source container describe k8sevents -o yaml
+
+  # Print the URI the events of 'k8sevents' are sent to
+  kn source container describe k8sevents --model -o jsonpath='{.sink.resolvedURI}'`,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
@@ -54,6 +65,13 @@ func NewContainerDescribeCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			out := cmd.OutOrStdout()
+
+			// Print out machine readable output if requested
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, source, func() (runtime.Object, error) {
+					return commands.SourceDescription("ContainerSource", source.ObjectMeta, &source.Spec.SourceSpec, &source.Status.SourceStatus), nil
+				})
+			}
 			dw := printers.NewPrefixWriter(out)
 
 			printDetails, err := cmd.Flags().GetBool("verbose")
@@ -88,13 +106,15 @@ func NewContainerDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return nil
+			return commands.WriteEventsIfRequested(cmd, p, source.Namespace, commands.StaticEventObjects(commands.EventObject{Kind: "ContainerSource", Name: source.Name}))
 		},
 	}
 	flags := containerDescribe.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
-
+	commands.AddEventsFlag(flags)
+	machineReadablePrintFlags.AddFlags(containerDescribe)
+	commands.AddModelFlag(flags)
 	return containerDescribe
 }
 
@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	"knative.dev/pkg/apis"
+
 	v1 "knative.dev/client/pkg/sources/v1"
 	"knative.dev/client/pkg/util"
 )
@@ -60,6 +62,27 @@ func TestSimpleDescribe(t *testing.T) {
 	containerRecorder.Validate()
 }
 
+func TestDescribeModel(t *testing.T) {
+	containerClient := v1.NewMockKnContainerSourceClient(t, "mynamespace")
+
+	containerRecorder := containerClient.Recorder()
+	sampleSource := createContainerSource(
+		"testsource", "docker.io/test/testimg",
+		createSinkv1("testsvc", "default"),
+		nil, nil, nil,
+	)
+	sampleSource.Namespace = "mynamespace"
+	sampleSource.Status.SinkURI = &apis.URL{Scheme: "https", Host: "testsvc"}
+	containerRecorder.GetContainerSource("testsource", sampleSource, nil)
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "describe", "testsource", "--model", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: SourceDescription", "sourceKind: ContainerSource", "name: testsource", "resolvedURI: https://testsvc"))
+	assert.Assert(t, util.ContainsNone(out, "spec:", "status:"))
+
+	containerRecorder.Validate()
+}
+
 func TestContainerDescribeErrorForNoArgs(t *testing.T) {
 	containerClient := v1.NewMockKnContainerSourceClient(t, "mynamespace")
 	out, err := executeContainerSourceCommand(containerClient, nil, "describe")
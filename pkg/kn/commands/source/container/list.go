@@ -20,9 +20,14 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewContainerListCommand is for listing Container sources
@@ -37,7 +42,10 @@ func NewContainerListCommand(p *commands.KnParams) *cobra.Command {
   kn source container list
 
   # List all Container sources in YAML format
-  kn source apiserver list -o yaml`,
+  kn source apiserver list -o yaml
+
+  # List all Container sources and watch for changes until interrupted
+  kn source container list --watch`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			containerClient, err := newContainerSourceClient(p, cmd)
@@ -45,12 +53,21 @@ func NewContainerListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			sourceList, err := containerClient.ListContainerSources(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			sourceList, err := containerClient.ListContainerSources(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
 
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 {
+			err = listFlags.Filter(sourceList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No Container source found.\n")
 				return nil
 			}
@@ -59,10 +76,20 @@ func NewContainerListCommand(p *commands.KnParams) *cobra.Command {
 				listFlags.EnsureWithNamespace()
 			}
 
-			return listFlags.Print(sourceList, cmd.OutOrStdout())
+			err = listFlags.Print(sourceList, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   containerClient.Namespace(),
+				Resources:   []schema.GroupVersionResource{sourcesv1.SchemeGroupVersion.WithResource("containersources")},
+				ListOptions: metav1.ListOptions{ResourceVersion: sourceList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &sourcesv1.ContainerSource{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddWatchFlag(listCommand)
 	return listCommand
 }
@@ -15,18 +15,23 @@
 package source
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"knative.dev/client/pkg/sources"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/client/pkg/dynamic"
 	knerrors "knative.dev/client/pkg/errors"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	"knative.dev/client/pkg/kn/commands/source/duck"
+	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
 )
 
 const (
@@ -43,7 +48,10 @@ var listExample = `
   kn source list --type=PingSource
 
   # List PingSource and ApiServerSource types sources
-  kn source list --type=PingSource --type=apiserversource`
+  kn source list --type=PingSource --type=apiserversource
+
+  # List all sources and watch for changes until interrupted
+  kn source list --watch`
 
 // NewListCommand defines and processes `kn source list`
 func NewListCommand(p *commands.KnParams) *cobra.Command {
@@ -69,10 +77,16 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 				filters = append(filters, dynamic.WithTypeFilter(filter))
 			}
 
-			sourceList, err := dynamicClient.ListSources(cmd.Context(), filters...)
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			sourceList, err := dynamicClient.ListSources(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)), filters...)
 
+			forbidden := false
 			switch {
 			case knerrors.IsForbiddenError(err):
+				forbidden = true
 				gvks := sources.BuiltInSourcesGVKs()
 				if sourceList, err = dynamicClient.ListSourcesUsingGVKs(cmd.Context(), &gvks, filters...); err != nil {
 					return knerrors.GetError(err)
@@ -84,7 +98,12 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 			if sourceList == nil {
 				sourceList = &unstructured.UnstructuredList{}
 			}
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 {
+			err = listFlags.Filter(sourceList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No sources found.\n")
 				return nil
 			}
@@ -101,19 +120,52 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 				return nil
 			}
 			if listFlags.GenericPrintFlags.OutputFlagSpecified() {
-				return printer.PrintObj(sourceList, cmd.OutOrStdout())
+				if listFlags.HumanReadableFlags.SortBy != "" {
+					if err := hprinters.SortUnstructured(sourceList.Items, listFlags.HumanReadableFlags.SortBy); err != nil {
+						return err
+					}
+				}
+				err = printer.PrintObj(sourceList, cmd.OutOrStdout())
+			} else {
+				// Convert the source list to DuckSourceList only if human readable table printing requested
+				err = printer.PrintObj(duck.ToSourceList(sourceList), cmd.OutOrStdout())
 			}
-			// Convert the source list to DuckSourceList only if human readable table printing requested
-			sourceDuckList := duck.ToSourceList(sourceList)
-			err = printer.PrintObj(sourceDuckList, cmd.OutOrStdout())
-			if err != nil {
+			if err != nil || !listFlags.Watch {
 				return err
 			}
-			return nil
+
+			gvrs, err := sourceGVRs(cmd.Context(), dynamicClient, forbidden, filters)
+			if err != nil {
+				return knerrors.GetError(err)
+			}
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace: namespace,
+				Resources: gvrs,
+				Convert: func(obj *unstructured.Unstructured) (runtime.Object, error) {
+					return duck.ToSourceList(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*obj}}), nil
+				},
+			})
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
 	filterFlags.Add(listCommand, "source type")
+	listFlags.AddWatchFlag(listCommand)
 	return listCommand
 }
+
+// sourceGVRs returns the resources of the source types to watch, which are the built-in
+// source types if the source CRDs can't be listed
+func sourceGVRs(ctx context.Context, client dynamic.KnDynamicClient, forbidden bool, filters dynamic.WithTypes) ([]schema.GroupVersionResource, error) {
+	if !forbidden {
+		return client.SourcesGVRs(ctx, filters...)
+	}
+	var gvrs []schema.GroupVersionResource
+	for _, gvk := range sources.BuiltInSourcesGVKs() {
+		if len(filters) > 0 && !util.SliceContainsIgnoreCase(filters.List(), gvk.Kind) {
+			continue
+		}
+		gvrs = append(gvrs, gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind)+"s"))
+	}
+	return gvrs, nil
+}
@@ -69,6 +69,10 @@ func NewListTypesCommand(p *commands.KnParams) *cobra.Command {
 			if !listTypesFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceListTypes.Items) == 0 {
 				return knerrors.NewInvalidCRD("Sources")
 			}
+			err = listTypesFlags.Filter(sourceListTypes)
+			if err != nil {
+				return err
+			}
 
 			if sourceListTypes.GroupVersionKind().Empty() {
 				sourceListTypes.SetAPIVersion("apiextensions.k8s.io/v1")
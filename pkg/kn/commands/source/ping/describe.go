@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"knative.dev/client/lib/printing"
@@ -32,7 +33,10 @@ var describeExample = `
   kn source ping describe myping
 
   # Describe a ping source 'myping' in YAML format
-  kn source ping describe myping -o yaml`
+  kn source ping describe myping -o yaml
+
+  # Print the URI the events of 'myping' are sent to
+  kn source ping describe myping --model -o jsonpath='{.sink.resolvedURI}'`
 
 // NewPingDescribeCommand returns a new command for describe a Ping source object
 func NewPingDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -64,12 +68,10 @@ func NewPingDescribeCommand(p *commands.KnParams) *cobra.Command {
 			out := cmd.OutOrStdout()
 
 			// Print out machine readable output if requested
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(pingSource, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, pingSource, func() (runtime.Object, error) {
+					return commands.SourceDescription("PingSource", pingSource.ObjectMeta, &pingSource.Spec.SourceSpec, &pingSource.Status.SourceStatus), nil
+				})
 			}
 			dw := printers.NewPrefixWriter(out)
 
@@ -105,13 +107,15 @@ func NewPingDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return nil
+			return commands.WriteEventsIfRequested(cmd, p, pingSource.Namespace, commands.StaticEventObjects(commands.EventObject{Kind: "PingSource", Name: pingSource.Name}))
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	commands.AddEventsFlag(flags)
 	machineReadablePrintFlags.AddFlags(command)
+	commands.AddModelFlag(flags)
 	return command
 }
 
@@ -75,6 +75,21 @@ func TestDescribeMachineReadable(t *testing.T) {
 	pingRecorder.Validate()
 }
 
+func TestDescribeModel(t *testing.T) {
+	pingClient := clientv1beta2.NewMockKnPingSourceClient(t, "mynamespace")
+
+	source := getPingSourceSinkURI()
+	source.Status.SinkURI = &apis.URL{Scheme: "https", Host: "foo"}
+	pingRecorder := pingClient.Recorder()
+	pingRecorder.GetPingSource("testsource-uri", source, nil)
+
+	out, err := executePingSourceCommand(pingClient, nil, "describe", "testsource-uri", "--model", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: SourceDescription", "sourceKind: PingSource", "name: testsource-uri", "uri: https://foo", "resolvedURI: https://foo"))
+	assert.Assert(t, util.ContainsNone(out, "spec:", "status:"))
+	pingRecorder.Validate()
+}
+
 func TestDescribeError(t *testing.T) {
 	pingClient := clientv1beta2.NewMockKnPingSourceClient(t, "mynamespace")
 
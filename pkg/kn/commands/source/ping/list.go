@@ -18,9 +18,14 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	sourcesv1beta2 "knative.dev/eventing/pkg/apis/sources/v1beta2"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewPingListCommand is for listing Ping source COs
@@ -35,7 +40,10 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
   kn source ping list
 
   # List all Ping sources in YAML format
-  kn source ping list -o yaml`,
+  kn source ping list -o yaml
+
+  # List all Ping sources and watch for changes until interrupted
+  kn source ping list --watch`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given source name
@@ -45,12 +53,21 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			sourceList, err := pingClient.ListPingSource(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			sourceList, err := pingClient.ListPingSource(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
 
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 {
+			err = listFlags.Filter(sourceList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(sourceList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No Ping source found.\n")
 				return nil
 			}
@@ -63,11 +80,16 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-
-			return nil
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   pingClient.Namespace(),
+				Resources:   []schema.GroupVersionResource{sourcesv1beta2.SchemeGroupVersion.WithResource("pingsources")},
+				ListOptions: metav1.ListOptions{ResourceVersion: sourceList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &sourcesv1beta2.PingSource{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddWatchFlag(listCommand)
 	return listCommand
 }
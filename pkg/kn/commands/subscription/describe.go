@@ -22,10 +22,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
 
 	"knative.dev/client/lib/printing"
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	knerrors "knative.dev/client/pkg/errors"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
@@ -42,7 +44,10 @@ func NewSubscriptionDescribeCommand(p *commands.KnParams) *cobra.Command {
 		Short: "Show details of a subscription",
 		Example: `
   # Describe a subscription 'pipe'
-  kn subscription describe pipe`,
+  kn subscription describe pipe
+
+  # Print the URI the events of subscription 'pipe' are sent to
+  kn subscription describe pipe --model -o jsonpath='{.subscriber.resolvedURI}'`,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
@@ -62,12 +67,10 @@ func NewSubscriptionDescribeCommand(p *commands.KnParams) *cobra.Command {
 
 			out := cmd.OutOrStdout()
 
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(subscription, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, subscription, func() (runtime.Object, error) {
+					return subscriptionDescription(subscription), nil
+				})
 			}
 
 			dw := printers.NewPrefixWriter(out)
@@ -96,9 +99,27 @@ func NewSubscriptionDescribeCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
 	machineReadablePrintFlags.AddFlags(cmd)
+	commands.AddModelFlag(flags)
 	return cmd
 }
 
+// subscriptionDescription returns the describe model of the given subscription
+func subscriptionDescription(subscription *messagingv1.Subscription) *v1alpha1.SubscriptionDescription {
+	physical := subscription.Status.PhysicalSubscription
+	desc := &v1alpha1.SubscriptionDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("SubscriptionDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(subscription.ObjectMeta),
+		Channel:    subscription.Spec.Channel,
+		Subscriber: printing.SinkDescription(subscription.Spec.Subscriber, physical.SubscriberURI),
+		Reply:      printing.SinkDescription(subscription.Spec.Reply, physical.ReplyURI),
+		Conditions: subscription.Status.Conditions,
+	}
+	if subscription.Spec.Delivery != nil {
+		desc.DeadLetterSink = printing.SinkDescription(subscription.Spec.Delivery.DeadLetterSink, physical.DeadLetterSinkURI)
+	}
+	return desc
+}
+
 func writeSubscription(dw printers.PrefixWriter, subscription *messagingv1.Subscription, printDetails bool) {
 	commands.WriteMetadata(dw, &subscription.ObjectMeta, printDetails)
 	ctype := fmt.Sprintf("%s:%s (%s)", subscription.Spec.Channel.Kind, subscription.Spec.Channel.Name, subscription.Spec.Channel.APIVersion)
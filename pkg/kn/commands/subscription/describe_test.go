@@ -23,6 +23,7 @@ import (
 
 	"gotest.tools/v3/assert"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	clientv1 "knative.dev/client/pkg/messaging/v1"
 	"knative.dev/client/pkg/util"
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
@@ -74,5 +75,20 @@ func TestDescribeSubscription(t *testing.T) {
 		assert.DeepEqual(t, subscription, result)
 	})
 
+	t.Run("model output", func(t *testing.T) {
+		cRecorder.GetSubscription("sub0", subscription, nil)
+		out, err := executeSubscriptionCommand(cClient, nil, "describe", "sub0", "--model", "-o", "json")
+		assert.NilError(t, err, "subscription should be described")
+
+		result := &v1alpha1.SubscriptionDescription{}
+		assert.NilError(t, json.Unmarshal([]byte(out), result))
+		assert.Equal(t, result.Kind, "SubscriptionDescription")
+		assert.Equal(t, result.Name, "sub0")
+		assert.Equal(t, result.Channel.Name, "imc0")
+		assert.Equal(t, result.Subscriber.Ref.Name, "ksvc0")
+		assert.Equal(t, result.Reply.Ref.Name, "b0")
+		assert.Equal(t, result.DeadLetterSink.Ref.Name, "b1")
+	})
+
 	cRecorder.Validate()
 }
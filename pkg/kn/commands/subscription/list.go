@@ -24,6 +24,9 @@ import (
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
@@ -41,7 +44,10 @@ func NewSubscriptionListCommand(p *commands.KnParams) *cobra.Command {
   kn subscription list
 
   # List subscriptions in YAML format
-  kn subscription list -o yaml`,
+  kn subscription list -o yaml
+
+  # List all subscriptions and watch for changes until interrupted
+  kn subscription list --watch`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given subscription name
@@ -51,7 +57,11 @@ func NewSubscriptionListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			subscriptionList, err := client.ListSubscription(cmd.Context())
+			labelSelector, fieldSelector, err := listFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			subscriptionList, err := client.ListSubscription(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
@@ -63,7 +73,12 @@ func NewSubscriptionListCommand(p *commands.KnParams) *cobra.Command {
 					return err
 				}
 			}
-			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(subscriptionList.Items) == 0 {
+			err = listFlags.Filter(subscriptionList)
+			if err != nil {
+				return err
+			}
+
+			if !listFlags.GenericPrintFlags.OutputFlagSpecified() && len(subscriptionList.Items) == 0 && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No subscriptions found.\n")
 				return nil
 			}
@@ -76,11 +91,16 @@ func NewSubscriptionListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-
-			return nil
+			return listFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   client.Namespace(),
+				Resources:   []schema.GroupVersionResource{messagingv1.SchemeGroupVersion.WithResource("subscriptions")},
+				ListOptions: metav1.ListOptions{ResourceVersion: subscriptionList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &messagingv1.Subscription{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddWatchFlag(listCommand)
 	return listCommand
 }
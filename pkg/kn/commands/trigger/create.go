@@ -40,7 +40,10 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
   kn trigger create mytrigger --broker default --sink ksvc:mysvc
 
   # Create a trigger to filter events with attribute 'type=dev.knative.foo'
-  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc`,
+  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc
+
+  # Create a trigger with the delivery settings of the profile 'prod' from the configuration
+  kn trigger create mytrigger --broker default --sink ksvc:mysvc --profile prod`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
@@ -87,6 +90,14 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 					URI: objectRef.URI,
 				})
 
+			if cmd.Flags().Changed("profile") {
+				delivery, err := flags.ProfileDelivery(cmd.Context(), triggerUpdateFlags.Profile, dynamicClient, namespace)
+				if err != nil {
+					return err
+				}
+				triggerBuilder.Delivery(delivery)
+			}
+
 			err = eventingClient.CreateTrigger(cmd.Context(), triggerBuilder.Build())
 			if err != nil {
 				return fmt.Errorf(
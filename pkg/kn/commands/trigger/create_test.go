@@ -20,10 +20,12 @@ import (
 
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	dynamicfake "knative.dev/client/pkg/dynamic/fake"
 	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/util"
 )
 
@@ -49,6 +51,47 @@ func TestTriggerCreate(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestTriggerCreateWithProfile(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	retry := int32(3)
+	config.GlobalConfig = &config.TestConfig{TestProfiles: map[string]config.Profile{
+		"prod": {Delivery: config.ProfileDelivery{DeadLetterSink: "ksvc:dlq", Retry: &retry, BackoffPolicy: "exponential"}},
+	}}
+
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default",
+		&servingv1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+		},
+		&servingv1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "dlq", Namespace: "default"},
+		})
+
+	policy := eventingduckv1.BackoffPolicyExponential
+	expected := createTrigger("default", triggerName, nil, "mybroker", "mysvc")
+	expected.Spec.Delivery = &eventingduckv1.DeliverySpec{
+		DeadLetterSink: createServiceSink("dlq"),
+		Retry:          &retry,
+		BackoffPolicy:  &policy,
+	}
+	eventingRecorder := eventingClient.Recorder()
+	eventingRecorder.CreateTrigger(expected, nil)
+
+	out, err := executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--sink", "ksvc:mysvc", "--profile", "prod")
+	assert.NilError(t, err, "Trigger should be created")
+	assert.Assert(t, util.ContainsAll(out, "Trigger", triggerName, "created", "namespace", "default"))
+
+	_, err = executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--sink", "ksvc:mysvc", "--profile", "unknown")
+	assert.ErrorContains(t, err, "profile unknown doesn't exist")
+
+	eventingRecorder.Validate()
+}
+
 func TestSinkNotFoundError(t *testing.T) {
 	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
 	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
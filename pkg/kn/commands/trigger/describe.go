@@ -18,8 +18,10 @@ import (
 	"errors"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"knative.dev/client/lib/printing"
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/printers"
 	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1"
@@ -30,7 +32,10 @@ var describeExample = `
   kn trigger describe my-trigger
 
   # Describe a trigger 'my-trigger' in YAML format
-  kn trigger describe my-trigger -o yaml`
+  kn trigger describe my-trigger -o yaml
+
+  # Print the URI the events matching trigger 'my-trigger' are sent to
+  kn trigger describe my-trigger --model -o jsonpath='{.sink.resolvedURI}'`
 
 // NewTriggerDescribeCommand returns a new command for describe a trigger
 func NewTriggerDescribeCommand(p *commands.KnParams) *cobra.Command {
@@ -69,12 +74,10 @@ func NewTriggerDescribeCommand(p *commands.KnParams) *cobra.Command {
 			out := cmd.OutOrStdout()
 
 			// Print out machine readable output if requested
-			if machineReadablePrintFlags.OutputFlagSpecified() {
-				printer, err := machineReadablePrintFlags.ToPrinter()
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(trigger, out)
+			if machineReadablePrintFlags.OutputFlagSpecified() || commands.ModelRequested(cmd) {
+				return commands.PrintDescribeOutput(cmd, machineReadablePrintFlags, trigger, func() (runtime.Object, error) {
+					return triggerDescription(trigger), nil
+				})
 			}
 
 			dw := printers.NewPrefixWriter(out)
@@ -103,16 +106,33 @@ func NewTriggerDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return nil
+			return commands.WriteEventsIfRequested(cmd, p, trigger.Namespace, commands.StaticEventObjects(commands.EventObject{Kind: "Trigger", Name: trigger.Name}))
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	commands.AddEventsFlag(flags)
 	machineReadablePrintFlags.AddFlags(command)
+	commands.AddModelFlag(flags)
 	return command
 }
 
+// triggerDescription returns the describe model of the given trigger
+func triggerDescription(trigger *v1beta1.Trigger) *v1alpha1.TriggerDescription {
+	desc := &v1alpha1.TriggerDescription{
+		TypeMeta:   commands.DescriptionTypeMeta("TriggerDescription"),
+		ObjectMeta: commands.DescriptionObjectMeta(trigger.ObjectMeta),
+		Broker:     trigger.Spec.Broker,
+		Sink:       *printing.SinkDescription(&trigger.Spec.Subscriber, trigger.Status.SubscriberURI),
+		Conditions: trigger.Status.Conditions,
+	}
+	if trigger.Spec.Filter != nil {
+		desc.Filter = trigger.Spec.Filter.Attributes
+	}
+	return desc
+}
+
 func writeTrigger(dw printers.PrefixWriter, trigger *v1beta1.Trigger, printDetails bool) {
 	commands.WriteMetadata(dw, &trigger.ObjectMeta, printDetails)
 	dw.WriteAttribute("Broker", trigger.Spec.Broker)
@@ -30,6 +30,7 @@ import (
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"knative.dev/client/pkg/apis/client/v1alpha1"
 	clientv1beta1 "knative.dev/client/pkg/eventing/v1"
 	"knative.dev/client/pkg/util"
 )
@@ -268,6 +269,33 @@ func TestWriteNestedFilters(t *testing.T) {
 	}
 }
 
+func TestDescribeTriggerModel(t *testing.T) {
+	client := clientv1beta1.NewMockKnEventingClient(t, "mynamespace")
+
+	trigger := getTriggerSinkRef()
+	trigger.Status.SubscriberURI = &apis.URL{Scheme: "http", Host: "mysvc.myservicenamespace.svc.cluster.local"}
+	recorder := client.Recorder()
+	recorder.GetTrigger("testtrigger", trigger, nil)
+	recorder.GetTrigger("testtrigger", trigger, nil)
+
+	output, err := executeTriggerCommand(client, nil, "describe", "testtrigger", "--model", "-o", "json")
+	assert.NilError(t, err)
+	result := v1alpha1.TriggerDescription{}
+	assert.NilError(t, json.Unmarshal([]byte(output), &result))
+	assert.Equal(t, result.Kind, "TriggerDescription")
+	assert.Equal(t, result.Broker, "mybroker")
+	assert.DeepEqual(t, result.Filter, map[string]string{"type": "foo.type.knative", "source": "src.eventing.knative"})
+	assert.Equal(t, result.Sink.Ref.Name, "mysvc")
+	assert.Equal(t, result.Sink.ResolvedURI, "http://mysvc.myservicenamespace.svc.cluster.local")
+
+	output, err = executeTriggerCommand(client, nil, "describe", "testtrigger", "--model", "-o", "jsonpath={.sink.resolvedURI}")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "http://mysvc.myservicenamespace.svc.cluster.local")
+
+	// Validate that all recorded API methods have been called
+	recorder.Validate()
+}
+
 func getTriggerSinkRef() *v1beta1.Trigger {
 	return &v1beta1.Trigger{
 		TypeMeta: v1.TypeMeta{
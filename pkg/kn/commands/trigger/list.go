@@ -18,9 +18,14 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewTriggerListCommand represents 'kn trigger list' command
@@ -36,7 +41,10 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
   kn trigger list
 
   # List all triggers in JSON output format
-  kn trigger list -o json`,
+  kn trigger list -o json
+
+  # List all triggers and watch for changes until interrupted
+  kn trigger list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -46,11 +54,20 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			triggerList, err := client.ListTriggers(cmd.Context())
+			labelSelector, fieldSelector, err := triggerListFlags.ServerSelectors()
+			if err != nil {
+				return err
+			}
+			triggerList, err := client.ListTriggers(util.WithListOptions(cmd.Context(), util.WithSelectors(labelSelector, fieldSelector)))
 			if err != nil {
 				return err
 			}
-			if !triggerListFlags.GenericPrintFlags.OutputFlagSpecified() && len(triggerList.Items) == 0 {
+			err = triggerListFlags.Filter(triggerList)
+			if err != nil {
+				return err
+			}
+
+			if !triggerListFlags.GenericPrintFlags.OutputFlagSpecified() && len(triggerList.Items) == 0 && !triggerListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No triggers found.\n")
 				return nil
 			}
@@ -64,10 +81,16 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return nil
+			return triggerListFlags.WatchIfRequested(cmd, p, flags.WatchOptions{
+				Namespace:   namespace,
+				Resources:   []schema.GroupVersionResource{eventingv1.SchemeGroupVersion.WithResource("triggers")},
+				ListOptions: metav1.ListOptions{ResourceVersion: triggerList.ResourceVersion},
+				Convert:     flags.ConvertTo(func() runtime.Object { return &eventingv1.Trigger{} }),
+			})
 		},
 	}
 	commands.AddNamespaceFlags(triggerListCommand.Flags(), true)
 	triggerListFlags.AddFlags(triggerListCommand)
+	triggerListFlags.AddWatchFlag(triggerListCommand)
 	return triggerListCommand
 }
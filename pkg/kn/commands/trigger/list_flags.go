@@ -16,6 +16,7 @@ package trigger
 
 import (
 	"sort"
+	"strings"
 
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +37,7 @@ func TriggerListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions.", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the trigger.", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the trigger.", Priority: 1},
+		{Name: "Filter", Type: "string", Description: "Attributes an event must have to be delivered.", Priority: hprinters.WideColumnPriority},
 	}
 	h.TableHandler(sourceTypesColumnDefinitions, printTrigger)
 	h.TableHandler(sourceTypesColumnDefinitions, printTriggerList)
@@ -67,6 +69,9 @@ func printTrigger(trigger *v1beta1.Trigger, options hprinters.PrintOptions) ([]m
 		conditions,
 		ready,
 		reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, triggerFilter(trigger))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
@@ -122,3 +127,16 @@ func printTriggerList(triggerList *v1beta1.TriggerList, options hprinters.PrintO
 	}
 	return rows, nil
 }
+
+// triggerFilter returns the trigger's filter attributes as comma separated key=value pairs
+func triggerFilter(trigger *v1beta1.Trigger) string {
+	if trigger.Spec.Filter == nil {
+		return ""
+	}
+	attributes := make([]string, 0, len(trigger.Spec.Filter.Attributes))
+	for key, value := range trigger.Spec.Filter.Attributes {
+		attributes = append(attributes, key+"="+value)
+	}
+	sort.Strings(attributes)
+	return strings.Join(attributes, ",")
+}
@@ -47,6 +47,9 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 
   # Update the sink of a trigger 'mytrigger' to 'ksvc:new-service'
   kn trigger update mytrigger --sink ksvc:new-service
+
+  # Update the delivery settings of a trigger 'mytrigger' with the ones of the profile 'prod'
+  kn trigger update mytrigger --profile prod
   `,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -95,6 +98,14 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 						URI: destination.URI,
 					})
 				}
+				if cmd.Flags().Changed("profile") {
+					delivery, err := flags.ProfileDelivery(cmd.Context(), triggerUpdateFlags.Profile, dynamicClient, namespace)
+					if err != nil {
+						return nil, err
+					}
+					// The profile takes precedence over the existing delivery settings
+					b.Delivery(flags.MergeDelivery(delivery, trigger.Spec.Delivery))
+				}
 				return b.Build(), nil
 			}
 			err = eventingClient.UpdateTriggerWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
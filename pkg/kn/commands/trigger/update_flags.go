@@ -27,6 +27,7 @@ type TriggerUpdateFlags struct {
 	Broker       string
 	InjectBroker bool
 	Filters      []string
+	Profile      string
 }
 
 // GetFilters to return a map type of filters
@@ -56,4 +57,5 @@ func (f *TriggerUpdateFlags) Add(cmd *cobra.Command) {
 	}
 
 	cmd.Flags().StringSliceVar(&f.Filters, "filter", nil, "Key-value pair for exact CloudEvent attribute matching against incoming events, e.g type=dev.knative.foo")
+	cmd.Flags().StringVar(&f.Profile, "profile", "", "The profile name must be defined in config.yaml. The delivery settings of the profile are set on the trigger.")
 }
@@ -21,10 +21,12 @@ import (
 
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	dynamicfake "knative.dev/client/pkg/dynamic/fake"
 	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/util"
 )
 
@@ -49,6 +51,35 @@ func TestTriggerUpdate(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestTriggerUpdateWithProfile(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	retry := int32(3)
+	config.GlobalConfig = &config.TestConfig{TestProfiles: map[string]config.Profile{
+		"prod": {Delivery: config.ProfileDelivery{Retry: &retry}},
+	}}
+
+	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	timeout := "PT5S"
+	oldRetry := int32(1)
+	present := createTrigger("default", triggerName, map[string]string{"type": "dev.knative.foo"}, "mybroker", "mysvc")
+	present.Spec.Delivery = &eventingduckv1.DeliverySpec{Retry: &oldRetry, Timeout: &timeout}
+	updated := createTrigger("default", triggerName, map[string]string{"type": "dev.knative.foo"}, "mybroker", "mysvc")
+	updated.Spec.Delivery = &eventingduckv1.DeliverySpec{Retry: &retry, Timeout: &timeout}
+
+	eventingRecorder := eventingClient.Recorder()
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	eventingRecorder.UpdateTrigger(updated, nil)
+
+	out, err := executeTriggerCommand(eventingClient, dynamicClient, "update", triggerName, "--profile", "prod")
+	assert.NilError(t, err, "Trigger should be updated")
+	assert.Assert(t, util.ContainsAll(out, "Trigger", triggerName, "updated", "namespace", "default"))
+
+	eventingRecorder.Validate()
+}
+
 func TestTriggerUpdateWithError(t *testing.T) {
 	eventingClient := clienteventingv1.NewMockKnEventingClient(t)
 	eventingRecorder := eventingClient.Recorder()
@@ -70,6 +70,10 @@ type KnParams struct {
 	// General global options
 	LogHTTP bool
 
+	// Progress selects how progress of long-running operations is reported
+	// (one of ProgressText, ProgressJSON or ProgressNone)
+	Progress string
+
 	// Set this if you want to nail down the namespace
 	fixedCurrentNamespace string
 }
@@ -116,6 +120,23 @@ func (params *KnParams) Initialize() {
 	}
 }
 
+// ForContext returns parameters for accessing the cluster of another context of the
+// kubeconfig, with the same kubeconfig file, impersonation and global options
+func (params *KnParams) ForContext(kubeContext string) *KnParams {
+	target := &KnParams{
+		Output:      params.Output,
+		KubeCfgPath: params.KubeCfgPath,
+		KubeContext: kubeContext,
+		KubeAsUser:  params.KubeAsUser,
+		KubeAsUID:   params.KubeAsUID,
+		KubeAsGroup: params.KubeAsGroup,
+		LogHTTP:     params.LogHTTP,
+		Progress:    params.Progress,
+	}
+	target.Initialize()
+	return target
+}
+
 func (params *KnParams) newKubeClient() (kubernetes.Interface, error) {
 	restConfig, err := params.RestConfig()
 	if err != nil {
@@ -546,3 +546,34 @@ func TestInitialize(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Assert(t, eventingBeta1Client != nil)
 }
+
+func TestForContext(t *testing.T) {
+	kubeconfig := strings.Replace(BASIC_KUBECONFIG, "contexts:\n", `contexts:
+- name: b
+  context:
+    cluster: b
+    user: a
+`, 1)
+	kubeconfig = strings.Replace(kubeconfig, "clusters:\n", `clusters:
+- name: b
+  cluster:
+    server: https://10.0.0.2:6443
+`, 1)
+	tempFile := filepath.Join(t.TempDir(), "config")
+	assert.NilError(t, os.WriteFile(tempFile, []byte(kubeconfig), test.FileModeReadWrite))
+
+	params := &KnParams{KubeCfgPath: tempFile, KubeAsUser: "admin", LogHTTP: true}
+	params.Initialize()
+	target := params.ForContext("b")
+	assert.Equal(t, target.KubeContext, "b")
+	assert.Equal(t, target.KubeAsUser, "admin")
+	assert.Assert(t, target.LogHTTP)
+	assert.Assert(t, target.NewServingClient != nil)
+
+	config, err := target.RestConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, config.Host, "https://10.0.0.2:6443")
+	config, err = params.RestConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, config.Host, "https://127.0.0.1:8080")
+}
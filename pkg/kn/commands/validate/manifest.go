@@ -0,0 +1,387 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	eventingconfig "knative.dev/eventing/pkg/apis/config"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+	sigsjson "sigs.k8s.io/json"
+)
+
+// brokerDefaults are the broker defaults of a Knative Eventing installation
+// which the webhook takes from the 'config-br-defaults' config map
+const brokerDefaults = `clusterDefault:
+  brokerClass: MTChannelBasedBroker
+  apiVersion: v1
+  kind: ConfigMap
+  name: config-br-default-channel
+  namespace: knative-eventing
+`
+
+// validationContext returns a context for defaulting and validating new
+// resources with the configuration of a default installation
+func validationContext(ctx context.Context) (context.Context, error) {
+	defaults, err := eventingconfig.NewDefaultsConfigFromMap(map[string]string{
+		eventingconfig.BrokerDefaultsKey: brokerDefaults,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx = eventingconfig.ToContext(ctx, &eventingconfig.Config{Defaults: defaults})
+	return apis.WithinCreate(ctx), nil
+}
+
+// resource is a Knative resource which is defaulted and validated the same
+// way as done by the admission webhooks
+type resource interface {
+	metav1.Object
+	apis.Defaultable
+	apis.Validatable
+}
+
+// resourceKinds are the kinds of resources which can be validated
+var resourceKinds = map[schema.GroupVersionKind]func() resource{
+	servingv1.SchemeGroupVersion.WithKind("Service"):       func() resource { return &servingv1.Service{} },
+	servingv1.SchemeGroupVersion.WithKind("Configuration"): func() resource { return &servingv1.Configuration{} },
+	servingv1.SchemeGroupVersion.WithKind("Route"):         func() resource { return &servingv1.Route{} },
+	servingv1beta1.SchemeGroupVersion.WithKind("DomainMapping"): func() resource {
+		return &servingv1beta1.DomainMapping{}
+	},
+	eventingv1.SchemeGroupVersion.WithKind("Broker"):           func() resource { return &eventingv1.Broker{} },
+	eventingv1.SchemeGroupVersion.WithKind("Trigger"):          func() resource { return &eventingv1.Trigger{} },
+	eventingv1beta2.SchemeGroupVersion.WithKind("EventType"):   func() resource { return &eventingv1beta2.EventType{} },
+	messagingv1.SchemeGroupVersion.WithKind("Channel"):         func() resource { return &messagingv1.Channel{} },
+	messagingv1.SchemeGroupVersion.WithKind("InMemoryChannel"): func() resource { return &messagingv1.InMemoryChannel{} },
+	messagingv1.SchemeGroupVersion.WithKind("Subscription"):    func() resource { return &messagingv1.Subscription{} },
+	sourcesv1.SchemeGroupVersion.WithKind("ApiServerSource"):   func() resource { return &sourcesv1.ApiServerSource{} },
+	sourcesv1.SchemeGroupVersion.WithKind("ContainerSource"):   func() resource { return &sourcesv1.ContainerSource{} },
+	sourcesv1.SchemeGroupVersion.WithKind("PingSource"):        func() resource { return &sourcesv1.PingSource{} },
+	sourcesv1.SchemeGroupVersion.WithKind("SinkBinding"):       func() resource { return &sourcesv1.SinkBinding{} },
+}
+
+// knativeGroups are the API groups for which a warning is given if a kind
+// can't be validated
+var knativeGroups = []string{
+	"serving.knative.dev",
+	"eventing.knative.dev",
+	"messaging.knative.dev",
+	"sources.knative.dev",
+	"flows.knative.dev",
+}
+
+// issue is a problem found in a manifest
+type issue struct {
+	file     string
+	line     int
+	level    apis.DiagnosticLevel
+	resource string
+	message  string
+}
+
+func (i issue) String() string {
+	location := i.file
+	if i.line > 0 {
+		location = fmt.Sprintf("%s:%d", i.file, i.line)
+	}
+	level := strings.ToLower(i.level.String())
+	if i.resource == "" {
+		return fmt.Sprintf("%s: %s: %s", location, level, i.message)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s", location, level, i.resource, i.message)
+}
+
+// result of validating one or more manifest files
+type result struct {
+	files     int
+	validated int
+	skipped   int
+	issues    []issue
+}
+
+func (r *result) count(level apis.DiagnosticLevel) int {
+	n := 0
+	for _, i := range r.issues {
+		if i.level == level {
+			n++
+		}
+	}
+	return n
+}
+
+// document is a single resource of a manifest file
+type document struct {
+	file string
+	node *yaml.Node
+}
+
+// validateManifest validates all resources found in the given manifest
+func validateManifest(ctx context.Context, file string, in io.Reader, r *result) {
+	r.files++
+	decoder := yaml.NewDecoder(in)
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			r.issues = append(r.issues, issue{file: file, level: apis.ErrorLevel, message: err.Error()})
+			return
+		}
+		if len(node.Content) == 0 {
+			continue
+		}
+		for _, doc := range expandList(file, node.Content[0]) {
+			doc.validate(ctx, r)
+		}
+	}
+}
+
+// expandList returns the items of a 'List' or the document itself
+func expandList(file string, node *yaml.Node) []document {
+	if node.Kind == yaml.MappingNode && scalar(node, "kind") == "List" {
+		if items := child(node, "items"); items != nil && items.Kind == yaml.SequenceNode {
+			docs := make([]document, 0, len(items.Content))
+			for _, item := range items.Content {
+				docs = append(docs, document{file: file, node: item})
+			}
+			return docs
+		}
+	}
+	return []document{{file: file, node: node}}
+}
+
+func (d document) validate(ctx context.Context, r *result) {
+	if d.node.Kind != yaml.MappingNode {
+		d.report(r, apis.ErrorLevel, "", "", "manifest is not a YAML or JSON object")
+		return
+	}
+	apiVersion, kind := scalar(d.node, "apiVersion"), scalar(d.node, "kind")
+	if apiVersion == "" || kind == "" {
+		d.report(r, apis.ErrorLevel, "", "", "missing field(s): apiVersion, kind")
+		return
+	}
+	description := kind
+	if name := scalar(child(d.node, "metadata"), "name"); name != "" {
+		description = fmt.Sprintf("%s '%s'", kind, name)
+	}
+
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	newResource, ok := resourceKinds[gvk]
+	if !ok {
+		r.skipped++
+		if isKnativeGroup(gvk.Group) {
+			d.report(r, apis.WarningLevel, description, "", fmt.Sprintf("cannot validate unknown kind '%s' of API version '%s'", kind, apiVersion))
+		}
+		return
+	}
+
+	var content interface{}
+	if err := d.node.Decode(&content); err != nil {
+		d.report(r, apis.ErrorLevel, description, "", err.Error())
+		return
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		d.report(r, apis.ErrorLevel, description, "", err.Error())
+		return
+	}
+	obj := newResource()
+	strictErrs, err := sigsjson.UnmarshalStrict(data, obj)
+	if err != nil {
+		d.report(r, apis.ErrorLevel, description, typeErrorPath(data, newResource()), err.Error())
+		return
+	}
+	for _, err := range strictErrs {
+		d.report(r, apis.WarningLevel, description, fieldPath(err), err.Error())
+	}
+
+	r.validated++
+	obj.SetDefaults(ctx)
+	fieldErrs := obj.Validate(ctx)
+	if fieldErrs == nil {
+		return
+	}
+	for _, fe := range fieldErrs.WrappedErrors() {
+		message := fe.Message
+		if fe.Details != "" {
+			message = fmt.Sprintf("%s (%s)", message, fe.Details)
+		}
+		if len(fe.Paths) == 0 {
+			d.report(r, fe.Level, description, "", message)
+			continue
+		}
+		d.report(r, fe.Level, description, fe.Paths[0], fmt.Sprintf("%s: %s", message, strings.Join(fe.Paths, ", ")))
+	}
+}
+
+// report adds an issue at the line of the given field path
+func (d document) report(r *result, level apis.DiagnosticLevel, resource, path, message string) {
+	r.issues = append(r.issues, issue{
+		file:     d.file,
+		line:     locate(d.node, path),
+		level:    level,
+		resource: resource,
+		message:  message,
+	})
+}
+
+// fieldPath returns the path of the field an unmarshal error refers to
+func fieldPath(err error) string {
+	var fieldErr sigsjson.FieldError
+	if errors.As(err, &fieldErr) {
+		return fieldErr.FieldPath()
+	}
+	return ""
+}
+
+// typeErrorPath returns the path of the field which can't be unmarshalled
+// because of its type. Only encoding/json exposes the path of such errors.
+func typeErrorPath(data []byte, obj interface{}) string {
+	var typeErr *json.UnmarshalTypeError
+	if err := json.Unmarshal(data, obj); errors.As(err, &typeErr) {
+		return typeErr.Field
+	}
+	return ""
+}
+
+// locate returns the line of the deepest node found along the given field
+// path, e.g. 'spec.template.spec.containers[0].image' or
+// 'metadata.annotations[autoscaling.knative.dev/min-scale]'
+func locate(node *yaml.Node, path string) int {
+	line := node.Line
+	for path != "" {
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+		path = strings.TrimPrefix(path, ".")
+		var (
+			next    *yaml.Node
+			nextPos int
+			rest    string
+		)
+		switch node.Kind {
+		case yaml.SequenceNode:
+			index, remaining, ok := bracketed(path)
+			if !ok {
+				return line
+			}
+			i, err := strconv.Atoi(index)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return line
+			}
+			next, rest = node.Content[i], remaining
+			nextPos = next.Line
+		case yaml.MappingNode:
+			var key *yaml.Node
+			key, next, rest = lookupKey(node, path)
+			if next == nil {
+				return line
+			}
+			nextPos = key.Line
+		default:
+			return line
+		}
+		node, path, line = next, rest, nextPos
+	}
+	return line
+}
+
+// lookupKey finds the longest key of a mapping node which prefixes the path
+// and returns its key and value node together with the remaining path
+func lookupKey(node *yaml.Node, path string) (*yaml.Node, *yaml.Node, string) {
+	if key, rest, ok := bracketed(path); ok {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				return node.Content[i], node.Content[i+1], rest
+			}
+		}
+		return nil, nil, ""
+	}
+	var (
+		key, value *yaml.Node
+		rest       string
+	)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		k := node.Content[i].Value
+		if key != nil && len(k) <= len(key.Value) {
+			continue
+		}
+		if path == k || strings.HasPrefix(path, k+".") || strings.HasPrefix(path, k+"[") {
+			key, value, rest = node.Content[i], node.Content[i+1], path[len(k):]
+		}
+	}
+	return key, value, rest
+}
+
+// bracketed splits a path starting with '[key]' into the key and the rest
+func bracketed(path string) (string, string, bool) {
+	if !strings.HasPrefix(path, "[") {
+		return "", "", false
+	}
+	end := strings.Index(path, "]")
+	if end < 0 {
+		return "", "", false
+	}
+	return path[1:end], path[end+1:], true
+}
+
+// child returns the value node of the given key of a mapping node
+func child(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// scalar returns the value of the given key of a mapping node if it's a scalar
+func scalar(node *yaml.Node, key string) string {
+	value := child(node, key)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+func isKnativeGroup(group string) bool {
+	for _, g := range knativeGroups {
+		if group == g {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/apis"
+)
+
+const serviceManifest = `apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: foo
+  annotations:
+    autoscaling.knative.dev/min-scale: "1"
+spec:
+  template:
+    spec:
+      containers:
+      - name: user
+        imagePullPolicy: Always
+      - name: sidecar
+        image: sidecar
+`
+
+func TestLocate(t *testing.T) {
+	var node yaml.Node
+	assert.NilError(t, yaml.Unmarshal([]byte(serviceManifest), &node))
+	doc := node.Content[0]
+
+	for _, tc := range []struct {
+		path string
+		line int
+	}{
+		{"", 1},
+		{"kind", 2},
+		{"metadata.name", 4},
+		{"metadata.annotations", 5},
+		{"metadata.annotations[autoscaling.knative.dev/min-scale]", 6},
+		{"metadata.annotations.autoscaling.knative.dev/min-scale", 6},
+		{"spec.template.spec.containers[0].image", 11},
+		{"spec.template.spec.containers[0].imagePullPolicy", 12},
+		{"spec.template.spec.containers[1].image", 14},
+		{"spec.template.spec.containers[2].image", 10},
+		{"spec.traffic[0].percent", 7},
+	} {
+		assert.Equal(t, locate(doc, tc.path), tc.line, "path %s", tc.path)
+	}
+}
+
+func TestValidateManifest(t *testing.T) {
+	manifest := `apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: foo
+  annotations:
+    autoscaling.knative.dev/min-scale: "1"
+spec:
+  template:
+    spec:
+      containers:
+      - name: user
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+---
+apiVersion: sources.knative.dev/v1
+kind: KafkaSource
+metadata:
+  name: kafka
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: eventing.knative.dev/v1
+  kind: Broker
+  metadata:
+    name: default
+  spec:
+    foo: bar
+- apiVersion: eventing.knative.dev/v1
+  kind: Trigger
+  metadata:
+    name: trigger
+  spec:
+    broker: default
+`
+	r := &result{}
+	ctx, err := validationContext(context.Background())
+	assert.NilError(t, err)
+	validateManifest(ctx, "test.yaml", strings.NewReader(manifest), r)
+
+	assert.Equal(t, r.files, 1)
+	assert.Equal(t, r.validated, 3)
+	assert.Equal(t, r.skipped, 2)
+	var errs, warnings []string
+	for _, i := range r.issues {
+		if i.level == apis.ErrorLevel {
+			errs = append(errs, i.String())
+		} else {
+			warnings = append(warnings, i.String())
+		}
+	}
+	assert.DeepEqual(t, errs, []string{
+		"test.yaml:5: error: Service 'foo': invalid key name \"autoscaling.knative.dev/min-scale\" (autoscaling annotations must be put under \"spec.template.metadata.annotations\" to work): metadata.annotations",
+		"test.yaml:11: error: Service 'foo': missing field(s): spec.template.spec.containers[0].image",
+		"test.yaml:36: error: Trigger 'trigger': expected at least one, got none: spec.subscriber.ref, spec.subscriber.uri",
+	})
+	assert.Assert(t, contains(warnings, "test.yaml:18: warning: KafkaSource 'kafka': cannot validate unknown kind 'KafkaSource' of API version 'sources.knative.dev/v1'"))
+	assert.Assert(t, contains(warnings, "test.yaml:31: warning: Broker 'default': unknown field \"spec.foo\""))
+}
+
+func TestValidateManifestInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		manifest string
+		err      string
+	}{
+		{"foo: [bar", "test.yaml: error: yaml: line 1: did not find expected ',' or ']'"},
+		{"- foo", "test.yaml:1: error: manifest is not a YAML or JSON object"},
+		{"metadata:\n  name: foo", "test.yaml:1: error: missing field(s): apiVersion, kind"},
+		{"apiVersion: serving.knative.dev/v1\nkind: Service\nspec:\n  template:\n    spec:\n      containers: foo",
+			"test.yaml:6: error: Service: json: cannot unmarshal string into Go struct field"},
+	} {
+		r := &result{}
+		validateManifest(context.Background(), "test.yaml", strings.NewReader(tc.manifest), r)
+		assert.Equal(t, len(r.issues), 1, tc.manifest)
+		assert.Assert(t, strings.HasPrefix(r.issues[0].String(), tc.err), r.issues[0].String())
+	}
+}
+
+func contains(lines []string, line string) bool {
+	for _, l := range lines {
+		if l == line {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewValidateCommand to validate Knative manifests without a cluster
+func NewValidateCommand(p *commands.KnParams) *cobra.Command {
+	var (
+		filenames []string
+		recursive bool
+	)
+
+	command := &cobra.Command{
+		Use:   "validate -f FILENAME",
+		Short: "Validate Knative manifests without a cluster",
+		Long: `Validate Knative manifests without a cluster
+
+Services, configurations, routes, domain mappings, brokers, triggers, channels,
+subscriptions, event types and sources are defaulted and validated the same way
+as done by the Knative admission webhooks. Other resources are skipped. Errors and
+warnings are printed with the file and line they refer to. The command fails if
+an error has been found.`,
+		Example: `
+  # Validate the manifest of a service
+  kn validate -f service.yaml
+
+  # Validate all manifests in directory 'deploy' and its sub directories
+  kn validate -f deploy -R
+
+  # Validate a manifest given on the standard input
+  cat service.yaml | kn validate -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn validate' does not accept any arguments, use '--filename' to specify manifests")
+			}
+			if len(filenames) == 0 {
+				return errors.New("'kn validate' requires the manifests to validate, use '--filename' to specify them")
+			}
+
+			ctx, err := validationContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			r := &result{}
+			for _, filename := range filenames {
+				if err := validatePath(ctx, filename, recursive, cmd.InOrStdin(), r); err != nil {
+					return err
+				}
+			}
+			return printResult(cmd.OutOrStdout(), r)
+		},
+	}
+	command.Flags().StringArrayVarP(&filenames, "filename", "f", nil, "Manifest file or directory to validate, use '-' to read from the standard input. Can be specified multiple times.")
+	command.Flags().BoolVarP(&recursive, "recursive", "R", false, "Process the directories given with '--filename' recursively.")
+	return command
+}
+
+// validatePath validates a manifest file, the standard input or the manifests of a directory
+func validatePath(ctx context.Context, path string, recursive bool, stdin io.Reader, r *result) error {
+	if path == "-" {
+		validateManifest(ctx, "<stdin>", stdin, r)
+		return nil
+	}
+	return commands.WalkManifests(path, recursive, func(file string) error {
+		return validateFile(ctx, file, r)
+	})
+}
+
+func validateFile(ctx context.Context, file string, r *result) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	validateManifest(ctx, file, f, r)
+	return nil
+}
+
+// printResult prints all issues and a summary, it returns an error if any
+// of the issues is an error
+func printResult(out io.Writer, r *result) error {
+	for _, i := range r.issues {
+		fmt.Fprintln(out, i.String())
+	}
+	errCount, warnCount := r.count(apis.ErrorLevel), r.count(apis.WarningLevel)
+	fmt.Fprintf(out, "Validated %d resource(s) in %d file(s), skipped %d unsupported resource(s): %d error(s), %d warning(s).\n",
+		r.validated, r.files, r.skipped, errCount, warnCount)
+	if errCount > 0 {
+		return fmt.Errorf("validation failed with %d error(s)", errCount)
+	}
+	return nil
+}
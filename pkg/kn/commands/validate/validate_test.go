@@ -0,0 +1,106 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+const validService = `apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: foo
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/foo/bar:baz
+`
+
+const invalidTrigger = `apiVersion: eventing.knative.dev/v1
+kind: Trigger
+metadata:
+  name: foo
+spec:
+  broker: default
+`
+
+func executeValidateCommand(stdin string, args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	cmd := NewValidateCommand(&commands.KnParams{})
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	cmd.SetIn(strings.NewReader(stdin))
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func writeManifest(t *testing.T, path, content string) {
+	assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestValidateFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "service.yaml")
+	writeManifest(t, file, validService)
+
+	out, err := executeValidateCommand("", "-f", file)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Validated 1 resource(s) in 1 file(s)", "0 error(s)"))
+}
+
+func TestValidateDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, filepath.Join(dir, "service.yaml"), validService)
+	writeManifest(t, filepath.Join(dir, "README.md"), "# Manifests")
+	writeManifest(t, filepath.Join(dir, "eventing", "trigger.yml"), invalidTrigger)
+
+	out, err := executeValidateCommand("", "-f", dir)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Validated 1 resource(s) in 1 file(s)"))
+
+	out, err = executeValidateCommand("", "-f", dir, "--recursive")
+	assert.ErrorContains(t, err, "validation failed with 1 error(s)")
+	assert.Assert(t, util.ContainsAll(out,
+		filepath.Join(dir, "eventing", "trigger.yml")+":5: error: Trigger 'foo': expected at least one, got none",
+		"Validated 2 resource(s) in 2 file(s)", "1 error(s)"))
+}
+
+func TestValidateStdin(t *testing.T) {
+	out, err := executeValidateCommand(validService+"---\n"+invalidTrigger, "-f", "-")
+	assert.ErrorContains(t, err, "validation failed")
+	assert.Assert(t, util.ContainsAll(out, "<stdin>:15: error: Trigger 'foo'", "Validated 2 resource(s) in 1 file(s)"))
+}
+
+func TestValidateErrors(t *testing.T) {
+	_, err := executeValidateCommand("")
+	assert.ErrorContains(t, err, "requires the manifests to validate")
+
+	_, err = executeValidateCommand("", "foo.yaml")
+	assert.ErrorContains(t, err, "does not accept any arguments")
+
+	_, err = executeValidateCommand("", "-f", filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "no such file or directory")
+}
@@ -35,6 +35,8 @@ type WaitFlags struct {
 	Wait bool
 	// Duration in seconds for waiting between intermediate false ready conditions
 	ErrorWindowInSeconds int
+	// If set then diagnose the resource when waiting for it times out
+	Diagnose bool
 }
 
 // Add flags which influence the wait/no-wait behaviour when creating, updating, waiting for
@@ -57,3 +59,10 @@ func (p *WaitFlags) AddConditionWaitFlags(command *cobra.Command, waitTimeoutDef
 	windowUsage := fmt.Sprintf("Seconds to wait for %s to be %s after a false ready condition is returned", what, until)
 	command.Flags().IntVar(&p.ErrorWindowInSeconds, "wait-window", 2, windowUsage)
 }
+
+// AddDiagnoseFlag adds a flag for analysing why the resource described by `what` did not become
+// ready when waiting for it times out
+func (p *WaitFlags) AddDiagnoseFlag(command *cobra.Command, what string) {
+	usage := fmt.Sprintf("Append a diagnosis of likely causes to the error when waiting for the %s times out.", what)
+	command.Flags().BoolVar(&p.Diagnose, "wait-diagnose", false, usage)
+}
@@ -17,18 +17,23 @@ package config
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
 
 	homedir "github.com/mitchellh/go-homedir"
 	flag "github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"golang.org/x/term"
 )
 
 // bootstrapDefaults are the defaults values to use
 type defaultConfig struct {
 	configFile          string
+	systemConfigFile    string
 	pluginsDir          string
 	lookupPluginsInPath bool
 }
@@ -51,6 +56,19 @@ const configContentDefaults = `# Taken from https://github.com/knative/client/bl
 #    group: messaging.knative.dev
 #    version: v1alpha1
 #    kind: KafkaChannel
+#output:
+#  color: auto
+#defaults:
+#  namespace: default
+#commands:
+#  service create:
+#    flags:
+#      scale-min: 1
+#lint:
+#  rules:
+#    latest-tag: false
+#  dev-namespaces:
+#  - "*-dev"
 `
 
 // config contains the variables for the Kn config
@@ -67,6 +85,33 @@ type config struct {
 
 	// profiles is a map of profiles from the config file and built-in profiles
 	profiles map[string]Profile
+
+	// noColor is set by --no-color and overrides the configured color mode
+	noColor bool
+
+	// lint is the configuration of the lint rules
+	lint LintConfig
+
+	// defaults are the configured defaults like the namespace
+	defaults DefaultsConfig
+
+	// commands is the configuration of commands by their path
+	commands map[string]CommandConfig
+
+	// layers are the configuration files merged into the configuration
+	layers []Layer
+
+	// layerSettings are the settings read from each of the layers
+	layerSettings []map[string]interface{}
+
+	// merged are the settings merged from all layers
+	merged map[string]interface{}
+
+	// aliases are the user-defined command aliases by their name
+	aliases map[string]string
+
+	// signatures is the configuration of the signature verification of plugins
+	signatures SignaturesConfig
 }
 
 func (c *config) ContextSharing() bool {
@@ -94,6 +139,49 @@ func (c *config) PluginsDir() string {
 	}
 }
 
+// PluginIndex returns the configured plugin index
+func (c *config) PluginIndex() string {
+	return viper.GetString(keyPluginsIndex)
+}
+
+// PluginSignatures returns the configuration of the signature verification of
+// plugins, which is switched off by default
+func (c *config) PluginSignatures() SignaturesConfig {
+	signatures := c.signatures
+	if signatures.Policy == "" {
+		signatures.Policy = SignaturePolicyOff
+	}
+	return signatures
+}
+
+// DefaultNamespace returns the namespace configured for the kubeconfig context,
+// falling back to the globally configured namespace
+func (c *config) DefaultNamespace(kubeContext string) string {
+	return c.defaults.namespace(kubeContext)
+}
+
+// CommandDefaults returns the configured flag values of a command
+func (c *config) CommandDefaults(command string) map[string][]string {
+	return flagValues(c.commands[command].Flags)
+}
+
+// Layers returns the configuration files which have been merged into the
+// configuration, ordered from the lowest to the highest precedence
+func (c *config) Layers() []Layer {
+	return c.layers
+}
+
+// MergedSettings returns the settings merged from all configuration layers and
+// the environment overrides
+func (c *config) MergedSettings() map[string]interface{} {
+	return c.merged
+}
+
+// Aliases returns the user-defined command aliases
+func (c *config) Aliases() map[string]string {
+	return c.aliases
+}
+
 // LookupPluginsInPath returns true if plugins should be also checked in the pat
 func (c *config) LookupPluginsInPath() bool {
 	return bootstrapDefaults.lookupPluginsInPath
@@ -107,10 +195,55 @@ func (c *config) Profile(profile string) Profile {
 	return c.profiles[profile]
 }
 
+func (c *config) ProfileNames() []string {
+	return sortedProfileNames(c.profiles)
+}
+
 func (c *config) ChannelTypeMappings() []ChannelTypeMapping {
 	return c.channelTypeMappings
 }
 
+// Color returns the color mode, which is "never" if --no-color is given
+// and "auto" if not configured otherwise
+func (c *config) Color() string {
+	if c.noColor {
+		return ColorNever
+	}
+	if viper.IsSet(keyColor) {
+		return viper.GetString(keyColor)
+	}
+	return ColorAuto
+}
+
+// Lint returns the lint configuration with defaults for the settings
+// which are not configured
+func (c *config) Lint() LintConfig {
+	lint := c.lint
+	if lint.DevNamespaces == nil {
+		lint.DevNamespaces = DefaultDevNamespaces
+	}
+	if lint.BusyBrokerTriggers == 0 {
+		lint.BusyBrokerTriggers = DefaultBusyBrokerTriggers
+	}
+	return lint
+}
+
+// ColorEnabled returns true if output written to out should be colored with the given
+// color mode. In "auto" mode, output is colored if it goes to a terminal and NO_COLOR is not set.
+func ColorEnabled(mode string, out io.Writer) bool {
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := out.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
 // Config used for flag binding
 var globalConfig = config{}
 
@@ -145,25 +278,22 @@ func BootstrapConfig() error {
 		if !os.IsNotExist(err) {
 			return fmt.Errorf("cannot stat configfile %s: %w", configFile, err)
 		}
-		if err := os.MkdirAll(filepath.Dir(viper.ConfigFileUsed()), 0775); err != nil {
-			// Can't create config directory, proceed silently without reading the config
-			return nil
-		}
-		if err := os.WriteFile(viper.ConfigFileUsed(), []byte(configContentDefaults), 0600); err != nil {
-			// Can't create config file, proceed silently without reading the config
-			return nil
+		// Create a default config file, but proceed silently with the other
+		// configuration layers if that is not possible
+		if err := os.MkdirAll(filepath.Dir(configFile), 0775); err == nil {
+			_ = os.WriteFile(configFile, []byte(configContentDefaults), 0600)
 		}
 	}
 
-	viper.AutomaticEnv() // read in environment variables that match
-
 	// Defaults are taken from the parsed flags, which in turn have bootstrap defaults
 	// TODO: Re-enable when legacy handling for plugin config has been removed
 	// For now default handling is happening directly in the getter of GlobalConfig
 	// viper.SetDefault(keyPluginsDirectory, bootstrapDefaults.pluginsDir)
 
-	// If a config file is found, read it in.
-	err = viper.ReadInConfig()
+	// Merge the system, user and project configuration files and the
+	// environment overrides, in this order of precedence
+	globalConfig.layers = configLayers(configFile)
+	err = readLayers(globalConfig.layers)
 	if err != nil {
 		return err
 	}
@@ -182,13 +312,42 @@ func BootstrapConfig() error {
 
 	// Deserialize channel type mappings if configured
 	err = parseChannelTypeMappings()
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Deserialize lint configuration if configured
+	err = parseLint()
+	if err != nil {
+		return err
+	}
+
+	// Deserialize defaults and command configuration if configured
+	err = parseDefaults()
+	if err != nil {
+		return err
+	}
+
+	// Deserialize command aliases if configured
+	err = parseAliases()
+	if err != nil {
+		return err
+	}
+
+	// Deserialize plugin signature configuration if configured
+	err = parseSignatures()
+	if err != nil {
+		return err
+	}
+
+	return validateColor()
 }
 
 // Add bootstrap flags use in a separate bootstrap proceeds
 func AddBootstrapFlags(flags *flag.FlagSet) {
 	flags.StringVar(&globalConfig.configFile, "config", "", fmt.Sprintf("kn configuration file (default: %s)", defaultConfigFileForUsageMessage()))
 	flags.String(flagPluginsDir, "", "Directory holding kn plugins")
+	flags.BoolVar(&globalConfig.noColor, "no-color", false, "disable colored output, which is also disabled if NO_COLOR is set or the output is not a terminal")
 
 	// Let's try that and mark the flags as hidden: (as those configuration is a permanent choice of operation)
 	flags.MarkHidden(flagPluginsDir)
@@ -201,6 +360,7 @@ func AddBootstrapFlags(flags *flag.FlagSet) {
 func initDefaults() *defaultConfig {
 	return &defaultConfig{
 		configFile:          defaultConfigLocation("config.yaml"),
+		systemConfigFile:    defaultSystemConfigFile(),
 		pluginsDir:          defaultConfigLocation("plugins"),
 		lookupPluginsInPath: true,
 	}
@@ -273,27 +433,263 @@ func parseSinkMappings() error {
 	if key != "" {
 		err := viper.UnmarshalKey(key, &globalConfig.sinkMappings)
 		if err != nil {
-			return fmt.Errorf("error while parsing sink mappings in configuration file %s: %w",
-				viper.ConfigFileUsed(), err)
+			return fmt.Errorf("error while parsing sink mappings in %s: %w",
+				keySources(key), err)
 		}
 	}
 	return nil
 }
 
+// parse the lint configuration and store it in the global configuration
+func parseLint() error {
+	if viper.IsSet(keyLint) {
+		err := viper.UnmarshalKey(keyLint, &globalConfig.lint)
+		if err != nil {
+			return fmt.Errorf("error while parsing lint configuration in %s: %w",
+				keySources(keyLint), err)
+		}
+	}
+	return nil
+}
+
+// parseDefaults unmarshals the configured defaults and the configuration of commands
+func parseDefaults() error {
+	if viper.IsSet(keyDefaults) {
+		err := viper.UnmarshalKey(keyDefaults, &globalConfig.defaults)
+		if err != nil {
+			return fmt.Errorf("error while parsing defaults in %s: %w",
+				keySources(keyDefaults), err)
+		}
+	}
+	if viper.IsSet(keyCommands) {
+		err := viper.UnmarshalKey(keyCommands, &globalConfig.commands)
+		if err != nil {
+			return fmt.Errorf("error while parsing commands configuration in %s: %w",
+				keySources(keyCommands), err)
+		}
+	}
+	return nil
+}
+
+// parseAliases unmarshals the user-defined command aliases
+func parseAliases() error {
+	// Unmarshal into a new map, as the aliases of an earlier bootstrap would be kept otherwise
+	globalConfig.aliases = nil
+	if viper.IsSet(keyAliases) {
+		aliases := map[string]string{}
+		err := viper.UnmarshalKey(keyAliases, &aliases)
+		if err != nil {
+			return fmt.Errorf("error while parsing aliases in %s: %w",
+				keySources(keyAliases), err)
+		}
+		globalConfig.aliases = aliases
+	}
+	return nil
+}
+
+// parseSignatures unmarshals and validates the configuration of plugin signatures
+func parseSignatures() error {
+	globalConfig.signatures = SignaturesConfig{}
+	if !viper.IsSet(keyPluginsSignatures) {
+		return nil
+	}
+	err := viper.UnmarshalKey(keyPluginsSignatures, &globalConfig.signatures)
+	if err != nil {
+		return fmt.Errorf("error while parsing plugin signatures in %s: %w",
+			keySources(keyPluginsSignatures), err)
+	}
+	policy := GlobalConfig.PluginSignatures().Policy
+	for _, supported := range SignaturePolicies {
+		if policy == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value '%s' for %s.policy in %s, must be one of %s",
+		policy, keyPluginsSignatures, keySources(keyPluginsSignatures+".policy"), strings.Join(SignaturePolicies, ", "))
+}
+
+// namespace returns the namespace of the kubeconfig context or the global namespace
+func (d DefaultsConfig) namespace(kubeContext string) string {
+	// Keys are case-insensitive in the configuration file
+	if defaults, ok := d.Contexts[strings.ToLower(kubeContext)]; ok && defaults.Namespace != "" {
+		return defaults.Namespace
+	}
+	return d.Namespace
+}
+
+// flagValues converts configured flag values to their string representation, lists
+// are converted to multiple values
+func flagValues(flags map[string]interface{}) map[string][]string {
+	if len(flags) == 0 {
+		return nil
+	}
+	values := make(map[string][]string, len(flags))
+	for name, value := range flags {
+		if list, ok := value.([]interface{}); ok {
+			for _, item := range list {
+				values[name] = append(values[name], fmt.Sprint(item))
+			}
+			continue
+		}
+		values[name] = []string{fmt.Sprint(value)}
+	}
+	return values
+}
+
+// validateColor checks that the configured color mode is supported
+func validateColor() error {
+	mode := GlobalConfig.Color()
+	for _, supported := range ColorModes {
+		if mode == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value '%s' for %s in %s, must be one of %s",
+		mode, keyColor, keySources(keyColor), strings.Join(ColorModes, ", "))
+}
+
 // parse profiles and store them in the global configuration
 func parseProfiles() error {
 	if viper.IsSet(profiles) {
 		err := viper.UnmarshalKey(profiles, &globalConfig.profiles)
 		if err != nil {
-			return fmt.Errorf("error while parsing profiles in configuration file %s: %w",
-				viper.ConfigFileUsed(), err)
+			return fmt.Errorf("error while parsing profiles in %s: %w",
+				keySources(profiles), err)
 		}
 	}
-	globalConfig.profiles = mergeProfilesWithBuiltInProfiles(globalConfig.profiles)
-
+	resolved, err := resolveProfiles(mergeProfilesWithBuiltInProfiles(globalConfig.profiles))
+	if err != nil {
+		return fmt.Errorf("error while parsing profiles in %s: %w",
+			keySources(profiles), err)
+	}
+	globalConfig.profiles = resolved
 	return nil
 }
 
+// resolveProfiles applies the settings of the inherited profiles to each profile
+func resolveProfiles(profiles map[string]Profile) (map[string]Profile, error) {
+	resolved := make(map[string]Profile, len(profiles))
+	var resolve func(name string, path []string) (Profile, error)
+	resolve = func(name string, path []string) (Profile, error) {
+		if profile, ok := resolved[name]; ok {
+			return profile, nil
+		}
+		for _, parent := range path {
+			if parent == name {
+				return Profile{}, fmt.Errorf("profile '%s' inherits from itself via %s", name, strings.Join(append(path, name), " -> "))
+			}
+		}
+		profile := profiles[name]
+		var merged Profile
+		for _, parentName := range profile.Inherit {
+			// Profile names are case-insensitive in the configuration file
+			parentName = strings.ToLower(parentName)
+			if _, ok := profiles[parentName]; !ok {
+				return Profile{}, fmt.Errorf("profile '%s' inherits from unknown profile '%s'", name, parentName)
+			}
+			parent, err := resolve(parentName, append(path, name))
+			if err != nil {
+				return Profile{}, err
+			}
+			merged = mergeProfile(merged, parent)
+		}
+		merged = mergeProfile(merged, profile)
+		merged.Inherit = profile.Inherit
+		resolved[name] = merged
+		return merged, nil
+	}
+	for name := range profiles {
+		if _, err := resolve(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// mergeProfile returns the profile base with the settings of the profile override
+func mergeProfile(base, override Profile) Profile {
+	merged := base
+	merged.Annotations = mergeNamedValues(base.Annotations, override.Annotations)
+	merged.Labels = mergeNamedValues(base.Labels, override.Labels)
+	merged.Env = mergeNamedValues(base.Env, override.Env)
+	merged.Resources.Requests = mergeStringMaps(base.Resources.Requests, override.Resources.Requests)
+	merged.Resources.Limits = mergeStringMaps(base.Resources.Limits, override.Resources.Limits)
+	merged.NodeSelector = mergeStringMaps(base.NodeSelector, override.NodeSelector)
+	if override.Scale.Min != nil {
+		merged.Scale.Min = override.Scale.Min
+	}
+	if override.Scale.Max != nil {
+		merged.Scale.Max = override.Scale.Max
+	}
+	if override.Scale.Target != nil {
+		merged.Scale.Target = override.Scale.Target
+	}
+	if override.ConcurrencyLimit != nil {
+		merged.ConcurrencyLimit = override.ConcurrencyLimit
+	}
+	if override.Timeout != nil {
+		merged.Timeout = override.Timeout
+	}
+	merged.SecurityContext = overrideString(base.SecurityContext, override.SecurityContext)
+	merged.ServiceAccount = overrideString(base.ServiceAccount, override.ServiceAccount)
+	merged.Probes.Liveness = overrideString(base.Probes.Liveness, override.Probes.Liveness)
+	merged.Probes.LivenessOpts = overrideString(base.Probes.LivenessOpts, override.Probes.LivenessOpts)
+	merged.Probes.Readiness = overrideString(base.Probes.Readiness, override.Probes.Readiness)
+	merged.Probes.ReadinessOpts = overrideString(base.Probes.ReadinessOpts, override.Probes.ReadinessOpts)
+	merged.Delivery.DeadLetterSink = overrideString(base.Delivery.DeadLetterSink, override.Delivery.DeadLetterSink)
+	if override.Delivery.Retry != nil {
+		merged.Delivery.Retry = override.Delivery.Retry
+	}
+	merged.Delivery.Timeout = overrideString(base.Delivery.Timeout, override.Delivery.Timeout)
+	merged.Delivery.BackoffPolicy = overrideString(base.Delivery.BackoffPolicy, override.Delivery.BackoffPolicy)
+	merged.Delivery.BackoffDelay = overrideString(base.Delivery.BackoffDelay, override.Delivery.BackoffDelay)
+	return merged
+}
+
+// mergeNamedValues returns the values of base with the values of override,
+// values with the same name are replaced
+func mergeNamedValues(base, override []NamedValue) []NamedValue {
+	if len(override) == 0 {
+		return base
+	}
+	merged := append([]NamedValue{}, base...)
+	for _, value := range override {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == value.Name {
+				merged[i] = value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
+
+func mergeStringMaps(base, override map[string]string) map[string]string {
+	if len(override) == 0 {
+		return base
+	}
+	merged := make(map[string]string, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
+	}
+	return merged
+}
+
+func overrideString(base, override string) string {
+	if override != "" {
+		return override
+	}
+	return base
+}
+
 // defaultProfiles returns the built-in profiles
 func builtInProfiles() map[string]Profile {
 	return map[string]Profile{
@@ -323,13 +719,22 @@ func mergeProfilesWithBuiltInProfiles(profiles map[string]Profile) map[string]Pr
 	return mergedProfiles
 }
 
+func sortedProfileNames(profiles map[string]Profile) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // parse channel type mappings and store them in the global configuration
 func parseChannelTypeMappings() error {
 	if viper.IsSet(keyChannelTypeMappings) {
 		err := viper.UnmarshalKey(keyChannelTypeMappings, &globalConfig.channelTypeMappings)
 		if err != nil {
-			return fmt.Errorf("error while parsing channel type mappings in configuration file %s: %w",
-				viper.ConfigFileUsed(), err)
+			return fmt.Errorf("error while parsing channel type mappings in %s: %w",
+				keySources(keyChannelTypeMappings), err)
 		}
 	}
 	return nil
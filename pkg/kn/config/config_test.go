@@ -15,6 +15,7 @@
 package config
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -156,6 +157,241 @@ sink:
 	})
 }
 
+func TestBootstrapConfigColor(t *testing.T) {
+	_, cleanup := setupConfig(t, "")
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.Color(), ColorAuto)
+	cleanup()
+
+	_, cleanup = setupConfig(t, "output:\n  color: always\n")
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.Color(), ColorAlways)
+
+	os.Args = append(os.Args, "--no-color")
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.Color(), ColorNever)
+	cleanup()
+
+	_, cleanup = setupConfig(t, "output:\n  color: rainbow\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "invalid value 'rainbow' for output.color")
+}
+
+func TestBootstrapConfigLint(t *testing.T) {
+	_, cleanup := setupConfig(t, "")
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.Lint(), LintConfig{
+		DevNamespaces:      DefaultDevNamespaces,
+		BusyBrokerTriggers: DefaultBusyBrokerTriggers,
+	})
+	cleanup()
+
+	_, cleanup = setupConfig(t, `
+lint:
+  rules:
+    latest-tag: false
+    resources: true
+  dev-namespaces:
+  - sandbox
+  busy-broker-triggers: 2
+`)
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.Lint(), LintConfig{
+		Rules:              map[string]bool{"latest-tag": false, "resources": true},
+		DevNamespaces:      []string{"sandbox"},
+		BusyBrokerTriggers: 2,
+	})
+	cleanup()
+
+	_, cleanup = setupConfig(t, "lint:\n  rules: foo\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "error while parsing lint configuration")
+}
+
+func TestBootstrapConfigDefaults(t *testing.T) {
+	_, cleanup := setupConfig(t, `
+defaults:
+  namespace: team
+  contexts:
+    Prod-Cluster:
+      namespace: team-prod
+    staging:
+      namespace:
+    api.cluster.example.com:6443:
+      namespace: team-ocp
+commands:
+  service create:
+    flags:
+      scale-min: 1
+      profile: prod
+      env:
+      - A=1
+      - B=2
+`)
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.DefaultNamespace("Prod-Cluster"), "team-prod")
+	assert.Equal(t, GlobalConfig.DefaultNamespace("staging"), "team")
+	assert.Equal(t, GlobalConfig.DefaultNamespace(""), "team")
+	assert.Equal(t, GlobalConfig.DefaultNamespace("api.cluster.example.com:6443"), "team-ocp")
+	assert.DeepEqual(t, GlobalConfig.CommandDefaults("service create"), map[string][]string{
+		"scale-min": {"1"},
+		"profile":   {"prod"},
+		"env":       {"A=1", "B=2"},
+	})
+	assert.Assert(t, GlobalConfig.CommandDefaults("service update") == nil)
+	cleanup()
+
+	_, cleanup = setupConfig(t, "defaults:\n  namespace: [foo]\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "error while parsing defaults")
+}
+
+func TestBootstrapConfigAliases(t *testing.T) {
+	_, cleanup := setupConfig(t, `
+aliases:
+  deploy: service apply --wait --profile prod
+  logs: service describe $1 -o yaml
+`)
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.Aliases(), map[string]string{
+		"deploy": "service apply --wait --profile prod",
+		"logs":   "service describe $1 -o yaml",
+	})
+	cleanup()
+
+	_, cleanup = setupConfig(t, "aliases:\n  deploy: [service, apply]\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "error while parsing aliases")
+}
+
+func TestBootstrapConfigPluginSignatures(t *testing.T) {
+	_, cleanup := setupConfig(t, "")
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.PluginSignatures(), SignaturesConfig{Policy: SignaturePolicyOff})
+	cleanup()
+
+	_, cleanup = setupConfig(t, `
+plugins:
+  signatures:
+    policy: enforce
+    trusted-keys:
+    - name: acme
+      key: MCowBQYDK2VwAyEAGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE=
+`)
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.PluginSignatures(), SignaturesConfig{
+		Policy: SignaturePolicyEnforce,
+		TrustedKeys: []TrustedKey{
+			{Name: "acme", Key: "MCowBQYDK2VwAyEAGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE="},
+		},
+	})
+	cleanup()
+
+	_, cleanup = setupConfig(t, "plugins:\n  signatures:\n    policy: strict\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "invalid value 'strict' for plugins.signatures.policy")
+}
+
+func TestBootstrapConfigProfileInheritance(t *testing.T) {
+	_, cleanup := setupConfig(t, `
+profiles:
+  base:
+    inherit: [istio]
+    labels:
+    - name: team
+      value: blue
+    env:
+    - name: LOG_LEVEL
+      value: info
+    resources:
+      limits:
+        memory: 256Mi
+    scale:
+      min: 1
+    node-selector:
+      kubernetes.io/arch: amd64
+    delivery:
+      dl-sink: ksvc:dlq
+      retry: 3
+  prod:
+    inherit: [Base]
+    env:
+    - name: LOG_LEVEL
+      value: warn
+    resources:
+      limits:
+        cpu: "1"
+    scale:
+      max: 5
+    delivery:
+      retry: 5
+`)
+	defer cleanup()
+	assert.NilError(t, BootstrapConfig())
+
+	prod := GlobalConfig.Profile("prod")
+	assert.DeepEqual(t, prod.Inherit, []string{"Base"})
+	assert.Equal(t, len(prod.Annotations), 3)
+	assert.DeepEqual(t, prod.Labels, []NamedValue{{Name: "team", Value: "blue"}})
+	assert.DeepEqual(t, prod.Env, []NamedValue{{Name: "LOG_LEVEL", Value: "warn"}})
+	assert.DeepEqual(t, prod.Resources.Limits, map[string]string{"memory": "256Mi", "cpu": "1"})
+	assert.Equal(t, *prod.Scale.Min, 1)
+	assert.Equal(t, *prod.Scale.Max, 5)
+	assert.DeepEqual(t, prod.NodeSelector, map[string]string{"kubernetes.io/arch": "amd64"})
+	assert.Equal(t, prod.Delivery.DeadLetterSink, "ksvc:dlq")
+	assert.Equal(t, *prod.Delivery.Retry, int32(5))
+
+	base := GlobalConfig.Profile("base")
+	assert.Assert(t, base.Scale.Max == nil)
+	assert.Equal(t, *base.Delivery.Retry, int32(3))
+	assert.Assert(t, !base.IsEmpty())
+	assert.Assert(t, GlobalConfig.Profile("unknown").IsEmpty())
+}
+
+func TestBootstrapConfigProfileInheritanceErrors(t *testing.T) {
+	_, cleanup := setupConfig(t, `
+profiles:
+  a:
+    inherit: [b]
+  b:
+    inherit: [a]
+`)
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "inherits from itself via")
+	cleanup()
+
+	_, cleanup = setupConfig(t, "profiles:\n  a:\n    inherit: [missing]\n")
+	defer cleanup()
+	err = BootstrapConfig()
+	assert.ErrorContains(t, err, "profile 'a' inherits from unknown profile 'missing'")
+}
+
+func TestColorEnabled(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Equal(t, ColorEnabled(ColorAlways, &buf), true)
+	assert.Equal(t, ColorEnabled(ColorNever, os.Stdout), false)
+	// Not a terminal
+	assert.Equal(t, ColorEnabled(ColorAuto, &buf), false)
+
+	t.Setenv("NO_COLOR", "1")
+	assert.Equal(t, ColorEnabled(ColorAuto, os.Stdout), false)
+	assert.Equal(t, ColorEnabled(ColorAlways, os.Stdout), true)
+}
+
 func setupConfig(t *testing.T, configContent string) (string, func()) {
 	tmpDir := t.TempDir()
 
@@ -182,6 +418,7 @@ func setupConfig(t *testing.T, configContent string) (string, func()) {
 	globalConfig = config{}
 	GlobalConfig = &globalConfig
 	bootstrapDefaults = initDefaults()
+	bootstrapDefaults.systemConfigFile = filepath.Join(tmpDir, "system", "config.yaml")
 
 	return cfgFile, func() {
 		// Cleanup everything
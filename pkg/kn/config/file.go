@@ -0,0 +1,245 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// File is a configuration file which can be inspected and changed. Comments
+// are preserved when writing the file back.
+type File struct {
+	path string
+	// raw content as read from disk
+	raw []byte
+	doc yaml.Node
+}
+
+// LoadFile reads a configuration file, a missing file is treated as empty.
+// An error is returned if the file is not valid YAML.
+func LoadFile(path string) (*File, error) {
+	f := &File{path: path}
+	raw, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	f.raw = raw
+	if err := yaml.Unmarshal(raw, &f.doc); err != nil {
+		return nil, fmt.Errorf("cannot parse configuration file %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Path returns the location of the configuration file
+func (f *File) Path() string {
+	return f.path
+}
+
+// Validate checks the configuration file against the configuration schema
+func (f *File) Validate() ([]ConfigIssue, error) {
+	return validateNode(&f.doc)
+}
+
+// Get returns the value of a key like "eventing.sink-mappings" formatted as YAML,
+// scalar values are returned as they are
+func (f *File) Get(key string) (string, bool, error) {
+	node := lookupNode(f.root(), splitKey(key))
+	if node == nil {
+		return "", false, nil
+	}
+	if node.Kind == yaml.ScalarNode {
+		return node.Value, true, nil
+	}
+	out, err := encodeNode(node)
+	if err != nil {
+		return "", false, err
+	}
+	return strings.TrimSuffix(string(out), "\n"), true, nil
+}
+
+// Set sets the value of a key, the value is parsed as YAML. Missing parent
+// keys are created.
+func (f *File) Set(key, value string) error {
+	var valueDoc yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &valueDoc); err != nil {
+		return fmt.Errorf("cannot parse value '%s' for key '%s': %w", value, key, err)
+	}
+	valueNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: ""}
+	if len(valueDoc.Content) > 0 {
+		valueNode = valueDoc.Content[0]
+	}
+
+	parts := splitKey(key)
+	if len(parts) == 0 {
+		return errors.New("the key to set must not be empty")
+	}
+	if f.doc.Kind == 0 {
+		f.doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	node := f.root()
+	for i, part := range parts {
+		if node.Kind != yaml.MappingNode {
+			if i == 0 {
+				return fmt.Errorf("cannot set key '%s' because the configuration file doesn't contain a mapping of keys to values", key)
+			}
+			return fmt.Errorf("cannot set key '%s' because '%s' is not a mapping", key, strings.Join(parts[:i], "."))
+		}
+		child := mappingValue(node, part)
+		if i == len(parts)-1 {
+			if child != nil {
+				// Keep comments of the replaced value
+				valueNode.HeadComment, valueNode.LineComment, valueNode.FootComment = child.HeadComment, child.LineComment, child.FootComment
+				*child = *valueNode
+			} else {
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: part}, valueNode)
+			}
+			return nil
+		}
+		if child == nil || child.Tag == "!!null" {
+			if child == nil {
+				child = &yaml.Node{}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: part}, child)
+			}
+			*child = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		node = child
+	}
+	return nil
+}
+
+// Unset removes a key, it returns false if the key is not set
+func (f *File) Unset(key string) bool {
+	parts := splitKey(key)
+	if len(parts) == 0 {
+		return false
+	}
+	parent := lookupNode(f.root(), parts[:len(parts)-1])
+	if parent == nil || parent.Kind != yaml.MappingNode {
+		return false
+	}
+	name := parts[len(parts)-1]
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == name {
+			parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Bytes returns the content of the configuration file including all changes
+func (f *File) Bytes() ([]byte, error) {
+	if f.doc.Kind == 0 {
+		return f.raw, nil
+	}
+	out, err := encodeNode(&f.doc)
+	if err != nil {
+		return nil, err
+	}
+	// Comments of a file without any keys are not part of the parsed document
+	if isCommentsOnly(f.raw) {
+		return append(ensureNewline(f.raw), out...), nil
+	}
+	return out, nil
+}
+
+// Save writes the configuration file back to disk
+func (f *File) Save() error {
+	out, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0775); err != nil {
+		return err
+	}
+	if err := os.WriteFile(f.path, out, 0600); err != nil {
+		return err
+	}
+	f.raw = out
+	return nil
+}
+
+// root returns the top level mapping of the file or nil if the file is empty
+func (f *File) root() *yaml.Node {
+	if f.doc.Kind != yaml.DocumentNode || len(f.doc.Content) == 0 {
+		return nil
+	}
+	return f.doc.Content[0]
+}
+
+func splitKey(key string) []string {
+	if key == "" {
+		return nil
+	}
+	return strings.Split(key, ".")
+}
+
+func lookupNode(node *yaml.Node, parts []string) *yaml.Node {
+	for _, part := range parts {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+		node = mappingValue(node, part)
+	}
+	return node
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func encodeNode(node *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// isCommentsOnly returns true if the content has no other lines than comments and blank lines
+func isCommentsOnly(content []byte) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+func ensureNewline(content []byte) []byte {
+	result := append([]byte{}, content...)
+	if len(result) > 0 && !bytes.HasSuffix(result, []byte("\n")) {
+		result = append(result, '\n')
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestFileGet(t *testing.T) {
+	f, err := LoadFile(writeConfigFile(t, `
+output:
+  color: never
+eventing:
+  sink-mappings:
+  - prefix: svc
+    resource: services
+`))
+	assert.NilError(t, err)
+
+	value, found, err := f.Get("output.color")
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+	assert.Equal(t, value, "never")
+
+	value, found, err = f.Get("eventing.sink-mappings")
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+	assert.Equal(t, value, "- prefix: svc\n  resource: services")
+
+	_, found, err = f.Get("output.foo")
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+	_, found, err = f.Get("output.color.foo")
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+}
+
+func TestFileSetPreservesComments(t *testing.T) {
+	path := writeConfigFile(t, `# kn configuration
+plugins:
+  # where plugins live
+  directory: ~/plugins # home
+output:
+  color: auto # default
+`)
+	f, err := LoadFile(path)
+	assert.NilError(t, err)
+	assert.NilError(t, f.Set("output.color", "never"))
+	assert.NilError(t, f.Set("lint.rules.latest-tag", "false"))
+	assert.NilError(t, f.Set("lint.dev-namespaces", "[dev, test]"))
+	assert.NilError(t, f.Save())
+
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), `# kn configuration
+plugins:
+  # where plugins live
+  directory: ~/plugins # home
+output:
+  color: never # default
+lint:
+  rules:
+    latest-tag: false
+  dev-namespaces: [dev, test]
+`)
+}
+
+func TestFileSetCommentsOnly(t *testing.T) {
+	path := writeConfigFile(t, configContentDefaults)
+	f, err := LoadFile(path)
+	assert.NilError(t, err)
+	assert.NilError(t, f.Set("output.color", "always"))
+	assert.NilError(t, f.Save())
+
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), configContentDefaults+"output:\n  color: always\n")
+}
+
+func TestFileSetMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kn", "config.yaml")
+	f, err := LoadFile(path)
+	assert.NilError(t, err)
+	assert.NilError(t, f.Set("plugins.directory", "/plugins"))
+	assert.NilError(t, f.Save())
+
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "plugins:\n  directory: /plugins\n")
+}
+
+func TestFileSetErrors(t *testing.T) {
+	f, err := LoadFile(writeConfigFile(t, "output:\n  color: auto\n"))
+	assert.NilError(t, err)
+	assert.ErrorContains(t, f.Set("output.color.foo", "bar"), "cannot set key 'output.color.foo' because 'output.color' is not a mapping")
+	assert.ErrorContains(t, f.Set("output.color", "[foo"), "cannot parse value '[foo' for key 'output.color'")
+	assert.ErrorContains(t, f.Set("", "foo"), "must not be empty")
+
+	f, err = LoadFile(writeConfigFile(t, "- foo\n"))
+	assert.NilError(t, err)
+	assert.ErrorContains(t, f.Set("output.color", "never"), "doesn't contain a mapping")
+
+	_, err = LoadFile(writeConfigFile(t, "output: [foo\n"))
+	assert.ErrorContains(t, err, "cannot parse configuration file")
+}
+
+func TestFileUnset(t *testing.T) {
+	f, err := LoadFile(writeConfigFile(t, "output:\n  color: auto\nplugins:\n  directory: /plugins\n"))
+	assert.NilError(t, err)
+	assert.Assert(t, f.Unset("output.color"))
+	assert.Assert(t, !f.Unset("output.color"))
+	assert.Assert(t, !f.Unset("foo.bar"))
+	assert.Assert(t, f.Unset("plugins"))
+	content, err := f.Bytes()
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "output: {}\n")
+}
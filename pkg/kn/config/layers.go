@@ -0,0 +1,396 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// Names of the configuration layers
+const (
+	// LayerSystem is the system wide configuration file, like /etc/kn/config.yaml
+	LayerSystem = "system"
+	// LayerUser is the configuration file of the user, which can be set with --config
+	LayerUser = "user"
+	// LayerProject is the configuration file .kn/config.yaml of a project, found in the
+	// working directory or one of its parent directories
+	LayerProject = "project"
+)
+
+// EnvPrefix is the prefix of environment variables overriding configuration keys,
+// like KN_OUTPUT_COLOR for 'output.color'
+const EnvPrefix = "KN_"
+
+// projectConfigFile is the configuration file looked up in the working directory and its parents
+var projectConfigFile = filepath.Join(".kn", "config.yaml")
+
+// Layer is a configuration file which is merged into the configuration
+type Layer struct {
+	// Name of the layer, one of LayerSystem, LayerUser or LayerProject
+	Name string
+	// Path of the configuration file
+	Path string
+}
+
+// mergedListKeys are the keys of lists which are merged across layers instead of being
+// replaced. Entries with the same value of the given field are replaced.
+var mergedListKeys = map[string]string{
+	keySinkMappings:        "prefix",
+	legacyKeySinkMappings:  "prefix",
+	keyChannelTypeMappings: "alias",
+	keyPluginsTrustedKeys:  "name",
+}
+
+// untrustedProjectKeys are the keys which are ignored in project configuration files, as
+// a checked out project must neither be able to choose the plugins kn runs nor weaken
+// their verification
+var untrustedProjectKeys = []string{
+	keyPluginsDirectory,
+	legacyKeyPluginsDirectory,
+	keyPluginsIndex,
+	keyPluginsSignatures,
+}
+
+// untrustedProjectCommand is the command group whose flag defaults are ignored in project
+// configuration files, as its flags like --index choose the plugins kn installs
+const untrustedProjectCommand = "plugin"
+
+// configLayers returns the configuration files to merge in the order of their precedence,
+// the last one wins. Only existing files are returned.
+func configLayers(userConfigFile string) []Layer {
+	var layers []Layer
+	if fileExists(bootstrapDefaults.systemConfigFile) {
+		layers = append(layers, Layer{Name: LayerSystem, Path: bootstrapDefaults.systemConfigFile})
+	}
+	if fileExists(userConfigFile) {
+		layers = append(layers, Layer{Name: LayerUser, Path: userConfigFile})
+	}
+	if project := findProjectConfig(userConfigFile); project != "" {
+		layers = append(layers, Layer{Name: LayerProject, Path: project})
+	}
+	return layers
+}
+
+// findProjectConfig walks up from the working directory to find a project configuration
+// file. The lookup stops at the home directory, which holds the user configuration.
+func findProjectConfig(userConfigFile string) string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	home, _ := homedir.Dir()
+	for {
+		if home != "" && dir == home {
+			return ""
+		}
+		candidate := filepath.Join(dir, projectConfigFile)
+		if candidate != userConfigFile && fileExists(candidate) {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// readLayers merges the configuration files of all layers and the environment overrides
+// into the configuration
+func readLayers(layers []Layer) error {
+	merged := map[string]interface{}{}
+	globalConfig.layerSettings = nil
+	for _, layer := range layers {
+		settings, err := readLayer(layer)
+		if err != nil {
+			return err
+		}
+		if layer.Name == LayerProject {
+			for _, key := range untrustedProjectKeys {
+				deleteKey(settings, key)
+			}
+			deleteUntrustedCommands(settings)
+		}
+		globalConfig.layerSettings = append(globalConfig.layerSettings, settings)
+		mergeSettings(merged, settings, "")
+	}
+	overrides, err := envOverrides()
+	if err != nil {
+		return err
+	}
+	mergeSettings(merged, overrides, "")
+	globalConfig.merged = merged
+	// Drop the settings of an earlier bootstrap before merging in the new ones
+	viper.SetConfigType("yaml")
+	if err := viper.ReadConfig(bytes.NewReader(nil)); err != nil {
+		return err
+	}
+	return viper.MergeConfigMap(merged)
+}
+
+// readLayer reads the settings of a configuration file. The file is parsed directly
+// instead of with viper, as viper would split keys containing dots, like the
+// names of kubeconfig contexts, into nested keys.
+func readLayer(layer Layer) (map[string]interface{}, error) {
+	content, err := os.ReadFile(layer.Path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s configuration file %s: %w", layer.Name, layer.Path, err)
+	}
+	settings := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return nil, fmt.Errorf("cannot read %s configuration file %s: %w", layer.Name, layer.Path, err)
+	}
+	return lowerKeys(settings), nil
+}
+
+// keySources describes where the value of a key comes from for error messages, i.e. the
+// configuration files and the environment variables setting the key or one of its nested
+// keys. Sources whose value is replaced by a later source are left out.
+func keySources(key string) string {
+	var sources []string
+	// index of the last source replacing the value of the earlier ones
+	start := 0
+	_, mergedList := mergedListKeys[key]
+	replaces := func(value interface{}) bool {
+		_, isMap := value.(map[string]interface{})
+		return !isMap && !mergedList
+	}
+	for i, settings := range globalConfig.layerSettings {
+		value, ok := lookupKey(settings, key)
+		if !ok || i >= len(globalConfig.layers) {
+			continue
+		}
+		if replaces(value) {
+			start = len(sources)
+		}
+		layer := globalConfig.layers[i]
+		sources = append(sources, fmt.Sprintf("%s configuration file %s", layer.Name, layer.Path))
+	}
+	keys, _ := overridableKeys()
+	for _, envKey := range keys {
+		if envKey != key && !strings.HasPrefix(envKey, key+".") && !strings.HasPrefix(key, envKey+".") {
+			continue
+		}
+		if _, ok := os.LookupEnv(EnvVarName(envKey)); !ok {
+			continue
+		}
+		// A variable for a nested key only sets part of the value
+		if !strings.HasPrefix(envKey, key+".") && !mergedList {
+			start = len(sources)
+		}
+		sources = append(sources, "environment variable "+EnvVarName(envKey))
+	}
+	if len(sources) == 0 {
+		return "configuration"
+	}
+	return strings.Join(sources[start:], ", ")
+}
+
+// lookupKey returns the value of a nested key like "plugins.signatures" in the settings
+func lookupKey(settings map[string]interface{}, key string) (interface{}, bool) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := settings[part].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		settings = child
+	}
+	value, ok := settings[parts[len(parts)-1]]
+	return value, ok
+}
+
+// lowerKeys converts the keys of the settings to lower case, like viper does for all keys
+func lowerKeys(settings map[string]interface{}) map[string]interface{} {
+	lowered := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = lowerKeys(nested)
+		}
+		lowered[strings.ToLower(key)] = value
+	}
+	return lowered
+}
+
+// deleteKey removes a nested key like "plugins.signatures" from the settings
+func deleteKey(settings map[string]interface{}, key string) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := settings[part].(map[string]interface{})
+		if !ok {
+			return
+		}
+		settings = child
+	}
+	delete(settings, parts[len(parts)-1])
+}
+
+// deleteUntrustedCommands deletes the flag defaults of the commands of the untrusted
+// command group from the settings
+func deleteUntrustedCommands(settings map[string]interface{}) {
+	commands, ok := settings[keyCommands].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for command := range commands {
+		if fields := strings.Fields(command); len(fields) > 0 && fields[0] == untrustedProjectCommand {
+			delete(commands, command)
+		}
+	}
+}
+
+// mergeSettings merges the settings of src into dst. Nested keys are merged, values of
+// other keys are replaced, except for the lists in mergedListKeys.
+func mergeSettings(dst, src map[string]interface{}, prefix string) {
+	for key, value := range src {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + "." + key
+		}
+		if idField, ok := mergedListKeys[fullKey]; ok {
+			dst[key] = mergeLists(dst[key], value, idField)
+			continue
+		}
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeSettings(dstMap, srcMap, fullKey)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// mergeLists appends the entries of src to dst, entries of dst with the same value
+// of the id field as an entry of src are replaced
+func mergeLists(dst, src interface{}, idField string) interface{} {
+	dstList, dstOk := dst.([]interface{})
+	srcList, srcOk := src.([]interface{})
+	if !dstOk || !srcOk {
+		return src
+	}
+	merged := append([]interface{}{}, dstList...)
+	for _, entry := range srcList {
+		replaced := false
+		if id := listEntryID(entry, idField); id != "" {
+			for i := range merged {
+				if listEntryID(merged[i], idField) == id {
+					merged[i] = entry
+					replaced = true
+					break
+				}
+			}
+		}
+		if !replaced {
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
+
+func listEntryID(entry interface{}, idField string) string {
+	if m, ok := entry.(map[string]interface{}); ok {
+		for k, v := range m {
+			if strings.EqualFold(k, idField) {
+				return fmt.Sprint(v)
+			}
+		}
+	}
+	return ""
+}
+
+// EnvVarName returns the name of the environment variable overriding a configuration key
+func EnvVarName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+}
+
+// envOverrides returns the settings given as environment variables. Values are parsed
+// as YAML, so that lists like sink mappings can be given, too.
+func envOverrides() (map[string]interface{}, error) {
+	keys, err := overridableKeys()
+	if err != nil {
+		return nil, err
+	}
+	settings := map[string]interface{}{}
+	for _, key := range keys {
+		env, ok := os.LookupEnv(EnvVarName(key))
+		if !ok {
+			continue
+		}
+		var value interface{}
+		if err := yaml.Unmarshal([]byte(env), &value); err != nil {
+			return nil, fmt.Errorf("cannot parse value of environment variable %s: %w", EnvVarName(key), err)
+		}
+		parts := strings.Split(key, ".")
+		parent := settings
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := parent[part].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[part] = child
+			}
+			parent = child
+		}
+		parent[parts[len(parts)-1]] = value
+	}
+	return settings, nil
+}
+
+// overridableKeys returns all keys of the configuration schema which have a value,
+// i.e. all keys except the ones which only group other keys
+func overridableKeys() ([]string, error) {
+	root, err := loadSchema()
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	var collect func(s *schema, prefix string)
+	collect = func(s *schema, prefix string) {
+		for name, property := range s.Properties {
+			key := joinKey(prefix, name)
+			if property.Type == "object" && len(property.Properties) > 0 {
+				collect(property, key)
+				continue
+			}
+			keys = append(keys, key)
+		}
+	}
+	collect(root, "")
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// defaultSystemConfigFile returns the location of the system wide configuration file
+func defaultSystemConfigFile() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("ProgramData"), "kn", "config.yaml")
+	}
+	return filepath.Join("/etc", "kn", "config.yaml")
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
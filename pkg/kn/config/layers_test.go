@@ -0,0 +1,344 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestBootstrapConfigLayers(t *testing.T) {
+	userFile, cleanup := setupConfig(t, `
+eventing:
+  sink-mappings:
+  - prefix: svc
+    group: core
+    version: v1
+    resource: services
+  - prefix: user
+    group: example.com
+    version: v1
+    resource: users
+output:
+  color: always
+`)
+	defer cleanup()
+	home := filepath.Dir(userFile)
+
+	writeFile(t, bootstrapDefaults.systemConfigFile, `
+eventing:
+  sink-mappings:
+  - prefix: system
+    group: example.com
+    version: v1
+    resource: systems
+  channel-type-mappings:
+  - alias: kafka
+    group: messaging.knative.dev
+    version: v1alpha1
+    kind: KafkaChannel
+profiles:
+  org:
+    labels:
+    - name: org
+      value: acme
+lint:
+  busy-broker-triggers: 3
+`)
+	projectDir := filepath.Join(home, "project")
+	writeFile(t, filepath.Join(projectDir, ".kn", "config.yaml"), `
+eventing:
+  sink-mappings:
+  - prefix: svc
+    group: serving.knative.dev
+    version: v1
+    resource: services
+profiles:
+  team:
+    annotations:
+    - name: team
+      value: blue
+output:
+  color: never
+`)
+	chdir(t, filepath.Join(projectDir, "sub", "dir"))
+
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.Layers(), []Layer{
+		{Name: LayerSystem, Path: bootstrapDefaults.systemConfigFile},
+		{Name: LayerUser, Path: userFile},
+		{Name: LayerProject, Path: filepath.Join(projectDir, ".kn", "config.yaml")},
+	})
+	assert.DeepEqual(t, GlobalConfig.SinkMappings(), []SinkMapping{
+		{Prefix: "system", Group: "example.com", Version: "v1", Resource: "systems"},
+		{Prefix: "svc", Group: "serving.knative.dev", Version: "v1", Resource: "services"},
+		{Prefix: "user", Group: "example.com", Version: "v1", Resource: "users"},
+	})
+	assert.Equal(t, len(GlobalConfig.ChannelTypeMappings()), 1)
+	assert.DeepEqual(t, GlobalConfig.Profile("org").Labels, []NamedValue{{Name: "org", Value: "acme"}})
+	assert.DeepEqual(t, GlobalConfig.Profile("team").Annotations, []NamedValue{{Name: "team", Value: "blue"}})
+	assert.Equal(t, GlobalConfig.Color(), ColorNever)
+	assert.Equal(t, GlobalConfig.Lint().BusyBrokerTriggers, 3)
+}
+
+func TestBootstrapConfigLayersWithoutProject(t *testing.T) {
+	userFile, cleanup := setupConfig(t, "output:\n  color: always\n")
+	defer cleanup()
+	chdir(t, filepath.Dir(userFile))
+
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.Layers(), []Layer{{Name: LayerUser, Path: userFile}})
+	assert.Equal(t, GlobalConfig.Color(), ColorAlways)
+}
+
+func TestBootstrapConfigTwice(t *testing.T) {
+	userFile, cleanup := setupConfig(t, "output:\n  color: always\n")
+	defer cleanup()
+	chdir(t, filepath.Dir(userFile))
+
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.Color(), ColorAlways)
+
+	// Settings of the first bootstrap must not survive a second one
+	writeFile(t, userFile, "plugins:\n  directory: /tmp/plugins\n")
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.Color(), ColorAuto)
+	assert.Equal(t, GlobalConfig.PluginsDir(), "/tmp/plugins")
+}
+
+func TestReadLayerKeepsDottedKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, "Profiles:\n  team.Blue:\n    labels:\n    - name: team\n      value: blue\n")
+
+	settings, err := readLayer(Layer{Name: LayerUser, Path: path})
+	assert.NilError(t, err)
+	profiles, ok := settings["profiles"].(map[string]interface{})
+	assert.Assert(t, ok)
+	_, ok = profiles["team.blue"]
+	assert.Assert(t, ok, "dotted key was split: %v", profiles)
+}
+
+func TestBootstrapConfigProjectSignatures(t *testing.T) {
+	userFile, cleanup := setupConfig(t, `
+plugins:
+  signatures:
+    policy: enforce
+    trusted-keys:
+    - name: acme
+      key: user-key
+`)
+	defer cleanup()
+	projectDir := filepath.Join(filepath.Dir(userFile), "project")
+	writeFile(t, filepath.Join(projectDir, ".kn", "config.yaml"), `
+plugins:
+  directory: /project/plugins
+  signatures:
+    policy: "off"
+    trusted-keys:
+    - name: acme
+      key: project-key
+`)
+	chdir(t, projectDir)
+
+	// A project must not be able to weaken plugin signature verification
+	assert.NilError(t, BootstrapConfig())
+	assert.DeepEqual(t, GlobalConfig.PluginSignatures(), SignaturesConfig{
+		Policy:      SignaturePolicyEnforce,
+		TrustedKeys: []TrustedKey{{Name: "acme", Key: "user-key"}},
+	})
+}
+
+func TestBootstrapConfigProjectPluginLocations(t *testing.T) {
+	userFile, cleanup := setupConfig(t, `
+plugins:
+  directory: /user/plugins
+  index: https://user.example.com/index.yaml
+`)
+	defer cleanup()
+	projectDir := filepath.Join(filepath.Dir(userFile), "project")
+	writeFile(t, filepath.Join(projectDir, ".kn", "config.yaml"), `
+plugins-dir: /project/legacy-plugins
+plugins:
+  directory: /project/plugins
+  index: https://project.example.com/index.yaml
+output:
+  color: never
+commands:
+  plugin install:
+    flags:
+      index: https://project.example.com/index.yaml
+  plugin upgrade:
+    flags:
+      index: https://project.example.com/index.yaml
+  service create:
+    flags:
+      scale-min: 1
+`)
+	chdir(t, projectDir)
+
+	// A project must not be able to choose the plugins kn runs
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.PluginsDir(), "/user/plugins")
+	assert.Equal(t, GlobalConfig.PluginIndex(), "https://user.example.com/index.yaml")
+	assert.Equal(t, GlobalConfig.Color(), ColorNever)
+	assert.Equal(t, len(GlobalConfig.CommandDefaults("plugin install")), 0)
+	assert.Equal(t, len(GlobalConfig.CommandDefaults("plugin upgrade")), 0)
+	assert.DeepEqual(t, GlobalConfig.CommandDefaults("service create"), map[string][]string{"scale-min": {"1"}})
+}
+
+func TestBootstrapConfigInvalidLayer(t *testing.T) {
+	_, cleanup := setupConfig(t, "output:\n  color: always\n")
+	defer cleanup()
+	writeFile(t, bootstrapDefaults.systemConfigFile, "output: [\n")
+
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "cannot read system configuration file "+bootstrapDefaults.systemConfigFile)
+}
+
+func TestBootstrapConfigEnvOverrides(t *testing.T) {
+	_, cleanup := setupConfig(t, `
+eventing:
+  sink-mappings:
+  - prefix: svc
+    group: core
+    version: v1
+    resource: services
+output:
+  color: always
+`)
+	defer cleanup()
+	t.Setenv("KN_OUTPUT_COLOR", "never")
+	t.Setenv("KN_EVENTING_SINK_MAPPINGS", "[{prefix: env, group: example.com, version: v1, resource: envs}]")
+	t.Setenv("KN_LINT_BUSY_BROKER_TRIGGERS", "7")
+	t.Setenv("KN_PLUGINS_DIRECTORY", "/tmp/env-plugins")
+
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.Color(), ColorNever)
+	assert.Equal(t, GlobalConfig.Lint().BusyBrokerTriggers, 7)
+	assert.Equal(t, GlobalConfig.PluginsDir(), "/tmp/env-plugins")
+	assert.DeepEqual(t, GlobalConfig.SinkMappings(), []SinkMapping{
+		{Prefix: "svc", Group: "core", Version: "v1", Resource: "services"},
+		{Prefix: "env", Group: "example.com", Version: "v1", Resource: "envs"},
+	})
+	assert.Equal(t, GlobalConfig.MergedSettings()["output"].(map[string]interface{})["color"], "never")
+}
+
+func TestBootstrapConfigEnvOverridesFlagsWin(t *testing.T) {
+	cfgFile, cleanup := setupConfig(t, "plugins:\n  directory: /tmp/file-plugins\n")
+	defer cleanup()
+	t.Setenv("KN_PLUGINS_DIRECTORY", "/tmp/env-plugins")
+	os.Args = []string{"kn", "--config", cfgFile, "--plugins-dir", "/tmp/flag-plugins"}
+
+	assert.NilError(t, BootstrapConfig())
+	assert.Equal(t, GlobalConfig.PluginsDir(), "/tmp/flag-plugins")
+}
+
+func TestBootstrapConfigInvalidEnvOverride(t *testing.T) {
+	_, cleanup := setupConfig(t, "output:\n  color: always\n")
+	defer cleanup()
+	t.Setenv("KN_EVENTING_SINK_MAPPINGS", "[foo")
+
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "environment variable KN_EVENTING_SINK_MAPPINGS")
+}
+
+func TestBootstrapConfigErrorNamesLayer(t *testing.T) {
+	userFile, cleanup := setupConfig(t, "lint:\n  busy-broker-triggers: 3\n")
+	defer cleanup()
+	projectFile := filepath.Join(filepath.Dir(userFile), "project", ".kn", "config.yaml")
+	writeFile(t, projectFile, "lint:\n  rules: foo\noutput:\n  color: rainbow\n")
+	chdir(t, filepath.Dir(filepath.Dir(projectFile)))
+
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "error while parsing lint configuration in user configuration file "+userFile+", project configuration file "+projectFile)
+
+	writeFile(t, projectFile, "output:\n  color: rainbow\n")
+	err = BootstrapConfig()
+	assert.ErrorContains(t, err, "invalid value 'rainbow' for output.color in project configuration file "+projectFile+", must be")
+
+	// A value replaced by a later layer is not reported
+	t.Setenv("KN_OUTPUT_COLOR", "blue")
+	err = BootstrapConfig()
+	assert.ErrorContains(t, err, "invalid value 'blue' for output.color in environment variable KN_OUTPUT_COLOR, must be")
+}
+
+func TestEnvVarName(t *testing.T) {
+	assert.Equal(t, EnvVarName("output.color"), "KN_OUTPUT_COLOR")
+	assert.Equal(t, EnvVarName("eventing.sink-mappings"), "KN_EVENTING_SINK_MAPPINGS")
+	assert.Equal(t, EnvVarName("plugins-dir"), "KN_PLUGINS_DIR")
+}
+
+func TestOverridableKeys(t *testing.T) {
+	keys, err := overridableKeys()
+	assert.NilError(t, err)
+	assert.Assert(t, containsString(keys, "output.color"))
+	assert.Assert(t, containsString(keys, "profiles"))
+	assert.Assert(t, containsString(keys, "lint.rules"))
+	assert.Assert(t, !containsString(keys, "output"))
+	assert.Assert(t, !containsString(keys, "eventing"))
+}
+
+func TestMergeSettings(t *testing.T) {
+	dst := map[string]interface{}{
+		"eventing": map[string]interface{}{
+			"channel-type-mappings": []interface{}{
+				map[string]interface{}{"alias": "kafka", "kind": "KafkaChannel"},
+			},
+		},
+		"lint": map[string]interface{}{
+			"dev-namespaces": []interface{}{"dev"},
+		},
+	}
+	mergeSettings(dst, map[string]interface{}{
+		"eventing": map[string]interface{}{
+			"channel-type-mappings": []interface{}{
+				map[string]interface{}{"alias": "kafka", "kind": "OtherChannel"},
+				map[string]interface{}{"alias": "imc", "kind": "InMemoryChannel"},
+			},
+		},
+		"lint": map[string]interface{}{
+			"dev-namespaces": []interface{}{"sandbox"},
+		},
+	}, "")
+	assert.DeepEqual(t, dst, map[string]interface{}{
+		"eventing": map[string]interface{}{
+			"channel-type-mappings": []interface{}{
+				map[string]interface{}{"alias": "kafka", "kind": "OtherChannel"},
+				map[string]interface{}{"alias": "imc", "kind": "InMemoryChannel"},
+			},
+		},
+		"lint": map[string]interface{}{
+			"dev-namespaces": []interface{}{"sandbox"},
+		},
+	})
+}
+
+func writeFile(t *testing.T, path, content string) {
+	assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func chdir(t *testing.T, dir string) {
+	assert.NilError(t, os.MkdirAll(dir, 0755))
+	wd, err := os.Getwd()
+	assert.NilError(t, err)
+	assert.NilError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		assert.NilError(t, os.Chdir(wd))
+	})
+}
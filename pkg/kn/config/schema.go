@@ -0,0 +1,220 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed config-schema.json
+var schemaJSON []byte
+
+// Schema returns the JSON schema of the configuration file
+func Schema() []byte {
+	return schemaJSON
+}
+
+// schema is the subset of JSON schema used for describing the configuration file
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Description          string             `json:"description"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	Required             []string           `json:"required"`
+	Enum                 []string           `json:"enum"`
+	Minimum              *int               `json:"minimum"`
+	Deprecated           bool               `json:"deprecated"`
+	Replacement          string             `json:"x-replacement"`
+	Defs                 map[string]*schema `json:"$defs"`
+}
+
+// ConfigIssue is a problem found when validating a configuration file
+type ConfigIssue struct {
+	// Line of the configuration file the issue refers to
+	Line int
+	// Key is the path of the key the issue refers to, like "eventing.sink-mappings[0].prefix"
+	Key string
+	// Message describes the issue
+	Message string
+	// Warning is true for issues which don't prevent using the configuration
+	Warning bool
+}
+
+// schemaValidator checks a parsed configuration file against the schema
+type schemaValidator struct {
+	root   *schema
+	issues []ConfigIssue
+}
+
+func loadSchema() (*schema, error) {
+	var s schema
+	if err := json.Unmarshal(schemaJSON, &s); err != nil {
+		return nil, fmt.Errorf("cannot parse configuration schema: %w", err)
+	}
+	return &s, nil
+}
+
+// validateNode returns the issues of a parsed configuration file
+func validateNode(doc *yaml.Node) ([]ConfigIssue, error) {
+	root, err := loadSchema()
+	if err != nil {
+		return nil, err
+	}
+	v := &schemaValidator{root: root}
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		v.validate(doc.Content[0], root, "")
+	}
+	return v.issues, nil
+}
+
+func (v *schemaValidator) report(node *yaml.Node, key, format string, args ...interface{}) {
+	v.issues = append(v.issues, ConfigIssue{Line: node.Line, Key: key, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *schemaValidator) resolve(s *schema) *schema {
+	if name, ok := strings.CutPrefix(s.Ref, "#/$defs/"); ok {
+		if def, found := v.root.Defs[name]; found {
+			return def
+		}
+	}
+	return s
+}
+
+func (v *schemaValidator) validate(node *yaml.Node, s *schema, key string) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	s = v.resolve(s)
+	// An empty value, like 'plugins:' without any nested keys, is the same as not setting the key
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+	switch s.Type {
+	case "object":
+		v.validateObject(node, s, key)
+	case "array":
+		if node.Kind != yaml.SequenceNode {
+			v.report(node, key, "must be a list")
+			return
+		}
+		for i, item := range node.Content {
+			v.validate(item, s.Items, fmt.Sprintf("%s[%d]", key, i))
+		}
+	case "string":
+		if node.Kind != yaml.ScalarNode {
+			v.report(node, key, "must be a string")
+			return
+		}
+		if len(s.Enum) > 0 && !containsString(s.Enum, node.Value) {
+			v.report(node, key, "invalid value '%s', must be one of %s", node.Value, strings.Join(s.Enum, ", "))
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(node.Value); node.Kind != yaml.ScalarNode || err != nil {
+			v.report(node, key, "must be 'true' or 'false'")
+		}
+	case "integer":
+		value, err := strconv.Atoi(node.Value)
+		if node.Kind != yaml.ScalarNode || err != nil {
+			v.report(node, key, "must be an integer")
+			return
+		}
+		if s.Minimum != nil && value < *s.Minimum {
+			v.report(node, key, "must be at least %d", *s.Minimum)
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(node *yaml.Node, s *schema, key string) {
+	if node.Kind != yaml.MappingNode {
+		v.report(node, key, "must be a mapping of keys to values")
+		return
+	}
+	var additional *schema
+	if len(s.AdditionalProperties) > 0 && string(s.AdditionalProperties) != "false" {
+		additional = &schema{}
+		if string(s.AdditionalProperties) != "true" {
+			if err := json.Unmarshal(s.AdditionalProperties, additional); err != nil {
+				v.report(node, key, "invalid configuration schema: %v", err)
+				return
+			}
+		}
+	}
+	present := map[string]bool{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name, value := node.Content[i].Value, node.Content[i+1]
+		present[name] = true
+		childKey := joinKey(key, name)
+		property, known := s.Properties[name]
+		switch {
+		case known:
+			if property.Deprecated {
+				v.issues = append(v.issues, ConfigIssue{
+					Line:    node.Content[i].Line,
+					Key:     childKey,
+					Message: fmt.Sprintf("deprecated, use '%s' instead", property.Replacement),
+					Warning: true,
+				})
+			}
+			v.validate(value, property, childKey)
+		case additional != nil:
+			v.validate(value, additional, childKey)
+		default:
+			v.report(node.Content[i], childKey, "unknown key%s", v.suggestion(s))
+		}
+	}
+	for _, name := range s.Required {
+		if !present[name] {
+			v.report(node, key, "missing key '%s'", name)
+		}
+	}
+}
+
+// suggestion lists the known keys for an unknown key
+func (v *schemaValidator) suggestion(s *schema) string {
+	known := make([]string, 0, len(s.Properties))
+	for property := range s.Properties {
+		known = append(known, property)
+	}
+	if len(known) == 0 {
+		return ""
+	}
+	sort.Strings(known)
+	return fmt.Sprintf(", must be one of %s", strings.Join(known, ", "))
+}
+
+func joinKey(key, name string) string {
+	if key == "" {
+		return name
+	}
+	return key + "." + name
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	"gotest.tools/v3/assert"
+)
+
+func validateContent(t *testing.T, content string) []ConfigIssue {
+	var doc yaml.Node
+	assert.NilError(t, yaml.Unmarshal([]byte(content), &doc))
+	issues, err := validateNode(&doc)
+	assert.NilError(t, err)
+	return issues
+}
+
+func TestValidateValidConfig(t *testing.T) {
+	issues := validateContent(t, `
+features:
+  context-sharing: true
+plugins:
+  directory: ~/.config/kn/plugins
+  signatures:
+    policy: warn
+    trusted-keys:
+    - name: acme
+      key: MCowBQYDK2VwAyEAGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE=
+eventing:
+  sink-mappings:
+  - prefix: svc
+    group: core
+    version: v1
+    resource: services
+  channel-type-mappings:
+  - alias: kafka
+    group: messaging.knative.dev
+    version: v1alpha1
+    kind: KafkaChannel
+profiles:
+  foo:
+    annotations:
+    - name: a
+      value: b
+    labels:
+    - name: c
+output:
+  color: always
+lint:
+  rules:
+    latest-tag: false
+  dev-namespaces: ["*-dev"]
+  busy-broker-triggers: 3
+defaults:
+  namespace: team
+  contexts:
+    prod:
+      namespace: team-prod
+commands:
+  service create:
+    flags:
+      scale-min: 1
+      env: [A=1, B=2]
+aliases:
+  deploy: service apply --wait --profile prod
+`)
+	assert.Equal(t, len(issues), 0, "%v", issues)
+
+	assert.Equal(t, len(validateContent(t, "")), 0)
+	assert.Equal(t, len(validateContent(t, "# only comments\n#output:\n#  color: auto\n")), 0)
+	assert.Equal(t, len(validateContent(t, "plugins:\n")), 0)
+}
+
+func TestValidateInvalidConfig(t *testing.T) {
+	issues := validateContent(t, `features:
+  context-sharing: maybe
+eventing:
+  sink-mappings:
+  - prefix: svc
+    resource: services
+    group: core
+    version: v1
+    namespace: foo
+  - prefix: broker
+  channel-type-mappings: kafka
+profiles:
+  foo:
+    annotations: a=b
+output:
+  colour: auto
+lint:
+  busy-broker-triggers: many
+plugins-dir: /plugins
+sink: []
+`)
+	assert.DeepEqual(t, issues, []ConfigIssue{
+		{Line: 2, Key: "features.context-sharing", Message: "must be 'true' or 'false'"},
+		{Line: 9, Key: "eventing.sink-mappings[0].namespace", Message: "unknown key, must be one of group, prefix, resource, version"},
+		{Line: 10, Key: "eventing.sink-mappings[1]", Message: "missing key 'resource'"},
+		{Line: 10, Key: "eventing.sink-mappings[1]", Message: "missing key 'group'"},
+		{Line: 10, Key: "eventing.sink-mappings[1]", Message: "missing key 'version'"},
+		{Line: 11, Key: "eventing.channel-type-mappings", Message: "must be a list"},
+		{Line: 14, Key: "profiles.foo.annotations", Message: "must be a list"},
+		{Line: 16, Key: "output.colour", Message: "unknown key, must be one of color"},
+		{Line: 18, Key: "lint.busy-broker-triggers", Message: "must be an integer"},
+		{Line: 19, Key: "plugins-dir", Message: "deprecated, use 'plugins.directory' instead", Warning: true},
+		{Line: 20, Key: "sink", Message: "deprecated, use 'eventing.sink-mappings' instead", Warning: true},
+	})
+
+	issues = validateContent(t, "- foo\n")
+	assert.DeepEqual(t, issues, []ConfigIssue{{Line: 1, Message: "must be a mapping of keys to values"}})
+}
+
+func TestSchemaIsValidJSON(t *testing.T) {
+	s, err := loadSchema()
+	assert.NilError(t, err)
+	assert.Equal(t, s.Type, "object")
+	assert.Assert(t, len(Schema()) > 0)
+}
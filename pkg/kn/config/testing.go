@@ -22,9 +22,18 @@ type TestConfig struct {
 	TestPluginsDir          string
 	TestConfigFile          string
 	TestLookupPluginsInPath bool
+	TestPluginIndex         string
+	TestPluginSignatures    SignaturesConfig
 	TestSinkMappings        []SinkMapping
 	TestChannelTypeMappings []ChannelTypeMapping
 	TestProfiles            map[string]Profile
+	TestColor               string
+	TestLint                LintConfig
+	TestDefaults            DefaultsConfig
+	TestCommandDefaults     map[string]map[string][]string
+	TestLayers              []Layer
+	TestMergedSettings      map[string]interface{}
+	TestAliases             map[string]string
 }
 
 // Ensure that TestConfig implements the configuration interface
@@ -34,6 +43,20 @@ func (t TestConfig) ContextSharing() bool                      { return t.TestCo
 func (t TestConfig) PluginsDir() string                        { return t.TestPluginsDir }
 func (t TestConfig) ConfigFile() string                        { return t.TestConfigFile }
 func (t TestConfig) LookupPluginsInPath() bool                 { return t.TestLookupPluginsInPath }
+func (t TestConfig) PluginIndex() string                       { return t.TestPluginIndex }
+func (t TestConfig) PluginSignatures() SignaturesConfig        { return t.TestPluginSignatures }
 func (t TestConfig) SinkMappings() []SinkMapping               { return t.TestSinkMappings }
 func (t TestConfig) ChannelTypeMappings() []ChannelTypeMapping { return t.TestChannelTypeMappings }
 func (t TestConfig) Profile(profile string) Profile            { return t.TestProfiles[profile] }
+func (t TestConfig) ProfileNames() []string                    { return sortedProfileNames(t.TestProfiles) }
+func (t TestConfig) Color() string                             { return t.TestColor }
+func (t TestConfig) Lint() LintConfig                          { return t.TestLint }
+func (t TestConfig) DefaultNamespace(kubeContext string) string {
+	return t.TestDefaults.namespace(kubeContext)
+}
+func (t TestConfig) CommandDefaults(command string) map[string][]string {
+	return t.TestCommandDefaults[command]
+}
+func (t TestConfig) Layers() []Layer                        { return t.TestLayers }
+func (t TestConfig) MergedSettings() map[string]interface{} { return t.TestMergedSettings }
+func (t TestConfig) Aliases() map[string]string             { return t.TestAliases }
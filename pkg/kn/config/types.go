@@ -14,6 +14,8 @@
 
 package config
 
+import "reflect"
+
 // Package for holding configuration types used in bootstrapping
 // and for types in configuration files
 
@@ -32,6 +34,12 @@ type Config interface {
 	// in the execution path
 	LookupPluginsInPath() bool
 
+	// PluginIndex returns the plugin index used by 'kn plugin install' if none is given
+	PluginIndex() string
+
+	// PluginSignatures returns the configuration of the signature verification of plugins
+	PluginSignatures() SignaturesConfig
+
 	// SinkMappings returns additional mappings for sink prefixes to resources
 	SinkMappings() []SinkMapping
 
@@ -40,6 +48,36 @@ type Config interface {
 
 	// Profile returns a configured profile with this name or nil of no such profile is configured
 	Profile(profile string) Profile
+
+	// ProfileNames returns the sorted names of all configured and built-in profiles
+	ProfileNames() []string
+
+	// Color returns when to use colored output, one of ColorAuto, ColorAlways or ColorNever
+	Color() string
+
+	// Lint returns the configuration of the lint rules
+	Lint() LintConfig
+
+	// DefaultNamespace returns the namespace configured for the given kubeconfig
+	// context or the globally configured namespace, or "" if none is configured
+	DefaultNamespace(kubeContext string) string
+
+	// CommandDefaults returns the configured values of flags for the command with the
+	// given path without the binary name, like "service create". Each flag has a list
+	// of values, which has more than one value for repeatable flags.
+	CommandDefaults(command string) map[string][]string
+
+	// Layers returns the configuration files merged into the configuration,
+	// ordered from the lowest to the highest precedence
+	Layers() []Layer
+
+	// MergedSettings returns the settings merged from all configuration layers
+	// and the environment overrides, without the settings given as flags
+	MergedSettings() map[string]interface{}
+
+	// Aliases returns the user-defined command aliases, mapping the name of an
+	// alias to the command line it expands to
+	Aliases() map[string]string
 }
 
 // SinkMappings is the struct of sink prefix config in kn config
@@ -82,16 +120,173 @@ type NamedValue struct {
 
 // Profile is the struct of profile config in kn config
 type Profile struct {
+
+	// Inherit lists the profiles whose settings are applied before the settings of this profile
+	Inherit []string `yaml:"inherit" mapstructure:"inherit"`
+
 	Annotations []NamedValue `yaml:"annotations"`
 	Labels      []NamedValue `yaml:"labels"`
+
+	// Env are the environment variables of the container
+	Env []NamedValue `yaml:"env" mapstructure:"env"`
+
+	// Resources are the resource requests and limits of the container
+	Resources ProfileResources `yaml:"resources" mapstructure:"resources"`
+
+	// Scale are the autoscaling settings of the service
+	Scale ProfileScale `yaml:"scale" mapstructure:"scale"`
+
+	// ConcurrencyLimit is the hard limit of concurrent requests of a replica
+	ConcurrencyLimit *int `yaml:"concurrency-limit" mapstructure:"concurrency-limit"`
+
+	// Timeout is the duration in seconds that a request is allowed to take
+	Timeout *int64 `yaml:"timeout" mapstructure:"timeout"`
+
+	// SecurityContext is the predefined security context of the container ("none" or "strict")
+	SecurityContext string `yaml:"security-context" mapstructure:"security-context"`
+
+	// NodeSelector selects the nodes on which the service is scheduled
+	NodeSelector map[string]string `yaml:"node-selector" mapstructure:"node-selector"`
+
+	// ServiceAccount is the service account the service runs as
+	ServiceAccount string `yaml:"service-account" mapstructure:"service-account"`
+
+	// Probes are the health probes of the container
+	Probes ProfileProbes `yaml:"probes" mapstructure:"probes"`
+
+	// Delivery are the event delivery settings used for brokers and triggers
+	Delivery ProfileDelivery `yaml:"delivery" mapstructure:"delivery"`
+}
+
+// ProfileResources are the resource requests and limits of a profile, like "cpu: 100m"
+type ProfileResources struct {
+	Requests map[string]string `yaml:"requests" mapstructure:"requests"`
+	Limits   map[string]string `yaml:"limits" mapstructure:"limits"`
+}
+
+// ProfileScale are the autoscaling settings of a profile
+type ProfileScale struct {
+
+	// Min is the minimum number of replicas
+	Min *int `yaml:"min" mapstructure:"min"`
+
+	// Max is the maximum number of replicas
+	Max *int `yaml:"max" mapstructure:"max"`
+
+	// Target is the number of concurrent requests per replica the autoscaler aims for
+	Target *int `yaml:"target" mapstructure:"target"`
+}
+
+// ProfileProbes are the health probes of a profile, given in the format of the
+// '--probe-liveness' and '--probe-readiness' flags, like "http::8080:/healthz"
+type ProfileProbes struct {
+	Liveness      string `yaml:"liveness" mapstructure:"liveness"`
+	LivenessOpts  string `yaml:"liveness-opts" mapstructure:"liveness-opts"`
+	Readiness     string `yaml:"readiness" mapstructure:"readiness"`
+	ReadinessOpts string `yaml:"readiness-opts" mapstructure:"readiness-opts"`
+}
+
+// ProfileDelivery are the event delivery settings of a profile, given in the format
+// of the delivery flags of 'kn broker create'
+type ProfileDelivery struct {
+
+	// DeadLetterSink receives the events which could not be delivered, like "ksvc:dlq"
+	DeadLetterSink string `yaml:"dl-sink" mapstructure:"dl-sink"`
+
+	// Retry is the minimum number of retries before an event is sent to the dead letter sink
+	Retry *int32 `yaml:"retry" mapstructure:"retry"`
+
+	// Timeout is the timeout of each request, like "PT10S"
+	Timeout string `yaml:"timeout" mapstructure:"timeout"`
+
+	// BackoffPolicy is the retry backoff policy ("linear" or "exponential")
+	BackoffPolicy string `yaml:"backoff-policy" mapstructure:"backoff-policy"`
+
+	// BackoffDelay is the delay before retrying, like "PT0.5S"
+	BackoffDelay string `yaml:"backoff-delay" mapstructure:"backoff-delay"`
+}
+
+// IsEmpty returns true if the profile doesn't set anything
+func (p Profile) IsEmpty() bool {
+	return reflect.DeepEqual(p, Profile{})
+}
+
+// IsEmpty returns true if no delivery settings are given
+func (d ProfileDelivery) IsEmpty() bool {
+	return d == ProfileDelivery{}
+}
+
+// LintConfig is the struct of the lint config in kn config
+type LintConfig struct {
+
+	// Rules enables (true) or disables (false) lint rules by their name
+	Rules map[string]bool `mapstructure:"rules"`
+
+	// DevNamespaces are patterns of namespace names used for development (like "*-dev")
+	DevNamespaces []string `mapstructure:"dev-namespaces"`
+
+	// BusyBrokerTriggers is the number of triggers from which on a broker is considered busy
+	BusyBrokerTriggers int `mapstructure:"busy-broker-triggers"`
+}
+
+// SignaturesConfig is the struct of the plugin signature config in kn config
+type SignaturesConfig struct {
+
+	// Policy is one of SignaturePolicyOff, SignaturePolicyWarn or SignaturePolicyEnforce
+	Policy string `mapstructure:"policy"`
+
+	// TrustedKeys are the public keys which plugin binaries are signed with
+	TrustedKeys []TrustedKey `mapstructure:"trusted-keys"`
+}
+
+// TrustedKey is a public key trusted for signing plugin binaries
+type TrustedKey struct {
+
+	// Name of the key, like the name of the team owning it
+	Name string `mapstructure:"name"`
+
+	// Key is an ed25519 public key, either base64 encoded or PEM encoded in PKIX format
+	Key string `mapstructure:"key"`
+}
+
+// DefaultsConfig is the struct of the defaults config in kn config
+type DefaultsConfig struct {
+
+	// Namespace is the namespace to use when --namespace is not given
+	Namespace string `mapstructure:"namespace"`
+
+	// Contexts are defaults for kubeconfig contexts, overriding the global defaults
+	Contexts map[string]ContextDefaults `mapstructure:"contexts"`
+}
+
+// ContextDefaults is the struct of the defaults for a kubeconfig context in kn config
+type ContextDefaults struct {
+
+	// Namespace is the namespace to use when --namespace is not given
+	Namespace string `mapstructure:"namespace"`
+}
+
+// CommandConfig is the struct of the configuration of a command in kn config
+type CommandConfig struct {
+
+	// Flags are the values of flags used when not given on the command line
+	Flags map[string]interface{} `mapstructure:"flags"`
 }
 
 // config Keys for looking up in viper
 const (
 	keyFeaturesContextSharing = "features.context-sharing"
 	keyPluginsDirectory       = "plugins.directory"
+	keyPluginsIndex           = "plugins.index"
+	keyPluginsSignatures      = "plugins.signatures"
+	keyPluginsTrustedKeys     = "plugins.signatures.trusted-keys"
 	keySinkMappings           = "eventing.sink-mappings"
 	keyChannelTypeMappings    = "eventing.channel-type-mappings"
+	keyColor                  = "output.color"
+	keyLint                   = "lint"
+	keyDefaults               = "defaults"
+	keyCommands               = "commands"
+	keyAliases                = "aliases"
 	profiles                  = "profiles"
 )
 
@@ -107,6 +302,40 @@ const (
 	flagPluginsDir = "plugins-dir"
 )
 
+// color modes for output
+const (
+	// ColorAuto colors output written to a terminal, unless NO_COLOR is set
+	ColorAuto = "auto"
+	// ColorAlways colors all output
+	ColorAlways = "always"
+	// ColorNever disables colored output
+	ColorNever = "never"
+)
+
+// ColorModes are all supported color modes
+var ColorModes = []string{ColorAuto, ColorAlways, ColorNever}
+
+// signature policies for plugins
+const (
+	// SignaturePolicyOff doesn't verify the signatures of plugins
+	SignaturePolicyOff = "off"
+	// SignaturePolicyWarn warns about plugins without a valid signature
+	SignaturePolicyWarn = "warn"
+	// SignaturePolicyEnforce refuses to run plugins without a valid signature
+	SignaturePolicyEnforce = "enforce"
+)
+
+// SignaturePolicies are all supported signature policies
+var SignaturePolicies = []string{SignaturePolicyOff, SignaturePolicyWarn, SignaturePolicyEnforce}
+
+// lint defaults, used if not configured otherwise
+var (
+	// DefaultDevNamespaces are the default patterns of development namespaces
+	DefaultDevNamespaces = []string{"dev", "dev-*", "*-dev"}
+	// DefaultBusyBrokerTriggers is the default number of triggers of a busy broker
+	DefaultBusyBrokerTriggers = 5
+)
+
 // default profiles
 const (
 	istio = "istio"
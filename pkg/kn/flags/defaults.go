@@ -0,0 +1,94 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// conflictsAnnotation is the annotation of a flag which lists the flags that can't be
+// provided together with it
+const conflictsAnnotation = "knative.dev/client/conflicting-flags"
+
+// MarkFlagsConflicting marks flags which can't be provided together, so that a configured
+// default of one of them isn't set when another one has been provided on the command line
+func MarkFlagsConflicting(f *pflag.FlagSet, names ...string) {
+	for _, name := range names {
+		flag := f.Lookup(name)
+		if flag == nil {
+			continue
+		}
+		if flag.Annotations == nil {
+			flag.Annotations = map[string][]string{}
+		}
+		for _, other := range names {
+			if other != name {
+				flag.Annotations[conflictsAnnotation] = append(flag.Annotations[conflictsAnnotation], other)
+			}
+		}
+	}
+}
+
+// SetFlagDefaults sets the given values of flags which have not been provided on the
+// command line. Repeatable flags are set once for each value. A flag is also left
+// alone if its "--no-" counterpart or a conflicting flag has been provided.
+func SetFlagDefaults(f *pflag.FlagSet, defaults map[string][]string) error {
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		flag := f.Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unknown flag --%s", name)
+		}
+		if flag.Changed || counterpartChanged(f, name) || conflictingFlagChanged(f, flag) {
+			continue
+		}
+		for _, value := range defaults[name] {
+			if err := f.Set(name, value); err != nil {
+				return fmt.Errorf("invalid value '%s' for flag --%s: %w", value, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// counterpartChanged returns true if the "--no-" counterpart of a flag, or the
+// positive flag of a "--no-" flag, has been provided
+func counterpartChanged(f *pflag.FlagSet, name string) bool {
+	counterpart := negPrefix + name
+	if positive, ok := strings.CutPrefix(name, negPrefix); ok {
+		counterpart = positive
+	}
+	flag := f.Lookup(counterpart)
+	return flag != nil && flag.Changed
+}
+
+// conflictingFlagChanged returns true if a flag marked as conflicting with the given flag
+// has been provided
+func conflictingFlagChanged(f *pflag.FlagSet, flag *pflag.Flag) bool {
+	for _, name := range flag.Annotations[conflictsAnnotation] {
+		if f.Changed(name) {
+			return true
+		}
+	}
+	return false
+}
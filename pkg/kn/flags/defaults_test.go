@@ -0,0 +1,102 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"gotest.tools/v3/assert"
+)
+
+func newDefaultsFlagSet() (*pflag.FlagSet, *int, *[]string, *bool) {
+	f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	scaleMin := f.Int("scale-min", 0, "")
+	env := f.StringArray("env", nil, "")
+	var wait bool
+	AddBothBoolFlags(f, &wait, "wait", "", true, "")
+	f.String("profile", "", "")
+	return f, scaleMin, env, &wait
+}
+
+func TestSetFlagDefaults(t *testing.T) {
+	f, scaleMin, env, _ := newDefaultsFlagSet()
+	assert.NilError(t, f.Parse([]string{"--profile", "dev"}))
+
+	err := SetFlagDefaults(f, map[string][]string{
+		"scale-min": {"1"},
+		"env":       {"A=1", "B=2"},
+		"profile":   {"prod"},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, *scaleMin, 1)
+	assert.DeepEqual(t, *env, []string{"A=1", "B=2"})
+	profile, _ := f.GetString("profile")
+	assert.Equal(t, profile, "dev")
+	assert.Assert(t, f.Changed("scale-min"))
+}
+
+func TestSetFlagDefaultsGivenRepeatable(t *testing.T) {
+	f, _, env, _ := newDefaultsFlagSet()
+	assert.NilError(t, f.Parse([]string{"--env", "C=3"}))
+
+	assert.NilError(t, SetFlagDefaults(f, map[string][]string{"env": {"A=1"}}))
+	assert.DeepEqual(t, *env, []string{"C=3"})
+}
+
+func TestSetFlagDefaultsCounterpart(t *testing.T) {
+	f, _, _, wait := newDefaultsFlagSet()
+	assert.NilError(t, f.Parse([]string{"--wait"}))
+
+	assert.NilError(t, SetFlagDefaults(f, map[string][]string{"no-wait": {"true"}}))
+	assert.NilError(t, ReconcileBoolFlags(f))
+	assert.Equal(t, *wait, true)
+
+	f, _, _, wait = newDefaultsFlagSet()
+	assert.NilError(t, f.Parse(nil))
+	assert.NilError(t, SetFlagDefaults(f, map[string][]string{"no-wait": {"true"}}))
+	assert.NilError(t, ReconcileBoolFlags(f))
+	assert.Equal(t, *wait, false)
+}
+
+func TestSetFlagDefaultsErrors(t *testing.T) {
+	f, _, _, _ := newDefaultsFlagSet()
+	assert.NilError(t, f.Parse(nil))
+
+	err := SetFlagDefaults(f, map[string][]string{"foo": {"bar"}})
+	assert.ErrorContains(t, err, "unknown flag --foo")
+
+	err = SetFlagDefaults(f, map[string][]string{"scale-min": {"many"}})
+	assert.ErrorContains(t, err, "invalid value 'many' for flag --scale-min")
+}
+
+func TestSetFlagDefaultsConflicting(t *testing.T) {
+	f, scaleMin, _, _ := newDefaultsFlagSet()
+	scale := f.String("scale", "", "")
+	MarkFlagsConflicting(f, "scale", "scale-min")
+	assert.NilError(t, f.Parse([]string{"--scale", "3"}))
+
+	assert.NilError(t, SetFlagDefaults(f, map[string][]string{"scale-min": {"1"}}))
+	assert.Equal(t, *scaleMin, 0)
+	assert.Assert(t, !f.Changed("scale-min"))
+	assert.Equal(t, *scale, "3")
+
+	f, scaleMin, _, _ = newDefaultsFlagSet()
+	f.String("scale", "", "")
+	MarkFlagsConflicting(f, "scale", "scale-min")
+	assert.NilError(t, f.Parse(nil))
+	assert.NilError(t, SetFlagDefaults(f, map[string][]string{"scale-min": {"1"}}))
+	assert.Equal(t, *scaleMin, 1)
+}
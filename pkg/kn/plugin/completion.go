@@ -0,0 +1,148 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// pluginCallTimeout limits the time kn waits for a plugin which is called for its manifest
+// or for shell completions
+var pluginCallTimeout = 5 * time.Second
+
+// RegisterCompletions adds the plugins below a command group to the shell completion of the
+// group's sub-commands, for all groups which don't complete their arguments on their own
+func (manager *Manager) RegisterCompletions(cmd *cobra.Command) {
+	if !cmd.HasSubCommands() {
+		return
+	}
+	if cmd.ValidArgsFunction == nil && len(cmd.ValidArgs) == 0 {
+		cmd.ValidArgsFunction = manager.completePluginNames
+	}
+	for _, sub := range cmd.Commands() {
+		manager.RegisterCompletions(sub)
+	}
+}
+
+// completePluginNames returns the command names of the plugins below a command group
+func (manager *Manager) completePluginNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	list, err := manager.ListPluginsForCommandGroup(extractCommandGroup(cmd, []string{}))
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var completions []string
+	for _, pl := range list {
+		name := pl.CommandParts()[len(pl.CommandParts())-1]
+		if !strings.HasPrefix(name, toComplete) {
+			continue
+		}
+		completions = append(completions, name+"\t"+DescriptionOf(pl, manager.helpManifest(pl)))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// Complete forwards a shell completion request to an external plugin, which is called as
+// 'kn-foo __complete args...' and answers like any cobra based program. The completions
+// of the plugin are merged with the flags declared in its manifest.
+func (manager *Manager) Complete(pl Plugin, args []string) ([]string, cobra.ShellCompDirective) {
+	if _, err := manager.signatures.Check(pl); err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions, directive, err := requestCompletions(pl, args)
+	if err != nil {
+		completions, directive = nil, cobra.ShellCompDirectiveDefault
+	}
+
+	toComplete := ""
+	if len(args) > 0 {
+		toComplete = args[len(args)-1]
+	}
+	if !strings.HasPrefix(toComplete, "-") {
+		return completions, directive
+	}
+	manifest := manager.helpManifest(pl)
+	if manifest == nil {
+		return completions, directive
+	}
+	seen := map[string]bool{}
+	for _, c := range completions {
+		seen[strings.Split(c, "\t")[0]] = true
+	}
+	for _, flag := range manifest.Flags {
+		for _, name := range manifestFlagNames(flag) {
+			if strings.HasPrefix(name, toComplete) && !seen[name] {
+				completions = append(completions, name+"\t"+flag.Description)
+				seen[name] = true
+				directive = cobra.ShellCompDirectiveNoFileComp
+			}
+		}
+	}
+	return completions, directive
+}
+
+// requestCompletions calls the plugin with the completion request and parses its answer,
+// which consists of one completion per line followed by a line with the directive
+func requestCompletions(pl Plugin, args []string) ([]string, cobra.ShellCompDirective, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	//nolint:gosec // Passing the arguments through is expected, the plugins are trusted.
+	out, err := exec.CommandContext(ctx, pl.Path(), append([]string{cobra.ShellCompRequestCmd}, args...)...).Output()
+	if err != nil {
+		return nil, 0, err
+	}
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	last := lines[len(lines)-1]
+	if !strings.HasPrefix(last, ":") {
+		return nil, 0, fmt.Errorf("no completion directive in the answer of plugin %s", pl.Name())
+	}
+	directive, err := strconv.Atoi(last[1:])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid completion directive '%s' in the answer of plugin %s", last, pl.Name())
+	}
+	return lines[:len(lines)-1], cobra.ShellCompDirective(directive), nil
+}
+
+// manifestFlagNames returns the names of a flag declared in a manifest, as used on the command line
+func manifestFlagNames(flag ManifestFlag) []string {
+	names := []string{"--" + flag.Name}
+	if flag.Shorthand != "" {
+		names = append(names, "-"+flag.Shorthand)
+	}
+	return names
+}
+
+// FlagsUsage formats the flags declared in a manifest like in a usage line, e.g.
+// "[--cluster] [-v|--verbose]"
+func FlagsUsage(flags []ManifestFlag) string {
+	usage := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if flag.Shorthand != "" {
+			usage = append(usage, fmt.Sprintf("[-%s|--%s]", flag.Shorthand, flag.Name))
+		} else {
+			usage = append(usage, fmt.Sprintf("[--%s]", flag.Name))
+		}
+	}
+	return strings.Join(usage, " ")
+}
@@ -0,0 +1,131 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package plugin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/config"
+)
+
+var testCompletionPluginScript = `#!/bin/bash
+case "$1" in
+manifest) echo '{"description":"Manage functions","flags":[{"name":"path","shorthand":"p","description":"Path"},{"name":"verbose"}]}';;
+__complete) shift; echo "deploy	Deploy $*"; echo "--verbose	Verbose output"; echo ":4";;
+esac
+`
+
+var testManifestOnlyPluginScript = `#!/bin/bash
+case "$1" in
+manifest) echo '{"flags":[{"name":"path","shorthand":"p","description":"Path"}]}';;
+*) exit 1;;
+esac
+`
+
+func TestPluginComplete(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPluginInDirectoryFromScript(t, "kn-func", ctx.pluginsDir, testCompletionPluginScript)
+	createTestPluginInDirectoryFromScript(t, "kn-admin", ctx.pluginsDir, testManifestOnlyPluginScript)
+	createTestPlugin(t, "kn-plain", ctx)
+
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestConfigFile: filepath.Join(t.TempDir(), "config.yaml")}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	fn, err := ctx.pluginManager.FindPlugin([]string{"func"})
+	assert.NilError(t, err)
+
+	// Flags of manifests are only offered once the manifests are cached
+	completions, _ := ctx.pluginManager.Complete(fn, []string{"-"})
+	assert.DeepEqual(t, completions, []string{"deploy\tDeploy -", "--verbose\tVerbose output"})
+	_, err = ctx.pluginManager.RefreshManifests()
+	assert.NilError(t, err)
+
+	completions, directive := ctx.pluginManager.Complete(fn, []string{"a", ""})
+	assert.DeepEqual(t, completions, []string{"deploy\tDeploy a ", "--verbose\tVerbose output"})
+	assert.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+
+	// Flags of the manifest are merged with the completions of the plugin
+	completions, _ = ctx.pluginManager.Complete(fn, []string{"-"})
+	assert.DeepEqual(t, completions, []string{"deploy\tDeploy -", "--verbose\tVerbose output", "--path\tPath", "-p\tPath"})
+
+	admin, err := ctx.pluginManager.FindPlugin([]string{"admin"})
+	assert.NilError(t, err)
+	completions, directive = ctx.pluginManager.Complete(admin, []string{"--p"})
+	assert.DeepEqual(t, completions, []string{"--path\tPath"})
+	assert.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+
+	completions, directive = ctx.pluginManager.Complete(admin, []string{""})
+	assert.Assert(t, len(completions) == 0)
+	assert.Equal(t, directive, cobra.ShellCompDirectiveDefault)
+
+	// A plugin without completion support, which echoes its arguments
+	plain, err := ctx.pluginManager.FindPlugin([]string{"plain"})
+	assert.NilError(t, err)
+	completions, directive = ctx.pluginManager.Complete(plain, []string{"--"})
+	assert.Assert(t, len(completions) == 0)
+	assert.Equal(t, directive, cobra.ShellCompDirectiveDefault)
+
+	// Unsigned plugins are not asked for completions if signatures are enforced
+	verifier, err := NewSignatureVerifier(config.SignaturesConfig{Policy: config.SignaturePolicyEnforce})
+	assert.NilError(t, err)
+	ctx.pluginManager.SetSignatureVerifier(verifier)
+	completions, directive = ctx.pluginManager.Complete(fn, []string{""})
+	assert.Assert(t, len(completions) == 0)
+	assert.Equal(t, directive, cobra.ShellCompDirectiveError)
+	assert.Assert(t, ctx.pluginManager.Manifest(fn) == nil)
+}
+
+func TestRegisterCompletions(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPlugin(t, "kn-admin", ctx)
+	createTestPlugin(t, "kn-service-log_2", ctx)
+
+	root := &cobra.Command{Use: "kn"}
+	serviceCmd := &cobra.Command{Use: "service"}
+	serviceCmd.AddCommand(&cobra.Command{Use: "create"})
+	completionCmd := &cobra.Command{Use: "completion", ValidArgs: []string{"bash"}}
+	completionCmd.AddCommand(&cobra.Command{Use: "dummy"})
+	root.AddCommand(serviceCmd, completionCmd)
+	ctx.pluginManager.RegisterCompletions(root)
+
+	assert.Assert(t, completionCmd.ValidArgsFunction == nil)
+	assert.Assert(t, serviceCmd.Commands()[0].ValidArgsFunction == nil)
+
+	completions, directive := root.ValidArgsFunction(root, nil, "a")
+	assert.Assert(t, len(completions) == 1 && completions[0][:6] == "admin\t")
+	assert.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+
+	completions, _ = serviceCmd.ValidArgsFunction(serviceCmd, nil, "")
+	assert.Assert(t, len(completions) == 1 && completions[0][:6] == "log-2\t")
+
+	completions, _ = root.ValidArgsFunction(root, nil, "x")
+	assert.Assert(t, len(completions) == 0)
+	completions, _ = root.ValidArgsFunction(root, []string{"foo"}, "")
+	assert.Assert(t, len(completions) == 0)
+}
+
+func TestFlagsUsage(t *testing.T) {
+	assert.Equal(t, FlagsUsage(nil), "")
+	assert.Equal(t, FlagsUsage([]ManifestFlag{{Name: "cluster"}, {Name: "verbose", Shorthand: "v"}}), "[--cluster] [-v|--verbose]")
+}
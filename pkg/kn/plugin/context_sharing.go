@@ -16,11 +16,14 @@ package plugin
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"knative.dev/client/pkg/kn/config"
@@ -45,6 +48,30 @@ type Manifest struct {
 	// plugin is interested in to consume. Nil or an empty list declares
 	// that this plugin is not a ContextDataConsumer
 	ConsumesContextDataKeys []string `json:"consumesKeys,omitempty"`
+
+	// Description is a short description of the plugin, which is shown in the
+	// help and in the list of plugins
+	Description string `json:"description,omitempty"`
+
+	// Flags declares the flags of the plugin, which are shown in the help and
+	// offered by the shell completion
+	Flags []ManifestFlag `json:"flags,omitempty"`
+
+	// ModTime is the modification time of the plugin binary the manifest has
+	// been fetched from. A cached manifest is only used as long as it matches.
+	ModTime int64 `json:"modTime,omitempty"`
+}
+
+// ManifestFlag describes a flag declared in a plugin manifest
+type ManifestFlag struct {
+	// Name of the flag without the leading dashes
+	Name string `json:"name"`
+
+	// Shorthand is the single letter abbreviation of the flag
+	Shorthand string `json:"shorthand,omitempty"`
+
+	// Description of the flag
+	Description string `json:"description,omitempty"`
 }
 
 // PluginWithManifest represents extended plugin support for Manifest and Context Sharing feature
@@ -64,14 +91,31 @@ type PluginWithManifest interface {
 
 //--TYPES--
 
+// ContextDataEnv is the environment variable holding the context data for the keys an
+// external plugin consumes, as JSON object
+const ContextDataEnv = "KN_PLUGIN_CONTEXT"
+
+// ContextOutputEnv is the environment variable holding the path of the file to which an
+// external plugin writes the context data it produces, as JSON object
+const ContextOutputEnv = "KN_PLUGIN_CONTEXT_OUTPUT"
+
+// contextCacheFile is the file next to the configuration file which caches the manifests
+// of the plugins and the context data produced by external plugins
+const contextCacheFile = "context.json"
+
 var CtxManager *ContextDataManager
 
 type ContextDataManager struct {
-	//ContextData map[string]ContextData `json:"-"`
 	PluginManager *Manager            `json:"-"`
 	Producers     map[string][]string `json:"producers"`
 	Consumers     map[string][]string `json:"consumers"`
 	Manifests     map[string]Manifest `json:"manifests"`
+
+	// Data holds the context data produced by external plugins
+	Data map[string]string `json:"data,omitempty"`
+
+	// Whether the cache file has been loaded already
+	cacheLoaded bool
 }
 
 func NewContextManager(pluginManager *Manager) (*ContextDataManager, error) {
@@ -81,6 +125,7 @@ func NewContextManager(pluginManager *Manager) (*ContextDataManager, error) {
 			Producers:     map[string][]string{},
 			Consumers:     map[string][]string{},
 			Manifests:     map[string]Manifest{},
+			Data:          map[string]string{},
 		}
 	}
 	return CtxManager, nil
@@ -96,6 +141,8 @@ func (c *ContextDataManager) GetProducesKeys(pluginName string) []string {
 	return c.Manifests[pluginName].ProducesContextDataKeys
 }
 
+// FetchContextData returns the current context data. It consists of the data stored for
+// external producers, overridden by the data of the inlined producers for their keys.
 func (c *ContextDataManager) FetchContextData() (map[string]string, error) {
 	// Load cached data first
 	if err := c.loadCache(); err != nil {
@@ -106,60 +153,158 @@ func (c *ContextDataManager) FetchContextData() (map[string]string, error) {
 	if err := c.FetchManifests(); err != nil {
 		return nil, err
 	}
+	return c.contextData(), nil
+}
 
-	// Get context data, limited to func only
+// CachedContextData returns the current context data like FetchContextData, but takes the
+// manifests from the cache file instead of running external plugins for them
+func (c *ContextDataManager) CachedContextData() (map[string]string, error) {
+	if err := c.loadCache(); err != nil {
+		return nil, err
+	}
+	return c.contextData(), nil
+}
+
+// contextData merges the data stored for external producers with the data of the inlined producers
+func (c *ContextDataManager) contextData() map[string]string {
+	data := map[string]string{}
+	for key, value := range c.Data {
+		data[key] = value
+	}
 	for _, p := range c.PluginManager.GetInternalPlugins() {
-		if p.Name() == "kn func" {
-			if pwm, ok := p.(PluginWithManifest); ok {
-				return pwm.GetContextData(), nil
+		if pwm, ok := p.(PluginWithManifest); ok {
+			keys := c.GetProducesKeys(manifestKey(p))
+			for key, value := range filterContextData(pwm.GetContextData(), keys) {
+				data[key] = value
 			}
 		}
 	}
-	return map[string]string{}, nil
+	return data
+}
+
+// Execute runs a plugin with context sharing. A consumer receives the context data for the
+// keys declared in its manifest, and the data emitted by an external producer for its
+// declared keys is stored for the plugins and commands running afterwards.
+func (c *ContextDataManager) Execute(p Plugin, args []string) error {
+	data, err := c.FetchContextData()
+	if err != nil {
+		return err
+	}
+	manifest := c.Manifests[manifestKey(p)]
+	consumed := filterContextData(data, manifest.ConsumesContextDataKeys)
+
+	if pwm, ok := p.(PluginWithManifest); ok {
+		// Inlined producers are asked for their data when it is needed
+		return pwm.ExecuteWithContext(consumed, args)
+	}
+	if p.Path() == "" {
+		return p.Execute(args)
+	}
+
+	// The environment is inherited by the external plugin
+	if len(manifest.ConsumesContextDataKeys) > 0 {
+		b, err := json.Marshal(consumed)
+		if err != nil {
+			return err
+		}
+		os.Setenv(ContextDataEnv, string(b))
+		defer os.Unsetenv(ContextDataEnv)
+	}
+	if len(manifest.ProducesContextDataKeys) == 0 {
+		return p.Execute(args)
+	}
+	output, err := os.CreateTemp("", "kn-plugin-context-*.json")
+	if err != nil {
+		return err
+	}
+	output.Close()
+	defer os.Remove(output.Name())
+	os.Setenv(ContextOutputEnv, output.Name())
+	defer os.Unsetenv(ContextOutputEnv)
+
+	if err := p.Execute(args); err != nil {
+		return err
+	}
+	return c.storeProducedData(p, manifest, output.Name())
 }
 
-// FetchManifests it tries to retrieve manifest from both inlined and external plugins
+// storeProducedData reads the context data written by an external producer and stores the
+// values of the keys declared in its manifest. Other keys are ignored.
+func (c *ContextDataManager) storeProducedData(p Plugin, manifest Manifest, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return nil
+	}
+	produced := map[string]string{}
+	if err := json.Unmarshal(content, &produced); err != nil {
+		return fmt.Errorf("cannot parse context data produced by plugin %s: %w", p.Name(), err)
+	}
+	if c.Data == nil {
+		c.Data = map[string]string{}
+	}
+	for key, value := range filterContextData(produced, manifest.ProducesContextDataKeys) {
+		c.Data[key] = value
+	}
+	return nil
+}
+
+// FetchManifests it tries to retrieve manifest from both inlined and external plugins.
+// The manifest of an external plugin is only fetched again if the plugin binary has been
+// modified since its manifest has been cached.
 func (c *ContextDataManager) FetchManifests() error {
+	manifests := map[string]Manifest{}
 	for _, plugin := range c.PluginManager.GetInternalPlugins() {
 		manifest := &Manifest{}
-		// For the integrity build the same name format as external plugins
-		pluginName := "kn-" + strings.Join(plugin.CommandParts(), "-")
-		if pwm, ok := plugin.(PluginWithManifest); ok {
+		if pwm, ok := plugin.(PluginWithManifest); ok && pwm.GetManifest() != nil {
 			manifest = pwm.GetManifest()
 		}
-
-		// Add manifest to map
-		c.Manifests[pluginName] = *manifest
-
-		if manifest.HasManifest {
-			c.populateDataKeys(manifest, pluginName)
-		}
+		manifests[manifestKey(plugin)] = *manifest
 	}
 	plugins, err := c.PluginManager.ListPlugins()
 	if err != nil {
 		return err
 	}
 	for _, plugin := range plugins {
-		// Add new plugins only
-		if _, exists := c.Manifests[plugin.Name()]; !exists {
-
-			if plugin.Path() != "" {
-				manifest := &Manifest{
-					Path: plugin.Path(),
-				}
-				// Fetch from external plugin
-				if m := fetchExternalManifest(plugin); m != nil {
-					manifest = m
-				}
-
-				// Add manifest to map
-				c.Manifests[plugin.Name()] = *manifest
-
-				if manifest.HasManifest {
-					c.populateDataKeys(manifest, plugin.Name())
-				}
-			}
+		// Inlined plugins have been added above, and shadow external plugins
+		if _, exists := manifests[plugin.Name()]; exists || plugin.Path() == "" {
+			continue
+		}
+		info, err := os.Stat(plugin.Path())
+		if err != nil {
+			continue
+		}
+		modTime := info.ModTime().UnixNano()
+		if cached, ok := c.Manifests[plugin.Name()]; ok && cached.Path == plugin.Path() && cached.ModTime == modTime {
+			manifests[plugin.Name()] = cached
+			continue
+		}
+		manifest := &Manifest{
+			Path: plugin.Path(),
+		}
+		// Fetch from external plugin
+		if m := c.PluginManager.Manifest(plugin); m != nil {
+			manifest = m
 		}
+		manifest.ModTime = modTime
+		manifests[plugin.Name()] = *manifest
+	}
+
+	c.Manifests = manifests
+	c.Producers = map[string][]string{}
+	c.Consumers = map[string][]string{}
+	for name, manifest := range manifests {
+		if manifest.HasManifest {
+			c.populateDataKeys(&manifest, name)
+		}
+	}
+	for _, names := range c.Producers {
+		sort.Strings(names)
+	}
+	for _, names := range c.Consumers {
+		sort.Strings(names)
 	}
 	return nil
 }
@@ -175,10 +320,126 @@ func (c *ContextDataManager) populateDataKeys(manifest *Manifest, pluginName str
 	}
 }
 
+// manifestKey returns the key of a plugin's manifest, which is the name of the plugin binary.
+// For the integrity the same name format is used for inlined plugins.
+func manifestKey(p Plugin) string {
+	if p.Path() == "" {
+		return "kn-" + strings.Join(p.CommandParts(), "-")
+	}
+	return p.Name()
+}
+
+// filterContextData returns the context data for the given keys only
+func filterContextData(data map[string]string, keys []string) map[string]string {
+	ret := map[string]string{}
+	for _, key := range keys {
+		if value, ok := data[key]; ok {
+			ret[key] = value
+		}
+	}
+	return ret
+}
+
+// Manifest returns the manifest declared by a plugin or nil if the plugin doesn't declare one.
+// An external plugin is only asked for its manifest if it passes the signature check.
+func (manager *Manager) Manifest(p Plugin) *Manifest {
+	if pwm, ok := p.(PluginWithManifest); ok {
+		if manifest := pwm.GetManifest(); manifest != nil && manifest.HasManifest {
+			return manifest
+		}
+		return nil
+	}
+	if p.Path() == "" {
+		return nil
+	}
+	if _, err := manager.signatures.Check(p); err != nil {
+		return nil
+	}
+	return fetchExternalManifest(p)
+}
+
+// CachedManifest returns the manifest of a plugin without running it. The manifest of an
+// external plugin is taken from the cache file, as long as the plugin binary hasn't been
+// modified since its manifest has been cached. The second return value is false if the
+// manifest of the plugin isn't known without running it.
+func (manager *Manager) CachedManifest(p Plugin) (*Manifest, bool) {
+	if p.Path() == "" {
+		return manager.Manifest(p), true
+	}
+	if manager.cachedManifests == nil {
+		manager.cachedManifests = map[string]Manifest{}
+		if cache, err := readCacheFile(); err == nil && cache.Manifests != nil {
+			manager.cachedManifests = cache.Manifests
+		}
+	}
+	cached, ok := manager.cachedManifests[p.Name()]
+	if !ok || cached.Path != p.Path() {
+		return nil, false
+	}
+	info, err := os.Stat(p.Path())
+	if err != nil || info.ModTime().UnixNano() != cached.ModTime {
+		return nil, false
+	}
+	if _, err := manager.signatures.Check(p); err != nil || !cached.HasManifest {
+		return nil, true
+	}
+	return &cached, true
+}
+
+// RefreshManifests fetches the manifests of all plugins and stores them in the cache file,
+// where the help and the shell completion pick them up. Only the external plugins which
+// have been modified since their manifest has been cached are run.
+func (manager *Manager) RefreshManifests() (map[string]Manifest, error) {
+	c := &ContextDataManager{PluginManager: manager}
+	if err := c.loadCache(); err != nil {
+		return nil, err
+	}
+	if err := c.FetchManifests(); err != nil {
+		return nil, err
+	}
+	manager.cachedManifests = c.Manifests
+	// The cache is an optimization only, e.g. the configuration directory might not exist
+	_ = c.WriteCache()
+	return c.Manifests, nil
+}
+
+// ManifestOf returns the manifest of a plugin out of the given manifests, or nil if the
+// plugin doesn't declare one
+func ManifestOf(manifests map[string]Manifest, p Plugin) *Manifest {
+	manifest, ok := manifests[manifestKey(p)]
+	if !ok || !manifest.HasManifest {
+		return nil
+	}
+	return &manifest
+}
+
+// helpManifest returns the manifest of a plugin shown in the help and offered by the shell
+// completion. Plugins are only run for their manifest if context sharing is enabled and
+// their manifest isn't cached yet.
+func (manager *Manager) helpManifest(p Plugin) *Manifest {
+	manifest, known := manager.CachedManifest(p)
+	if known || !config.GlobalConfig.ContextSharing() {
+		return manifest
+	}
+	return manager.Manifest(p)
+}
+
+// DescriptionOf returns the description declared in the manifest of a plugin, falling back
+// to the description of the plugin itself if there is no manifest or it has no description
+func DescriptionOf(p Plugin, manifest *Manifest) string {
+	if manifest != nil && manifest.Description != "" {
+		return manifest.Description
+	}
+	desc, _ := p.Description()
+	return desc
+}
+
 // TODO: We should cautiously execute external binaries
 // fetchExternalManifest returns Manifest from external plugin by exec `$plugin manifest get`
 func fetchExternalManifest(p Plugin) *Manifest {
-	cmd := exec.Command(p.Path(), "manifest") //nolint:gosec
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, p.Path(), "manifest") //nolint:gosec
 	stdOut := new(bytes.Buffer)
 	cmd.Stdout = stdOut
 	manifest := &Manifest{
@@ -196,37 +457,59 @@ func fetchExternalManifest(p Plugin) *Manifest {
 }
 
 func (c *ContextDataManager) loadCache() error {
-	cacheFile := filepath.Join(filepath.Dir(config.GlobalConfig.ConfigFile()), "context.json")
-	if _, err := os.Stat(cacheFile); err != nil {
+	if c.cacheLoaded {
+		return nil
+	}
+	ctxManager, err := readCacheFile()
+	if err != nil {
 		if os.IsNotExist(err) {
+			c.cacheLoaded = true
 			return nil // No cache file yet
-		} else {
-			return err
 		}
-	}
-
-	file, err := os.Open(cacheFile)
-	if err != nil {
-		return err
-	}
-	decoder := json.NewDecoder(file)
-	ctxManager := &ContextDataManager{}
-	if err := decoder.Decode(ctxManager); err != nil {
 		return err
 	}
 	c.Manifests = ctxManager.Manifests
 	c.Producers = ctxManager.Producers
 	c.Consumers = ctxManager.Consumers
+	c.Data = ctxManager.Data
+	c.cacheLoaded = true
 	return nil
 }
 
-// WriteCache store data back to cache file
+// readCacheFile reads the manifests and the context data stored in the cache file
+func readCacheFile() (*ContextDataManager, error) {
+	file, err := os.Open(cacheFilePath())
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	ctxManager := &ContextDataManager{}
+	if err := json.NewDecoder(file).Decode(ctxManager); err != nil {
+		return nil, err
+	}
+	return ctxManager, nil
+}
+
+// WriteCache store data back to cache file. Nothing is written if the cache hasn't been
+// loaded, so that cached data isn't dropped by commands not using context sharing.
 func (c *ContextDataManager) WriteCache() error {
+	if !c.cacheLoaded {
+		return nil
+	}
 	out := new(bytes.Buffer)
 	enc := json.NewEncoder(out)
 	enc.SetIndent("", "    ")
 	if err := enc.Encode(c); err != nil {
-		return nil
+		return err
+	}
+	return os.WriteFile(cacheFilePath(), out.Bytes(), fs.FileMode(0664))
+}
+
+// cacheFilePath returns the path of the cache file for context sharing, or an empty
+// string if there is no configuration file
+func cacheFilePath() string {
+	if config.GlobalConfig.ConfigFile() == "" {
+		return ""
 	}
-	return os.WriteFile(filepath.Join(filepath.Dir(config.GlobalConfig.ConfigFile()), "context.json"), out.Bytes(), fs.FileMode(0664))
+	return filepath.Join(filepath.Dir(config.GlobalConfig.ConfigFile()), contextCacheFile)
 }
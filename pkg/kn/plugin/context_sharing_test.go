@@ -19,10 +19,15 @@ package plugin
 import (
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/config"
 )
 
 type testPluginWithManifest struct {
@@ -169,6 +174,184 @@ exit 1\n`,
 
 }
 
+func TestFetchManifestsCache(t *testing.T) {
+	c := setup(t)
+	t.Cleanup(func() { CtxManager = nil })
+	calls := filepath.Join(t.TempDir(), "calls")
+	path := createTestPluginInDirectoryFromScript(t, "kn-cached", c.pluginsDir, `#!/bin/bash
+echo called >> `+calls+`
+echo '{"producesKeys":["service"]}'
+`)
+
+	ctxManager, err := NewContextManager(c.pluginManager)
+	assert.NilError(t, err)
+	assert.NilError(t, ctxManager.FetchManifests())
+	assert.NilError(t, ctxManager.FetchManifests())
+	assert.DeepEqual(t, ctxManager.Producers, map[string][]string{"service": {"kn-cached"}})
+	assert.Equal(t, countLines(t, calls), 1)
+
+	// A modified plugin is asked for its manifest again
+	modTime := time.Now().Add(time.Minute)
+	assert.NilError(t, os.Chtimes(path, modTime, modTime))
+	assert.NilError(t, ctxManager.FetchManifests())
+	assert.Equal(t, countLines(t, calls), 2)
+	assert.Equal(t, ctxManager.Manifests["kn-cached"].ModTime, modTime.UnixNano())
+	assert.DeepEqual(t, ctxManager.Producers, map[string][]string{"service": {"kn-cached"}})
+
+	// Removed plugins are dropped from the cache
+	assert.NilError(t, os.Remove(path))
+	assert.NilError(t, ctxManager.FetchManifests())
+	assert.Equal(t, len(ctxManager.Manifests), 0)
+	assert.Equal(t, len(ctxManager.Producers), 0)
+}
+
+func TestContextExecute(t *testing.T) {
+	c := setup(t)
+	t.Cleanup(func() { CtxManager = nil })
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestConfigFile: filepath.Join(t.TempDir(), "config.yaml")}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	received := filepath.Join(t.TempDir(), "received")
+	createTestPluginInDirectoryFromScript(t, "kn-producer", c.pluginsDir, `#!/bin/bash
+if [ "$1" = manifest ]; then echo '{"producesKeys":["service"]}'; exit; fi
+echo '{"service":"'$1'","revision":"ignored"}' > $KN_PLUGIN_CONTEXT_OUTPUT
+`)
+	createTestPluginInDirectoryFromScript(t, "kn-consumer", c.pluginsDir, `#!/bin/bash
+if [ "$1" = manifest ]; then echo '{"consumesKeys":["service","revision"]}'; exit; fi
+echo -n "$KN_PLUGIN_CONTEXT" > `+received+`
+`)
+	createTestPluginInDirectoryFromScript(t, "kn-broken", c.pluginsDir, `#!/bin/bash
+if [ "$1" = manifest ]; then echo '{"producesKeys":["service"]}'; exit; fi
+echo 'no json' > $KN_PLUGIN_CONTEXT_OUTPUT
+`)
+
+	ctxManager, err := NewContextManager(c.pluginManager)
+	assert.NilError(t, err)
+	consumer, err := c.pluginManager.FindPlugin([]string{"consumer"})
+	assert.NilError(t, err)
+	assert.NilError(t, ctxManager.Execute(consumer, nil))
+	content, err := os.ReadFile(received)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "{}")
+
+	producer, err := c.pluginManager.FindPlugin([]string{"producer"})
+	assert.NilError(t, err)
+	assert.NilError(t, ctxManager.Execute(producer, []string{"hello"}))
+	assert.DeepEqual(t, ctxManager.Data, map[string]string{"service": "hello"})
+	assert.Equal(t, os.Getenv(ContextOutputEnv), "")
+
+	assert.NilError(t, ctxManager.Execute(consumer, nil))
+	content, err = os.ReadFile(received)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), `{"service":"hello"}`)
+	assert.Equal(t, os.Getenv(ContextDataEnv), "")
+
+	broken, err := c.pluginManager.FindPlugin([]string{"broken"})
+	assert.NilError(t, err)
+	assert.ErrorContains(t, ctxManager.Execute(broken, nil), "cannot parse context data produced by plugin kn-broken")
+
+	// The data survives in the cache
+	assert.NilError(t, ctxManager.WriteCache())
+	CtxManager = nil
+	ctxManager, err = NewContextManager(c.pluginManager)
+	assert.NilError(t, err)
+	data, err := ctxManager.FetchContextData()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, data, map[string]string{"service": "hello"})
+}
+
+func TestFetchContextDataInlinedProducer(t *testing.T) {
+	c := setup(t)
+	t.Cleanup(func() { CtxManager = nil })
+	defer prepareInternalPlugins(testPluginWithManifest{
+		testPlugin:  testPlugin{parts: []string{"func"}},
+		manifest:    &Manifest{HasManifest: true, ProducesContextDataKeys: []string{"service"}},
+		contextData: map[string]string{"service": "fn", "other": "ignored"},
+	})()
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestConfigFile: filepath.Join(t.TempDir(), "config.yaml")}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	ctxManager, err := NewContextManager(c.pluginManager)
+	assert.NilError(t, err)
+	ctxManager.Data = map[string]string{"service": "stored", "revision": "r1"}
+	data, err := ctxManager.FetchContextData()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, data, map[string]string{"service": "fn", "revision": "r1"})
+	assert.DeepEqual(t, ctxManager.Producers, map[string][]string{"service": {"kn-func"}})
+}
+
+func countLines(t *testing.T, path string) int {
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	return strings.Count(string(content), "\n")
+}
+
+func TestManagerManifest(t *testing.T) {
+	ct := setup(t)
+	defer cleanup(t, ct)
+
+	manifest := &Manifest{HasManifest: true, Description: "Manage functions", Flags: []ManifestFlag{{Name: "path"}}}
+	inlined := testPluginWithManifest{testPlugin: testPlugin{parts: []string{"func"}}, manifest: manifest}
+	assert.Equal(t, ct.pluginManager.Manifest(inlined), manifest)
+	assert.Equal(t, DescriptionOf(inlined, manifest), "Manage functions")
+
+	inlined.manifest = &Manifest{}
+	assert.Assert(t, ct.pluginManager.Manifest(inlined) == nil)
+	assert.Assert(t, ct.pluginManager.Manifest(testPlugin{parts: []string{"a"}}) == nil)
+	assert.Equal(t, DescriptionOf(inlined, nil), "desc: kn-func")
+
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestConfigFile: filepath.Join(t.TempDir(), "config.yaml")}
+	defer func() { config.GlobalConfig = oldConfig }()
+	calls := filepath.Join(t.TempDir(), "calls")
+	createTestPluginInDirectoryFromScript(t, "kn-described", ct.pluginsDir, `#!/bin/bash
+echo called >> `+calls+`
+echo '{"description":"Described plugin","flags":[{"name":"verbose","shorthand":"v"}]}'
+`)
+	described, err := ct.pluginManager.FindPlugin([]string{"described"})
+	assert.NilError(t, err)
+	manifest = ct.pluginManager.Manifest(described)
+	assert.Assert(t, manifest != nil)
+	assert.Equal(t, DescriptionOf(described, manifest), "Described plugin")
+	assert.DeepEqual(t, manifest.Flags, []ManifestFlag{{Name: "verbose", Shorthand: "v"}})
+
+	assert.Equal(t, countLines(t, calls), 1)
+
+	help := ct.pluginManager.listPluginsHelpMessage()(&cobra.Command{Use: "kn", Run: func(*cobra.Command, []string) {}})
+	assert.Equal(t, help, "")
+	root := &cobra.Command{Use: "kn"}
+	root.AddCommand(&cobra.Command{Use: "service"})
+
+	// The help doesn't run plugins for their manifests
+	help = ct.pluginManager.listPluginsHelpMessage()(root)
+	assert.Assert(t, !strings.Contains(help, "Described plugin"), help)
+	assert.Equal(t, countLines(t, calls), 1)
+
+	// but shows the cached manifests
+	manifests, err := ct.pluginManager.RefreshManifests()
+	assert.NilError(t, err)
+	assert.Equal(t, ManifestOf(manifests, described).Description, "Described plugin")
+	assert.Equal(t, countLines(t, calls), 2)
+	manager := NewManager(ct.pluginsDir, false)
+	help = manager.listPluginsHelpMessage()(root)
+	assert.Assert(t, regexp.MustCompile(`(?m)^\s*described\s+Described plugin\n\s+\[-v\|--verbose\]$`).MatchString(help), help)
+	assert.Equal(t, countLines(t, calls), 2)
+
+	// as long as the plugin hasn't been modified
+	modTime := time.Now().Add(time.Minute)
+	assert.NilError(t, os.Chtimes(described.Path(), modTime, modTime))
+	cached, known := manager.CachedManifest(described)
+	assert.Assert(t, cached == nil && !known)
+
+	// Plugins are run for unknown manifests if context sharing is enabled
+	config.GlobalConfig.(*config.TestConfig).TestContextSharing = true
+	help = manager.listPluginsHelpMessage()(root)
+	assert.Assert(t, strings.Contains(help, "Described plugin"), help)
+	assert.Equal(t, countLines(t, calls), 3)
+}
+
 // CreateTestPluginInPath with name, path, script, and fileMode and return the tmp random path
 func createTestPluginInDirectoryFromScript(t *testing.T, name string, dir string, script string) string {
 	fullPath := filepath.Join(dir, name)
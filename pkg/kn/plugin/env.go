@@ -0,0 +1,85 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"os"
+	"strconv"
+)
+
+// Environment variables exported to external plugins. They describe the state kn resolved
+// from its global flags, its configuration and kubeconfig, so that plugins don't need to
+// resolve it on their own.
+const (
+	// EnvNamespace is the namespace given with --namespace, or the default namespace of
+	// the kn configuration or kubeconfig for the current context
+	EnvNamespace = "KN_NAMESPACE"
+	// EnvKubeconfig is the kubeconfig file given with --kubeconfig or $KUBECONFIG
+	EnvKubeconfig = "KN_KUBECONFIG"
+	// EnvContext is the kubeconfig context in use
+	EnvContext = "KN_CONTEXT"
+	// EnvCluster is the kubeconfig cluster in use
+	EnvCluster = "KN_CLUSTER"
+	// EnvAs is the user to impersonate given with --as
+	EnvAs = "KN_AS"
+	// EnvConfigFile is the kn configuration file
+	EnvConfigFile = "KN_CONFIG_FILE"
+	// EnvOutput is the output format given with --output
+	EnvOutput = "KN_OUTPUT"
+	// EnvLogHTTP is "true" if HTTP traffic should be logged
+	EnvLogHTTP = "KN_LOG_HTTP"
+	// EnvVersion is the version of kn
+	EnvVersion = "KN_VERSION"
+)
+
+// Environment is the state of kn exported to external plugins
+type Environment struct {
+	Namespace  string
+	Kubeconfig string
+	Context    string
+	Cluster    string
+	As         string
+	ConfigFile string
+	Output     string
+	LogHTTP    bool
+	Version    string
+}
+
+// Vars returns the environment variables for the state, values which couldn't be resolved
+// are empty
+func (env Environment) Vars() map[string]string {
+	return map[string]string{
+		EnvNamespace:  env.Namespace,
+		EnvKubeconfig: env.Kubeconfig,
+		EnvContext:    env.Context,
+		EnvCluster:    env.Cluster,
+		EnvAs:         env.As,
+		EnvConfigFile: env.ConfigFile,
+		EnvOutput:     env.Output,
+		EnvLogHTTP:    strconv.FormatBool(env.LogHTTP),
+		EnvVersion:    env.Version,
+	}
+}
+
+// Export sets the environment variables for the state, so that they are inherited by the
+// plugins executed afterwards. Variables of an outer kn are replaced.
+func (env Environment) Export() error {
+	for name, value := range env.Vars() {
+		if err := os.Setenv(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
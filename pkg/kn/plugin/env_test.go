@@ -0,0 +1,50 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"os"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestEnvironmentExport(t *testing.T) {
+	env := Environment{
+		Namespace:  "team",
+		Kubeconfig: "/home/user/.kube/config",
+		Context:    "prod",
+		Cluster:    "cluster-1",
+		ConfigFile: "/home/user/.config/kn/config.yaml",
+		Output:     "json",
+		LogHTTP:    true,
+		Version:    "v1.2.3",
+	}
+	for name := range env.Vars() {
+		// Restore the environment after the test
+		t.Setenv(name, "outer")
+	}
+
+	assert.NilError(t, env.Export())
+	assert.Equal(t, os.Getenv(EnvNamespace), "team")
+	assert.Equal(t, os.Getenv(EnvKubeconfig), "/home/user/.kube/config")
+	assert.Equal(t, os.Getenv(EnvContext), "prod")
+	assert.Equal(t, os.Getenv(EnvCluster), "cluster-1")
+	assert.Equal(t, os.Getenv(EnvAs), "")
+	assert.Equal(t, os.Getenv(EnvConfigFile), "/home/user/.config/kn/config.yaml")
+	assert.Equal(t, os.Getenv(EnvOutput), "json")
+	assert.Equal(t, os.Getenv(EnvLogHTTP), "true")
+	assert.Equal(t, os.Getenv(EnvVersion), "v1.2.3")
+}
@@ -0,0 +1,254 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+	"sigs.k8s.io/yaml"
+)
+
+// fetchTimeout limits the time kn waits for downloading an index, a plugin or a signature
+var fetchTimeout = 2 * time.Minute
+
+// Index lists plugins with their versions and the artifacts to install for each
+// platform. It is read from a file or a URL by 'kn plugin install --index'.
+type Index struct {
+	// Plugins are the plugins available in this index
+	Plugins []IndexPlugin `json:"plugins"`
+
+	// location of the index, used to resolve relative artifact URIs
+	location string
+}
+
+// IndexPlugin is a plugin of an index
+type IndexPlugin struct {
+	// Name of the plugin, like "kn-admin"
+	Name string `json:"name"`
+
+	// Description of the plugin
+	Description string `json:"description,omitempty"`
+
+	// Versions of the plugin
+	Versions []IndexVersion `json:"versions"`
+}
+
+// IndexVersion is a version of a plugin
+type IndexVersion struct {
+	// Version is a semantic version, like "v1.2.0"
+	Version string `json:"version"`
+
+	// Platforms are the artifacts of this version for each platform
+	Platforms []IndexPlatform `json:"platforms"`
+}
+
+// IndexPlatform is the artifact of a plugin version for a platform
+type IndexPlatform struct {
+	// OS is the operating system, like "linux"
+	OS string `json:"os"`
+
+	// Arch is the architecture, like "amd64"
+	Arch string `json:"arch"`
+
+	// URI of the artifact, which is either a gzip compressed tar archive or the plugin
+	// binary itself. A relative URI is resolved against the location of the index.
+	URI string `json:"uri"`
+
+	// SHA256 is the hex encoded SHA256 checksum of the artifact
+	SHA256 string `json:"sha256"`
+
+	// Bin is the name of the plugin binary within an archive, all binaries starting
+	// with "kn-" are installed if not given
+	Bin string `json:"bin,omitempty"`
+
+	// Signature is the URI of the detached signature of the plugin binary, which is installed
+	// next to it. A relative URI is resolved against the location of the index. Not needed if
+	// the archive contains the signature file next to the binary.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Release is a version of a plugin for a specific platform found in an index
+type Release struct {
+	// Name of the plugin
+	Name string
+
+	// Version of the plugin
+	Version string
+
+	// Platform with the artifact to install
+	Platform IndexPlatform
+
+	// URI of the artifact, resolved against the location of the index
+	URI string
+
+	// SignatureURI is the URI of the binary's signature, resolved against the location of
+	// the index, or empty if there is none
+	SignatureURI string
+}
+
+// LoadIndex reads a plugin index from a file or from a file://, http:// or https:// URL
+func LoadIndex(location string) (*Index, error) {
+	data, err := fetch(location)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read plugin index %s: %w", location, err)
+	}
+	index := &Index{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("cannot read plugin index %s: %w", location, err)
+	}
+	index.location = location
+	return index, nil
+}
+
+// Location returns the file or URL the index has been read from
+func (index *Index) Location() string {
+	return index.location
+}
+
+// Find returns the release of the plugin with the given name and version for a platform.
+// The latest version is returned if no version is given.
+func (index *Index) Find(name, version, goos, goarch string) (*Release, error) {
+	name = PluginName(name)
+	var plugin *IndexPlugin
+	for i := range index.Plugins {
+		if PluginName(index.Plugins[i].Name) == name {
+			plugin = &index.Plugins[i]
+			break
+		}
+	}
+	if plugin == nil {
+		return nil, fmt.Errorf("no plugin %s found in plugin index %s", name, index.location)
+	}
+
+	var found *IndexVersion
+	for i := range plugin.Versions {
+		v := &plugin.Versions[i]
+		if !semver.IsValid(canonicalVersion(v.Version)) {
+			return nil, fmt.Errorf("invalid version '%s' of plugin %s in plugin index %s", v.Version, name, index.location)
+		}
+		if version != "" {
+			if CompareVersions(v.Version, version) == 0 {
+				found = v
+				break
+			}
+			continue
+		}
+		if found == nil || CompareVersions(v.Version, found.Version) > 0 {
+			found = v
+		}
+	}
+	if found == nil {
+		if version != "" {
+			return nil, fmt.Errorf("no version %s of plugin %s found in plugin index %s", version, name, index.location)
+		}
+		return nil, fmt.Errorf("no versions of plugin %s found in plugin index %s", name, index.location)
+	}
+
+	for _, platform := range found.Platforms {
+		if platform.OS == goos && platform.Arch == goarch {
+			uri, err := resolveURI(index.location, platform.URI)
+			if err != nil {
+				return nil, err
+			}
+			release := &Release{Name: name, Version: found.Version, Platform: platform, URI: uri}
+			if platform.Signature != "" {
+				if release.SignatureURI, err = resolveURI(index.location, platform.Signature); err != nil {
+					return nil, err
+				}
+			}
+			return release, nil
+		}
+	}
+	return nil, fmt.Errorf("plugin %s %s is not available for %s/%s in plugin index %s", name, found.Version, goos, goarch, index.location)
+}
+
+// PluginName returns the name of a plugin with the "kn-" prefix, so that plugins
+// can be given with and without the prefix
+func PluginName(name string) string {
+	if strings.HasPrefix(name, "kn-") {
+		return name
+	}
+	return "kn-" + name
+}
+
+// CompareVersions compares two semantic versions, with or without a leading "v".
+// The result is 0 if a == b, -1 if a < b, or +1 if a > b.
+func CompareVersions(a, b string) int {
+	return semver.Compare(canonicalVersion(a), canonicalVersion(b))
+}
+
+// canonicalVersion adds the "v" prefix expected by the semver package
+func canonicalVersion(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+// fetch reads the content of a file or a file://, http:// or https:// URL
+func fetch(location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" || len(u.Scheme) == 1 {
+		// A path, where a single letter scheme is a windows drive letter
+		return os.ReadFile(location)
+	}
+	switch u.Scheme {
+	case "file":
+		return os.ReadFile(filepath.FromSlash(u.Path))
+	case "http", "https":
+		client := &http.Client{Timeout: fetchTimeout}
+		resp, err := client.Get(location) //nolint:gosec // Fetching a URL given by the user is expected
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status '%s'", resp.Status)
+		}
+		return io.ReadAll(resp.Body)
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme '%s'", u.Scheme)
+	}
+}
+
+// resolveURI resolves a URI relative to the location of an index
+func resolveURI(base string, uri string) (string, error) {
+	ref, err := url.Parse(uri)
+	if (err == nil && len(ref.Scheme) > 1) || filepath.IsAbs(uri) {
+		return uri, nil
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil || baseURL.Scheme == "" || len(baseURL.Scheme) == 1 {
+		return filepath.Join(filepath.Dir(base), filepath.FromSlash(uri)), nil
+	}
+	if baseURL.Scheme == "file" {
+		baseURL.Path = path.Join(path.Dir(baseURL.Path), uri)
+		return baseURL.String(), nil
+	}
+	resolved, err := baseURL.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid URI '%s' in plugin index %s: %w", uri, base, err)
+	}
+	return resolved.String(), nil
+}
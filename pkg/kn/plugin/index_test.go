@@ -0,0 +1,154 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+const testIndex = `
+plugins:
+- name: kn-admin
+  description: Administrate Knative
+  versions:
+  - version: v1.1.0
+    platforms:
+    - os: linux
+      arch: amd64
+      uri: v1.1.0/kn-admin-linux-amd64.tar.gz
+      sha256: "1111"
+  - version: 1.10.0
+    platforms:
+    - os: linux
+      arch: amd64
+      uri: https://downloads.example.com/kn-admin-linux-amd64.tar.gz
+      sha256: "2222"
+      bin: bin/kn-admin
+  - version: v1.2.0
+    platforms:
+    - os: darwin
+      arch: arm64
+      uri: kn-admin-darwin-arm64
+      sha256: "3333"
+- name: broken
+  versions:
+  - version: latest
+`
+
+func TestIndexFind(t *testing.T) {
+	dir := t.TempDir()
+	indexFile := filepath.Join(dir, "index.yaml")
+	assert.NilError(t, os.WriteFile(indexFile, []byte(testIndex), 0644))
+	index, err := LoadIndex(indexFile)
+	assert.NilError(t, err)
+	assert.Equal(t, index.Location(), indexFile)
+
+	release, err := index.Find("admin", "", "linux", "amd64")
+	assert.NilError(t, err)
+	assert.Equal(t, release.Name, "kn-admin")
+	assert.Equal(t, release.Version, "1.10.0")
+	assert.Equal(t, release.URI, "https://downloads.example.com/kn-admin-linux-amd64.tar.gz")
+	assert.Equal(t, release.Platform.Bin, "bin/kn-admin")
+
+	release, err = index.Find("kn-admin", "1.1.0", "linux", "amd64")
+	assert.NilError(t, err)
+	assert.Equal(t, release.Version, "v1.1.0")
+	assert.Equal(t, release.URI, filepath.Join(dir, "v1.1.0", "kn-admin-linux-amd64.tar.gz"))
+
+	release, err = index.Find("admin", "v1.2.0", "darwin", "arm64")
+	assert.NilError(t, err)
+	assert.Equal(t, release.URI, filepath.Join(dir, "kn-admin-darwin-arm64"))
+
+	for _, tc := range []struct {
+		name, version, goos, goarch string
+		expectedError               string
+	}{
+		{"unknown", "", "linux", "amd64", "no plugin kn-unknown found in plugin index"},
+		{"admin", "v2.0.0", "linux", "amd64", "no version v2.0.0 of plugin kn-admin found"},
+		{"admin", "", "windows", "amd64", "plugin kn-admin 1.10.0 is not available for windows/amd64"},
+		{"broken", "", "linux", "amd64", "invalid version 'latest' of plugin kn-broken"},
+	} {
+		_, err := index.Find(tc.name, tc.version, tc.goos, tc.goarch)
+		assert.ErrorContains(t, err, tc.expectedError)
+	}
+}
+
+func TestLoadIndexTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+	oldTimeout := fetchTimeout
+	fetchTimeout = 10 * time.Millisecond
+	defer func() { fetchTimeout = oldTimeout }()
+
+	_, err := LoadIndex(server.URL + "/plugins/index.yaml")
+	assert.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestLoadIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/plugins/index.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(testIndex))
+	}))
+	defer server.Close()
+
+	index, err := LoadIndex(server.URL + "/plugins/index.yaml")
+	assert.NilError(t, err)
+	release, err := index.Find("admin", "v1.1.0", "linux", "amd64")
+	assert.NilError(t, err)
+	assert.Equal(t, release.URI, server.URL+"/plugins/v1.1.0/kn-admin-linux-amd64.tar.gz")
+
+	_, err = LoadIndex(server.URL + "/unknown.yaml")
+	assert.ErrorContains(t, err, "404 Not Found")
+
+	indexFile := filepath.Join(t.TempDir(), "index.yaml")
+	assert.NilError(t, os.WriteFile(indexFile, []byte(testIndex), 0644))
+	index, err = LoadIndex("file://" + filepath.ToSlash(indexFile))
+	assert.NilError(t, err)
+	release, err = index.Find("admin", "v1.1.0", "linux", "amd64")
+	assert.NilError(t, err)
+	assert.Equal(t, release.URI, "file://"+filepath.ToSlash(filepath.Join(filepath.Dir(indexFile), "v1.1.0", "kn-admin-linux-amd64.tar.gz")))
+
+	assert.NilError(t, os.WriteFile(indexFile, []byte("plugins: {"), 0644))
+	_, err = LoadIndex(indexFile)
+	assert.ErrorContains(t, err, "cannot read plugin index "+indexFile)
+
+	_, err = LoadIndex("ftp://example.com/index.yaml")
+	assert.ErrorContains(t, err, "unsupported URL scheme 'ftp'")
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, CompareVersions("v1.2.0", "1.2.0"), 0)
+	assert.Equal(t, CompareVersions("1.10.0", "v1.9.1"), 1)
+	assert.Equal(t, CompareVersions("v1.0.0-rc1", "v1.0.0"), -1)
+}
+
+func TestPluginName(t *testing.T) {
+	assert.Equal(t, PluginName("admin"), "kn-admin")
+	assert.Equal(t, PluginName("kn-admin"), "kn-admin")
+}
@@ -0,0 +1,498 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"sigs.k8s.io/yaml"
+)
+
+// installedPluginsFile records the plugins installed with 'kn plugin install' in the plugins directory
+const installedPluginsFile = "installed.yaml"
+
+// InstalledPlugin is a plugin which has been installed with 'kn plugin install'
+type InstalledPlugin struct {
+	// Name of the plugin, like "kn-admin"
+	Name string `json:"name"`
+
+	// Version of the plugin, if known
+	Version string `json:"version,omitempty"`
+
+	// Source is the path or URI of the archive, directory or binary the plugin has been installed from
+	Source string `json:"source"`
+
+	// Index is the plugin index the plugin has been installed from, which is used for upgrades
+	Index string `json:"index,omitempty"`
+
+	// SHA256 is the checksum of the archive or binary the plugin has been installed from
+	SHA256 string `json:"sha256,omitempty"`
+
+	// Files are the names of the binaries and their signatures installed in the plugins directory
+	Files []string `json:"files"`
+
+	// Warnings about binaries without a valid signature, if the signature policy is 'warn'
+	Warnings []string `json:"-"`
+}
+
+// installedPlugins is the content of the installedPluginsFile
+type installedPlugins struct {
+	Plugins []InstalledPlugin `json:"plugins"`
+}
+
+// InstallOptions are the options for installing a plugin
+type InstallOptions struct {
+	// Version of the plugin to install from an index, or the version to record for a local source
+	Version string
+
+	// SHA256 is the expected checksum of a local archive or binary
+	SHA256 string
+
+	// Force replaces a plugin which is already installed
+	Force bool
+}
+
+// InstalledPlugins returns the plugins which have been installed with 'kn plugin install'
+func (manager *Manager) InstalledPlugins() ([]InstalledPlugin, error) {
+	dir, err := homedir.Expand(manager.pluginsDir)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, installedPluginsFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	installed := installedPlugins{}
+	if err := yaml.Unmarshal(data, &installed); err != nil {
+		return nil, fmt.Errorf("cannot read installed plugins from %s: %w", filepath.Join(dir, installedPluginsFile), err)
+	}
+	return installed.Plugins, nil
+}
+
+// InstallFromPath installs the plugins contained in a gzip compressed tar archive or in a
+// directory, or a single plugin binary
+func (manager *Manager) InstallFromPath(source string, options InstallOptions) (*InstalledPlugin, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("cannot install plugin from %s: %w", source, err)
+	}
+	absSource, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	record := InstalledPlugin{Version: options.Version, Source: absSource}
+	var files map[string][]byte
+	if info.IsDir() {
+		if options.SHA256 != "" {
+			return nil, fmt.Errorf("cannot verify the checksum of directory %s", source)
+		}
+		files, err = readPluginDirectory(source)
+	} else {
+		var data []byte
+		data, err = os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		record.SHA256, err = verifyChecksum(source, data, options.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		files, err = extractPlugins(source, data, "", "")
+		if err == nil && len(files) == 1 {
+			err = addSignatureFile(files, source+SignatureExtension)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return manager.install(record, files, options.Force)
+}
+
+// InstallFromIndex installs a plugin from a plugin index, using the latest version of the
+// plugin if no version is given
+func (manager *Manager) InstallFromIndex(indexLocation string, name string, options InstallOptions) (*InstalledPlugin, error) {
+	index, err := LoadIndex(indexLocation)
+	if err != nil {
+		return nil, err
+	}
+	release, err := index.Find(name, options.Version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return nil, err
+	}
+	return manager.installRelease(index, release, options.Force)
+}
+
+// Upgrade installs the latest version of an installed plugin from the given index, or from
+// the index the plugin has been installed from. It returns the previously installed plugin
+// and the upgraded plugin, which is nil if the plugin is already up to date.
+func (manager *Manager) Upgrade(name string, indexLocation string) (*InstalledPlugin, *InstalledPlugin, error) {
+	previous, err := manager.installedPlugin(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if indexLocation == "" {
+		indexLocation = previous.Index
+	}
+	if indexLocation == "" {
+		return nil, nil, fmt.Errorf("plugin %s has not been installed from a plugin index, use --index to upgrade it from an index", previous.Name)
+	}
+	index, err := LoadIndex(indexLocation)
+	if err != nil {
+		return nil, nil, err
+	}
+	release, err := index.Find(previous.Name, "", runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return nil, nil, err
+	}
+	if previous.Version != "" && CompareVersions(release.Version, previous.Version) <= 0 {
+		return previous, nil, nil
+	}
+	upgraded, err := manager.installRelease(index, release, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return previous, upgraded, nil
+}
+
+// Uninstall removes the binaries of a plugin installed with 'kn plugin install'
+func (manager *Manager) Uninstall(name string) (*InstalledPlugin, error) {
+	installed, err := manager.installedPlugin(name)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := homedir.Expand(manager.pluginsDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range installed.Files {
+		if err := os.Remove(filepath.Join(dir, file)); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("cannot uninstall plugin %s: %w", installed.Name, err)
+		}
+	}
+	plugins, err := manager.InstalledPlugins()
+	if err != nil {
+		return nil, err
+	}
+	remaining := make([]InstalledPlugin, 0, len(plugins))
+	for _, p := range plugins {
+		if p.Name != installed.Name {
+			remaining = append(remaining, p)
+		}
+	}
+	return installed, manager.writeInstalledPlugins(remaining)
+}
+
+// installRelease downloads, verifies and installs a release found in an index
+func (manager *Manager) installRelease(index *Index, release *Release, force bool) (*InstalledPlugin, error) {
+	if release.Platform.SHA256 == "" {
+		return nil, fmt.Errorf("no SHA256 checksum given for plugin %s %s in plugin index %s", release.Name, release.Version, index.Location())
+	}
+	data, err := fetch(release.URI)
+	if err != nil {
+		return nil, fmt.Errorf("cannot download plugin %s %s from %s: %w", release.Name, release.Version, release.URI, err)
+	}
+	checksum, err := verifyChecksum(release.URI, data, release.Platform.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	files, err := extractPlugins(release.URI, data, release.Platform.Bin, release.Name)
+	if err != nil {
+		return nil, err
+	}
+	if release.SignatureURI != "" {
+		binary, err := signedBinary(files, release.Platform.Bin)
+		if err != nil {
+			return nil, fmt.Errorf("cannot install signature of plugin %s %s: %w", release.Name, release.Version, err)
+		}
+		signature, err := fetch(release.SignatureURI)
+		if err != nil {
+			return nil, fmt.Errorf("cannot download signature of plugin %s %s from %s: %w", release.Name, release.Version, release.SignatureURI, err)
+		}
+		files[binary+SignatureExtension] = signature
+	}
+	record := InstalledPlugin{
+		Name:    release.Name,
+		Version: release.Version,
+		Source:  release.URI,
+		Index:   index.Location(),
+		SHA256:  checksum,
+	}
+	return manager.install(record, files, force)
+}
+
+// install writes the plugin binaries to the plugins directory and records the installed plugin
+func (manager *Manager) install(record InstalledPlugin, files map[string][]byte, force bool) (*InstalledPlugin, error) {
+	dir, err := homedir.Expand(manager.pluginsDir)
+	if err != nil {
+		return nil, err
+	}
+	plugins, err := manager.InstalledPlugins()
+	if err != nil {
+		return nil, err
+	}
+
+	record.Files = make([]string, 0, len(files))
+	for name := range files {
+		record.Files = append(record.Files, name)
+	}
+	sort.Strings(record.Files)
+	if record.Name == "" {
+		record.Name = stripWindowsExecExtensions(record.Files[0])
+	}
+	record.Warnings, err = manager.signatures.checkInstall(record.Files, files)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous *InstalledPlugin
+	for i := range plugins {
+		if plugins[i].Name == record.Name {
+			previous = &plugins[i]
+		}
+	}
+	if previous != nil && !force {
+		return nil, fmt.Errorf("plugin %s is already installed, use --force to replace it", record.Name)
+	}
+	if !force {
+		for _, file := range record.Files {
+			if _, err := os.Stat(filepath.Join(dir, file)); err == nil {
+				return nil, fmt.Errorf("plugin binary %s already exists in %s, use --force to replace it", file, dir)
+			}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	for _, file := range record.Files {
+		if err := writeBinary(dir, file, files[file]); err != nil {
+			return nil, fmt.Errorf("cannot install plugin %s: %w", record.Name, err)
+		}
+	}
+
+	updated := make([]InstalledPlugin, 0, len(plugins)+1)
+	for _, p := range plugins {
+		if p.Name != record.Name {
+			updated = append(updated, p)
+			continue
+		}
+		// Remove binaries of the previous installation which are not part of the new one
+		for _, file := range p.Files {
+			if _, ok := files[file]; !ok {
+				if err := os.Remove(filepath.Join(dir, file)); err != nil && !os.IsNotExist(err) {
+					return nil, err
+				}
+			}
+		}
+	}
+	updated = append(updated, record)
+	sort.Slice(updated, func(i, j int) bool { return updated[i].Name < updated[j].Name })
+	return &record, manager.writeInstalledPlugins(updated)
+}
+
+// installedPlugin returns the installed plugin with the given name
+func (manager *Manager) installedPlugin(name string) (*InstalledPlugin, error) {
+	plugins, err := manager.InstalledPlugins()
+	if err != nil {
+		return nil, err
+	}
+	name = PluginName(name)
+	for i := range plugins {
+		if plugins[i].Name == name {
+			return &plugins[i], nil
+		}
+	}
+	return nil, fmt.Errorf("plugin %s has not been installed with 'kn plugin install'", name)
+}
+
+// writeInstalledPlugins records the installed plugins in the plugins directory
+func (manager *Manager) writeInstalledPlugins(plugins []InstalledPlugin) error {
+	dir, err := homedir.Expand(manager.pluginsDir)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(installedPlugins{Plugins: plugins})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, installedPluginsFile), data, 0644)
+}
+
+// verifyChecksum returns the SHA256 checksum of the data and checks it against the
+// expected checksum, if given
+func verifyChecksum(source string, data []byte, expected string) (string, error) {
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	if expected != "" && !strings.EqualFold(expected, checksum) {
+		return "", fmt.Errorf("checksum mismatch for %s: expected SHA256 %s, but got %s", source, expected, checksum)
+	}
+	return checksum, nil
+}
+
+// extractPlugins returns the plugin binaries of an artifact by their file name. An artifact
+// is either a gzip compressed tar archive or a binary, which is named after the given name
+// if the file name of the artifact doesn't start with "kn-".
+func extractPlugins(source string, data []byte, bin string, name string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		base := path.Base(filepath.ToSlash(source))
+		if !strings.HasPrefix(base, "kn-") {
+			base = name
+		}
+		if base == "" {
+			return nil, fmt.Errorf("no plugin binary found in %s, the names of plugin binaries must start with 'kn-'", source)
+		}
+		files[base] = data
+		return files, nil
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read plugin archive %s: %w", source, err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read plugin archive %s: %w", source, err)
+		}
+		// Only the base name is used, so that no file can be written outside of the plugins directory
+		base := path.Base(header.Name)
+		if header.Typeflag != tar.TypeReg || !isPluginBinary(base, bin) {
+			continue
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read plugin archive %s: %w", source, err)
+		}
+		files[base] = content
+	}
+	if len(files) == 0 {
+		if bin != "" {
+			return nil, fmt.Errorf("no plugin binary %s found in plugin archive %s", bin, source)
+		}
+		return nil, fmt.Errorf("no plugin binary found in plugin archive %s, the names of plugin binaries must start with 'kn-'", source)
+	}
+	return files, nil
+}
+
+// readPluginDirectory returns the plugin binaries of a directory by their file name
+func readPluginDirectory(dir string) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !isPluginBinary(entry.Name(), "") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = content
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no plugin binary found in directory %s, the names of plugin binaries must start with 'kn-'", dir)
+	}
+	return files, nil
+}
+
+// isPluginBinary checks whether a file is the given binary or its signature, or a plugin
+// binary or signature if no binary is given
+func isPluginBinary(file string, bin string) bool {
+	if bin != "" {
+		return file == path.Base(bin) || file == path.Base(bin)+SignatureExtension
+	}
+	return strings.HasPrefix(file, "kn-")
+}
+
+// signedBinary returns the name of the binary a signature given separately from the
+// artifact belongs to, which is the given binary or the only binary of the artifact
+func signedBinary(files map[string][]byte, bin string) (string, error) {
+	if bin != "" {
+		return path.Base(bin), nil
+	}
+	var binaries []string
+	for name := range files {
+		if !isSignatureFile(name) {
+			binaries = append(binaries, name)
+		}
+	}
+	if len(binaries) != 1 {
+		return "", fmt.Errorf("the artifact contains %d plugin binaries, use 'bin' to specify the signed binary", len(binaries))
+	}
+	return binaries[0], nil
+}
+
+// addSignatureFile adds the signature file next to a plugin binary, if it exists, to the
+// files of the single binary
+func addSignatureFile(files map[string][]byte, signatureFile string) error {
+	binary, err := signedBinary(files, "")
+	if err != nil {
+		return err
+	}
+	signature, err := os.ReadFile(signatureFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	files[binary+SignatureExtension] = signature
+	return nil
+}
+
+// writeBinary writes an executable file to a directory, replacing an existing file atomically
+func writeBinary(dir string, name string, content []byte) error {
+	tmpFile, err := os.CreateTemp(dir, "."+name+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(content); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), 0755); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), filepath.Join(dir, name))
+}
@@ -0,0 +1,351 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/config"
+)
+
+func TestInstallFromPathArchive(t *testing.T) {
+	manager := NewManager(filepath.Join(t.TempDir(), "plugins"), false)
+	archive := filepath.Join(t.TempDir(), "kn-admin.tar.gz")
+	data := createTestArchive(t, map[string]string{
+		"kn-admin":            "admin",
+		"bin/kn-admin-users":  "users",
+		"README.md":           "readme",
+		"../../kn-evil-cheat": "evil",
+	})
+	assert.NilError(t, os.WriteFile(archive, data, 0644))
+
+	installed, err := manager.InstallFromPath(archive, InstallOptions{Version: "v1.0.0", SHA256: checksum(data)})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, *installed, InstalledPlugin{
+		Name:    "kn-admin",
+		Version: "v1.0.0",
+		Source:  archive,
+		SHA256:  checksum(data),
+		Files:   []string{"kn-admin", "kn-admin-users", "kn-evil-cheat"},
+	})
+	assertBinary(t, manager, "kn-admin", "admin")
+	assertBinary(t, manager, "kn-admin-users", "users")
+	assertBinary(t, manager, "kn-evil-cheat", "evil")
+	_, err = os.Stat(filepath.Join(manager.PluginsDir(), "README.md"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	plugins, err := manager.ListPlugins()
+	assert.NilError(t, err)
+	assert.Equal(t, len(plugins), 3)
+
+	recorded, err := manager.InstalledPlugins()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, recorded, []InstalledPlugin{*installed})
+
+	_, err = manager.InstallFromPath(archive, InstallOptions{})
+	assert.ErrorContains(t, err, "plugin kn-admin is already installed, use --force to replace it")
+
+	_, err = manager.InstallFromPath(archive, InstallOptions{SHA256: "abcd", Force: true})
+	assert.ErrorContains(t, err, "checksum mismatch for "+archive+": expected SHA256 abcd, but got "+checksum(data))
+
+	installed, err = manager.InstallFromPath(archive, InstallOptions{Force: true})
+	assert.NilError(t, err)
+	assert.Equal(t, installed.Version, "")
+}
+
+func TestInstallFromPathDirectoryAndBinary(t *testing.T) {
+	manager := NewManager(filepath.Join(t.TempDir(), "plugins"), false)
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "kn-foo"), []byte("foo"), 0755))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes"), 0644))
+	assert.NilError(t, os.Mkdir(filepath.Join(dir, "kn-dir"), 0755))
+
+	_, err := manager.InstallFromPath(dir, InstallOptions{SHA256: "abcd"})
+	assert.ErrorContains(t, err, "cannot verify the checksum of directory")
+
+	installed, err := manager.InstallFromPath(dir, InstallOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, installed.Name, "kn-foo")
+	assert.DeepEqual(t, installed.Files, []string{"kn-foo"})
+	assertBinary(t, manager, "kn-foo", "foo")
+
+	binary := filepath.Join(t.TempDir(), "kn-bar")
+	assert.NilError(t, os.WriteFile(binary, []byte("bar"), 0644))
+	installed, err = manager.InstallFromPath(binary, InstallOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, installed.Name, "kn-bar")
+	assertBinary(t, manager, "kn-bar", "bar")
+
+	// A signature file next to the binary is installed with it
+	assert.NilError(t, os.WriteFile(binary+SignatureExtension, []byte("signature"), 0644))
+	installed, err = manager.InstallFromPath(binary, InstallOptions{Force: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, installed.Files, []string{"kn-bar", "kn-bar.sig"})
+
+	// A binary not managed by kn is not replaced without --force
+	assert.NilError(t, os.WriteFile(filepath.Join(manager.PluginsDir(), "kn-baz"), []byte("old"), 0755))
+	binary = filepath.Join(t.TempDir(), "kn-baz")
+	assert.NilError(t, os.WriteFile(binary, []byte("baz"), 0644))
+	_, err = manager.InstallFromPath(binary, InstallOptions{})
+	assert.ErrorContains(t, err, "plugin binary kn-baz already exists in "+manager.PluginsDir())
+
+	for _, source := range []string{t.TempDir(), filepath.Join(dir, "notes.txt")} {
+		_, err = manager.InstallFromPath(source, InstallOptions{})
+		assert.ErrorContains(t, err, "no plugin binary found in")
+	}
+	_, err = manager.InstallFromPath(filepath.Join(dir, "unknown"), InstallOptions{})
+	assert.ErrorContains(t, err, "cannot install plugin from")
+}
+
+func TestInstallFromIndexUpgradeAndUninstall(t *testing.T) {
+	v1 := createTestArchive(t, map[string]string{"kn-admin": "v1", "kn-admin-old": "old"})
+	v2 := createTestArchive(t, map[string]string{"kn-admin": "v2", "kn-admin-users": "users"})
+	index := fmt.Sprintf(`
+plugins:
+- name: kn-admin
+  versions:
+  - version: v1.0.0
+    platforms:
+    - os: %[1]s
+      arch: %[2]s
+      uri: v1.tar.gz
+      sha256: %[3]s
+`, runtime.GOOS, runtime.GOARCH, checksum(v1))
+	v2Index := index + fmt.Sprintf(`
+  - version: v2.0.0
+    platforms:
+    - os: %[1]s
+      arch: %[2]s
+      uri: v2.tar.gz
+      sha256: %[3]s
+`, runtime.GOOS, runtime.GOARCH, checksum(v2))
+	content := map[string][]byte{"/index.yaml": []byte(index), "/v1.tar.gz": v1, "/v2.tar.gz": v2}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := content[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	manager := NewManager(filepath.Join(t.TempDir(), "plugins"), false)
+	installed, err := manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, *installed, InstalledPlugin{
+		Name:    "kn-admin",
+		Version: "v1.0.0",
+		Source:  server.URL + "/v1.tar.gz",
+		Index:   server.URL + "/index.yaml",
+		SHA256:  checksum(v1),
+		Files:   []string{"kn-admin", "kn-admin-old"},
+	})
+	assertBinary(t, manager, "kn-admin", "v1")
+
+	previous, upgraded, err := manager.Upgrade("admin", "")
+	assert.NilError(t, err)
+	assert.Equal(t, previous.Version, "v1.0.0")
+	assert.Assert(t, upgraded == nil)
+
+	content["/index.yaml"] = []byte(v2Index)
+	previous, upgraded, err = manager.Upgrade("kn-admin", "")
+	assert.NilError(t, err)
+	assert.Equal(t, previous.Version, "v1.0.0")
+	assert.Equal(t, upgraded.Version, "v2.0.0")
+	assertBinary(t, manager, "kn-admin", "v2")
+	assertBinary(t, manager, "kn-admin-users", "users")
+	_, err = os.Stat(filepath.Join(manager.PluginsDir(), "kn-admin-old"))
+	assert.Assert(t, os.IsNotExist(err))
+
+	content["/v2.tar.gz"] = v1
+	_, err = manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Force: true})
+	assert.ErrorContains(t, err, "checksum mismatch for "+server.URL+"/v2.tar.gz")
+	delete(content, "/v2.tar.gz")
+	_, err = manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Force: true})
+	assert.ErrorContains(t, err, "cannot download plugin kn-admin v2.0.0")
+
+	uninstalled, err := manager.Uninstall("admin")
+	assert.NilError(t, err)
+	assert.Equal(t, uninstalled.Version, "v2.0.0")
+	plugins, err := manager.ListPlugins()
+	assert.NilError(t, err)
+	assert.Equal(t, len(plugins), 0)
+	recorded, err := manager.InstalledPlugins()
+	assert.NilError(t, err)
+	assert.Equal(t, len(recorded), 0)
+
+	_, err = manager.Uninstall("admin")
+	assert.ErrorContains(t, err, "plugin kn-admin has not been installed with 'kn plugin install'")
+	_, _, err = manager.Upgrade("admin", "")
+	assert.ErrorContains(t, err, "plugin kn-admin has not been installed with 'kn plugin install'")
+}
+
+func TestInstallFromIndexWithSignature(t *testing.T) {
+	publicKey, privateKey := generateTestKey(t)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("admin")))
+	unsigned := createTestArchive(t, map[string]string{"kn-admin": "admin"})
+	bundled := createTestArchive(t, map[string]string{"bin/kn-admin": "admin", "bin/kn-admin.sig": signature})
+	index := fmt.Sprintf(`
+plugins:
+- name: kn-admin
+  versions:
+  - version: v1.0.0
+    platforms:
+    - os: %[1]s
+      arch: %[2]s
+      uri: v1.tar.gz
+      sha256: %[3]s
+      signature: sigs/kn-admin.sig
+  - version: v2.0.0
+    platforms:
+    - os: %[1]s
+      arch: %[2]s
+      uri: v2.tar.gz
+      sha256: %[4]s
+      bin: bin/kn-admin
+`, runtime.GOOS, runtime.GOARCH, checksum(unsigned), checksum(bundled))
+	content := map[string][]byte{"/index.yaml": []byte(index), "/v1.tar.gz": unsigned, "/v2.tar.gz": bundled,
+		"/sigs/kn-admin.sig": []byte(signature)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := content[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	manager := NewManager(filepath.Join(t.TempDir(), "plugins"), false)
+	assert.NilError(t, manager.ConfigureSignatures(config.SignaturesConfig{
+		Policy:      config.SignaturePolicyEnforce,
+		TrustedKeys: []config.TrustedKey{{Name: "team", Key: base64.StdEncoding.EncodeToString(publicKey)}},
+	}))
+	installed, err := manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Version: "v1.0.0"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, installed.Files, []string{"kn-admin", "kn-admin.sig"})
+	assert.Equal(t, len(installed.Warnings), 0)
+	key, err := manager.SignatureVerifier().Verify(filepath.Join(manager.PluginsDir(), "kn-admin"))
+	assert.NilError(t, err)
+	assert.Equal(t, key, "team")
+
+	// A signature bundled next to the binary in the archive is installed, too
+	_, upgraded, err := manager.Upgrade("admin", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, upgraded.Files, []string{"kn-admin", "kn-admin.sig"})
+
+	content["/sigs/kn-admin.sig"] = []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("other"))))
+	_, err = manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Version: "v1.0.0", Force: true})
+	assert.ErrorContains(t, err, "refusing to install plugin kn-admin: signature of kn-admin can't be verified with any of the trusted keys")
+	assertBinary(t, manager, "kn-admin", "admin")
+
+	assert.NilError(t, manager.ConfigureSignatures(config.SignaturesConfig{
+		Policy:      config.SignaturePolicyWarn,
+		TrustedKeys: []config.TrustedKey{{Name: "team", Key: base64.StdEncoding.EncodeToString(publicKey)}},
+	}))
+	installed, err = manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Version: "v1.0.0", Force: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, installed.Warnings, []string{"signature of kn-admin can't be verified with any of the trusted keys"})
+
+	delete(content, "/sigs/kn-admin.sig")
+	_, err = manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Version: "v1.0.0", Force: true})
+	assert.ErrorContains(t, err, "cannot download signature of plugin kn-admin v1.0.0")
+
+	assert.Check(t, manager.ConfigureSignatures(config.SignaturesConfig{
+		Policy:      config.SignaturePolicyEnforce,
+		TrustedKeys: []config.TrustedKey{{Name: "broken", Key: "no key"}},
+	}) != nil)
+	_, err = manager.InstallFromIndex(server.URL+"/index.yaml", "admin", InstallOptions{Force: true})
+	assert.ErrorContains(t, err, "refusing to install plugins")
+}
+
+func TestUpgradeLocalPlugin(t *testing.T) {
+	manager := NewManager(filepath.Join(t.TempDir(), "plugins"), false)
+	binary := filepath.Join(t.TempDir(), "kn-admin")
+	assert.NilError(t, os.WriteFile(binary, []byte("local"), 0644))
+	_, err := manager.InstallFromPath(binary, InstallOptions{})
+	assert.NilError(t, err)
+
+	_, _, err = manager.Upgrade("admin", "")
+	assert.ErrorContains(t, err, "plugin kn-admin has not been installed from a plugin index, use --index")
+
+	// A plugin binary is named after the plugin if its file name has no "kn-" prefix
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "admin"), []byte("indexed"), 0644))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "index.yaml"), []byte(fmt.Sprintf(`
+plugins:
+- name: admin
+  versions:
+  - version: v0.1.0
+    platforms:
+    - os: %s
+      arch: %s
+      uri: admin
+      sha256: %s
+`, runtime.GOOS, runtime.GOARCH, checksum([]byte("indexed")))), 0644))
+	previous, upgraded, err := manager.Upgrade("admin", filepath.Join(dir, "index.yaml"))
+	assert.NilError(t, err)
+	assert.Equal(t, previous.Version, "")
+	assert.Equal(t, upgraded.Version, "v0.1.0")
+	assertBinary(t, manager, "kn-admin", "indexed")
+}
+
+func createTestArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		assert.NilError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(content))}))
+		_, err := tarWriter.Write([]byte(content))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, tarWriter.WriteHeader(&tar.Header{Name: "kn-dir/", Typeflag: tar.TypeDir, Mode: 0755}))
+	assert.NilError(t, tarWriter.Close())
+	assert.NilError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func assertBinary(t *testing.T, manager *Manager, name string, content string) {
+	path := filepath.Join(manager.PluginsDir(), name)
+	data, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), content)
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		assert.NilError(t, err)
+		assert.Equal(t, info.Mode().Perm(), os.FileMode(0755))
+	}
+}
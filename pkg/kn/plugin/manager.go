@@ -29,6 +29,8 @@ import (
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/config"
 )
 
 // Allow plugins to register to this slice for inlining
@@ -61,6 +63,12 @@ type Manager struct {
 
 	// Whether to check the OS path or not
 	lookupInPath bool
+
+	// Verifier of plugin signatures, no signatures are verified if nil
+	signatures *SignatureVerifier
+
+	// Manifests read from the cache file, loaded on first use
+	cachedManifests map[string]Manifest
 }
 
 type plugin struct {
@@ -101,6 +109,29 @@ func NewManager(pluginDir string, lookupInPath bool) *Manager {
 	return m
 }
 
+// SetSignatureVerifier sets the verifier used for checking the signatures of plugins
+func (manager *Manager) SetSignatureVerifier(verifier *SignatureVerifier) {
+	manager.signatures = verifier
+}
+
+// ConfigureSignatures sets up the verification of plugin signatures with the given configuration.
+// An invalid configuration is returned as error and kept, so that all plugins are refused
+// unless the policy is off.
+func (manager *Manager) ConfigureSignatures(signatures config.SignaturesConfig) error {
+	verifier, err := NewSignatureVerifier(signatures)
+	if err != nil {
+		verifier = newFailingSignatureVerifier(signatures.Policy, err)
+	}
+	manager.signatures = verifier
+	return err
+}
+
+// SignatureVerifier returns the verifier for plugin signatures, which is nil if signatures
+// are not verified
+func (manager *Manager) SignatureVerifier() *SignatureVerifier {
+	return manager.signatures
+}
+
 func (manager *Manager) AppendPlugin(plugin Plugin) {
 	InternalPlugins = append(InternalPlugins, plugin)
 }
@@ -171,7 +202,7 @@ func (manager *Manager) ListPluginsForCommandGroup(commandGroupParts []string) (
 			if f.IsDir() {
 				continue
 			}
-			if !strings.HasPrefix(name, "kn-") {
+			if !strings.HasPrefix(name, "kn-") || isSignatureFile(name) {
 				continue
 			}
 
@@ -324,10 +355,14 @@ func (manager *Manager) listPluginsHelpMessage() func(cmd *cobra.Command) string
 		var plugins []string
 		for _, pl := range list {
 			t := fmt.Sprintf("  %%-%ds %%s", cmd.NamePadding())
-			desc, _ := pl.Description()
+			manifest := manager.helpManifest(pl)
 			command := (pl.CommandParts())[len(pl.CommandParts())-1]
-			help := fmt.Sprintf(t, command, desc)
+			help := fmt.Sprintf(t, command, DescriptionOf(pl, manifest))
 			plugins = append(plugins, help)
+			if manifest != nil && len(manifest.Flags) > 0 {
+				// Show the declared flags below the description
+				plugins = append(plugins, fmt.Sprintf(t, "", FlagsUsage(manifest.Flags)))
+			}
 		}
 		return strings.Join(plugins, "\n")
 	}
@@ -410,6 +445,9 @@ func findMostSpecificPluginInPath(dir string, parts []string, lookupInPath bool)
 			commandParts = append(commandParts, p)
 		}
 		name := fmt.Sprintf("kn-%s", strings.Join(nameParts, "-"))
+		if isSignatureFile(name) {
+			continue
+		}
 
 		// Check for the name in plugin directory and PATH (if requested)
 		path, err := findInDirOrPath(name, dir, lookupInPath)
@@ -0,0 +1,224 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"knative.dev/client/pkg/kn/config"
+)
+
+// SignatureExtension is the extension of the detached signature file next to a plugin binary
+const SignatureExtension = ".sig"
+
+// SignatureVerifier verifies the detached ed25519 signatures of plugin binaries
+type SignatureVerifier struct {
+	// policy is one of config.SignaturePolicyOff, config.SignaturePolicyWarn or config.SignaturePolicyEnforce
+	policy string
+
+	// keys are the trusted public keys by their name
+	keys []trustedKey
+
+	// err is set if the configured trusted keys are invalid, it fails all checks
+	// unless the policy is off
+	err error
+}
+
+type trustedKey struct {
+	name string
+	key  ed25519.PublicKey
+}
+
+// NewSignatureVerifier creates a verifier for the configured policy and trusted keys
+func NewSignatureVerifier(signatures config.SignaturesConfig) (*SignatureVerifier, error) {
+	verifier := newSignatureVerifier(signatures.Policy)
+	for _, trusted := range signatures.TrustedKeys {
+		key, err := parsePublicKey(trusted.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key '%s' for plugin signatures: %w", trusted.Name, err)
+		}
+		verifier.keys = append(verifier.keys, trustedKey{name: trusted.Name, key: key})
+	}
+	return verifier, nil
+}
+
+// newFailingSignatureVerifier creates a verifier for an invalid configuration, which refuses
+// all plugins unless the policy is off
+func newFailingSignatureVerifier(policy string, err error) *SignatureVerifier {
+	verifier := newSignatureVerifier(policy)
+	verifier.err = err
+	return verifier
+}
+
+func newSignatureVerifier(policy string) *SignatureVerifier {
+	if policy == "" {
+		policy = config.SignaturePolicyOff
+	}
+	return &SignatureVerifier{policy: policy}
+}
+
+// Policy returns the signature policy
+func (verifier *SignatureVerifier) Policy() string {
+	return verifier.policy
+}
+
+// KeyNames returns the names of the trusted keys
+func (verifier *SignatureVerifier) KeyNames() []string {
+	names := make([]string, 0, len(verifier.keys))
+	for _, trusted := range verifier.keys {
+		names = append(names, trusted.name)
+	}
+	return names
+}
+
+// Verify checks the signature file next to the plugin binary at the given path against
+// the trusted keys and returns the name of the key the binary has been signed with
+func (verifier *SignatureVerifier) Verify(path string) (string, error) {
+	signatureFile := path + SignatureExtension
+	content, err := os.ReadFile(signatureFile)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("%s is not signed, no signature file %s found", path, signatureFile)
+	}
+	if err != nil {
+		return "", err
+	}
+	binary, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return verifier.verifySignature(path, binary, content)
+}
+
+// verifySignature checks the content of a signature file for the given binary against the
+// trusted keys and returns the name of the key the binary has been signed with
+func (verifier *SignatureVerifier) verifySignature(path string, binary []byte, content []byte) (string, error) {
+	signature, err := decodeSignature(content)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature file %s: %w", path+SignatureExtension, err)
+	}
+	if len(verifier.keys) == 0 {
+		return "", fmt.Errorf("signature of %s can't be verified, no trusted keys are configured", path)
+	}
+	for _, trusted := range verifier.keys {
+		if ed25519.Verify(trusted.key, binary, signature) {
+			return trusted.name, nil
+		}
+	}
+	return "", fmt.Errorf("signature of %s can't be verified with any of the trusted keys", path)
+}
+
+// Check verifies the signature of a plugin according to the policy. It returns an error
+// if the policy is 'enforce' and a warning if the policy is 'warn' and the signature can't
+// be verified. Inlined plugins are compiled into kn and are not verified. If the trusted keys
+// are invalid, all other plugins are refused unless the policy is 'off'.
+func (verifier *SignatureVerifier) Check(plugin Plugin) (warning string, err error) {
+	if verifier == nil || verifier.policy == config.SignaturePolicyOff || plugin.Path() == "" {
+		return "", nil
+	}
+	if verifier.err != nil {
+		return "", fmt.Errorf("refusing to run plugin %s: %w", plugin.Name(), verifier.err)
+	}
+	if _, err := verifier.Verify(plugin.Path()); err != nil {
+		if verifier.policy == config.SignaturePolicyEnforce {
+			return "", fmt.Errorf("refusing to run plugin %s: %w", plugin.Name(), err)
+		}
+		return err.Error(), nil
+	}
+	return "", nil
+}
+
+// checkInstall verifies the signatures of the plugin binaries to install, given by their file
+// name together with their signature files, according to the policy. It returns an error if
+// the policy is 'enforce' and warnings if the policy is 'warn' and a signature can't be verified.
+func (verifier *SignatureVerifier) checkInstall(names []string, files map[string][]byte) ([]string, error) {
+	if verifier == nil || verifier.policy == config.SignaturePolicyOff {
+		return nil, nil
+	}
+	if verifier.err != nil {
+		return nil, fmt.Errorf("refusing to install plugins: %w", verifier.err)
+	}
+	var warnings []string
+	for _, name := range names {
+		if isSignatureFile(name) {
+			continue
+		}
+		var err error
+		if signature, ok := files[name+SignatureExtension]; ok {
+			_, err = verifier.verifySignature(name, files[name], signature)
+		} else {
+			err = fmt.Errorf("%s is not signed, no signature file %s found", name, name+SignatureExtension)
+		}
+		if err == nil {
+			continue
+		}
+		if verifier.policy == config.SignaturePolicyEnforce {
+			return nil, fmt.Errorf("refusing to install plugin %s: %w", name, err)
+		}
+		warnings = append(warnings, err.Error())
+	}
+	return warnings, nil
+}
+
+// isSignatureFile checks whether a file in a plugin directory is a detached signature
+func isSignatureFile(name string) bool {
+	return strings.HasSuffix(name, SignatureExtension)
+}
+
+// parsePublicKey parses an ed25519 public key, which is either PEM encoded in PKIX
+// format or the base64 encoded raw key
+func parsePublicKey(key string) (ed25519.PublicKey, error) {
+	key = strings.TrimSpace(key)
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		edKey, ok := parsed.(ed25519.PublicKey)
+		if !ok {
+			return nil, errors.New("not an ed25519 public key")
+		}
+		return edKey, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("ed25519 public key must have %d bytes, but has %d", ed25519.PublicKeySize, len(raw))
+	}
+	return raw, nil
+}
+
+// decodeSignature decodes a base64 encoded signature, like written by cosign, or a raw signature
+func decodeSignature(content []byte) ([]byte, error) {
+	if len(content) == ed25519.SignatureSize {
+		return content, nil
+	}
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("ed25519 signature must have %d bytes, but has %d", ed25519.SignatureSize, len(signature))
+	}
+	return signature, nil
+}
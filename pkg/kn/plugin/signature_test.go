@@ -0,0 +1,216 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/util"
+)
+
+func TestSignatureVerifierVerify(t *testing.T) {
+	publicKey, privateKey := generateTestKey(t)
+	otherKey, _ := generateTestKey(t)
+	der, err := x509.MarshalPKIXPublicKey(otherKey)
+	assert.NilError(t, err)
+	pemKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	verifier, err := NewSignatureVerifier(config.SignaturesConfig{
+		Policy: config.SignaturePolicyEnforce,
+		TrustedKeys: []config.TrustedKey{
+			{Name: "other", Key: pemKey},
+			{Name: "team", Key: base64.StdEncoding.EncodeToString(publicKey)},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, verifier.Policy(), config.SignaturePolicyEnforce)
+	assert.DeepEqual(t, verifier.KeyNames(), []string{"other", "team"})
+
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "kn-admin")
+	assert.NilError(t, os.WriteFile(binary, []byte("admin"), 0755))
+
+	_, err = verifier.Verify(binary)
+	assert.ErrorContains(t, err, binary+" is not signed, no signature file "+binary+".sig found")
+
+	signTestBinary(t, binary, privateKey)
+	key, err := verifier.Verify(binary)
+	assert.NilError(t, err)
+	assert.Equal(t, key, "team")
+
+	// Raw signatures are accepted, too
+	assert.NilError(t, os.WriteFile(binary+SignatureExtension, ed25519.Sign(privateKey, []byte("admin")), 0644))
+	key, err = verifier.Verify(binary)
+	assert.NilError(t, err)
+	assert.Equal(t, key, "team")
+
+	assert.NilError(t, os.WriteFile(binary, []byte("tampered"), 0755))
+	_, err = verifier.Verify(binary)
+	assert.ErrorContains(t, err, "signature of "+binary+" can't be verified with any of the trusted keys")
+
+	assert.NilError(t, os.WriteFile(binary+SignatureExtension, []byte("no signature"), 0644))
+	_, err = verifier.Verify(binary)
+	assert.ErrorContains(t, err, "invalid signature file "+binary+".sig")
+
+	verifier, err = NewSignatureVerifier(config.SignaturesConfig{Policy: config.SignaturePolicyWarn})
+	assert.NilError(t, err)
+	signTestBinary(t, binary, privateKey)
+	_, err = verifier.Verify(binary)
+	assert.ErrorContains(t, err, "no trusted keys are configured")
+}
+
+func TestNewSignatureVerifierInvalidKey(t *testing.T) {
+	for _, key := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("short")), "-----BEGIN PUBLIC KEY-----\nAAAA\n-----END PUBLIC KEY-----\n"} {
+		_, err := NewSignatureVerifier(config.SignaturesConfig{TrustedKeys: []config.TrustedKey{{Name: "broken", Key: key}}})
+		assert.ErrorContains(t, err, "invalid trusted key 'broken' for plugin signatures")
+	}
+	verifier, err := NewSignatureVerifier(config.SignaturesConfig{})
+	assert.NilError(t, err)
+	assert.Equal(t, verifier.Policy(), config.SignaturePolicyOff)
+}
+
+func TestSignatureVerifierCheck(t *testing.T) {
+	publicKey, privateKey := generateTestKey(t)
+	dir := t.TempDir()
+	signed := &plugin{path: filepath.Join(dir, "kn-signed"), name: "kn-signed"}
+	unsigned := &plugin{path: filepath.Join(dir, "kn-unsigned"), name: "kn-unsigned"}
+	assert.NilError(t, os.WriteFile(signed.path, []byte("signed"), 0755))
+	assert.NilError(t, os.WriteFile(unsigned.path, []byte("unsigned"), 0755))
+	signTestBinary(t, signed.path, privateKey)
+	keys := []config.TrustedKey{{Name: "team", Key: base64.StdEncoding.EncodeToString(publicKey)}}
+
+	for _, tc := range []struct {
+		policy          string
+		plugin          Plugin
+		expectedWarning string
+		expectedError   string
+	}{
+		{config.SignaturePolicyOff, unsigned, "", ""},
+		{config.SignaturePolicyWarn, signed, "", ""},
+		{config.SignaturePolicyWarn, unsigned, "kn-unsigned is not signed", ""},
+		{config.SignaturePolicyEnforce, signed, "", ""},
+		{config.SignaturePolicyEnforce, unsigned, "", "refusing to run plugin kn-unsigned"},
+		{config.SignaturePolicyEnforce, testPlugin{parts: []string{"inlined"}}, "", ""},
+	} {
+		verifier, err := NewSignatureVerifier(config.SignaturesConfig{Policy: tc.policy, TrustedKeys: keys})
+		assert.NilError(t, err)
+		warning, err := verifier.Check(tc.plugin)
+		if tc.expectedError != "" {
+			assert.ErrorContains(t, err, tc.expectedError)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.Assert(t, util.ContainsAll(warning, tc.expectedWarning))
+		assert.Equal(t, warning == "", tc.expectedWarning == "")
+	}
+
+	var verifier *SignatureVerifier
+	warning, err := verifier.Check(unsigned)
+	assert.NilError(t, err)
+	assert.Equal(t, warning, "")
+}
+
+func TestManagerVerifySignatures(t *testing.T) {
+	publicKey, privateKey := generateTestKey(t)
+	dir := t.TempDir()
+	for _, name := range []string{"kn-signed", "kn-unsigned"} {
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0755))
+	}
+	signTestBinary(t, filepath.Join(dir, "kn-signed"), privateKey)
+	keys := []config.TrustedKey{{Name: "team", Key: base64.StdEncoding.EncodeToString(publicKey)}}
+
+	manager := NewManager(dir, false)
+	plugins, err := manager.ListPlugins()
+	assert.NilError(t, err)
+	var names []string
+	for _, pl := range plugins {
+		if pl.Path() != "" {
+			names = append(names, pl.Name())
+		}
+	}
+	assert.DeepEqual(t, names, []string{"kn-signed", "kn-unsigned"})
+	found, err := manager.FindPlugin([]string{"signed.sig"})
+	assert.NilError(t, err)
+	assert.Assert(t, found == nil)
+
+	eaw := manager.Verify()
+	assert.Assert(t, eaw.IsEmpty(), "%v", eaw)
+
+	for _, policy := range []string{config.SignaturePolicyWarn, config.SignaturePolicyEnforce} {
+		verifier, err := NewSignatureVerifier(config.SignaturesConfig{Policy: policy, TrustedKeys: keys})
+		assert.NilError(t, err)
+		manager.SetSignatureVerifier(verifier)
+		assert.Equal(t, manager.SignatureVerifier(), verifier)
+		eaw = manager.Verify()
+		issues := eaw.Warnings
+		if policy == config.SignaturePolicyEnforce {
+			issues = eaw.Errors
+		}
+		assert.Equal(t, len(eaw.Warnings)+len(eaw.Errors), 1)
+		assert.Assert(t, util.ContainsAll(issues[0], "kn-unsigned is not signed"))
+	}
+}
+
+func TestManagerConfigureSignatures(t *testing.T) {
+	dir := t.TempDir()
+	unsigned := &plugin{path: filepath.Join(dir, "kn-unsigned"), name: "kn-unsigned"}
+	assert.NilError(t, os.WriteFile(unsigned.path, []byte("unsigned"), 0755))
+	broken := []config.TrustedKey{{Name: "broken", Key: "not base64!"}}
+
+	// An invalid key refuses all plugins, also when run for their manifest or completions
+	for _, policy := range []string{config.SignaturePolicyWarn, config.SignaturePolicyEnforce} {
+		manager := NewManager(dir, false)
+		err := manager.ConfigureSignatures(config.SignaturesConfig{Policy: policy, TrustedKeys: broken})
+		assert.ErrorContains(t, err, "invalid trusted key 'broken'")
+		_, err = manager.SignatureVerifier().Check(unsigned)
+		assert.ErrorContains(t, err, "refusing to run plugin kn-unsigned: invalid trusted key 'broken'")
+		completions, directive := manager.Complete(unsigned, nil)
+		assert.Equal(t, len(completions), 0)
+		assert.Equal(t, directive, cobra.ShellCompDirectiveError)
+	}
+
+	manager := NewManager(dir, false)
+	assert.ErrorContains(t, manager.ConfigureSignatures(config.SignaturesConfig{TrustedKeys: broken}), "invalid trusted key")
+	warning, err := manager.SignatureVerifier().Check(unsigned)
+	assert.NilError(t, err)
+	assert.Equal(t, warning, "")
+
+	assert.NilError(t, manager.ConfigureSignatures(config.SignaturesConfig{Policy: config.SignaturePolicyEnforce}))
+	assert.Equal(t, manager.SignatureVerifier().Policy(), config.SignaturePolicyEnforce)
+}
+
+func generateTestKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NilError(t, err)
+	return publicKey, privateKey
+}
+
+func signTestBinary(t *testing.T, path string, privateKey ed25519.PrivateKey) {
+	data, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data))
+	assert.NilError(t, os.WriteFile(path+SignatureExtension, []byte(signature+"\n"), 0644))
+}
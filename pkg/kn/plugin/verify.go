@@ -22,6 +22,8 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+
+	"knative.dev/client/pkg/kn/config"
 )
 
 // Collection of errors and warning collected during verifications
@@ -41,6 +43,7 @@ const (
 // for the verification. The following criteria are verified (for each plugin):
 // * If the plugin is executable
 // * If the plugin is overshadowed by a previous plugin
+// * If the plugin is signed with a trusted key, if signatures are verified
 func (manager *Manager) Verify() VerificationErrorsAndWarnings {
 	eaw := VerificationErrorsAndWarnings{}
 
@@ -69,10 +72,11 @@ func (manager *Manager) Verify() VerificationErrorsAndWarnings {
 			if f.IsDir() {
 				continue
 			}
-			if !strings.HasPrefix(f.Name(), "kn-") {
+			if !strings.HasPrefix(f.Name(), "kn-") || isSignatureFile(f.Name()) {
 				continue
 			}
 			eaw = verifyPath(filepath.Join(dir, f.Name()), seenPlugins, eaw)
+			eaw = manager.addIssueIfNotSigned(eaw, filepath.Join(dir, f.Name()))
 		}
 	}
 	return eaw
@@ -137,6 +141,22 @@ func addWarningIfNotExecutable(eaw VerificationErrorsAndWarnings, path string, f
 	return eaw.AddWarning("%s is not executable by current user", path)
 }
 
+// addIssueIfNotSigned adds a warning or an error, depending on the signature policy, if the
+// signature of the plugin can't be verified
+func (manager *Manager) addIssueIfNotSigned(eaw VerificationErrorsAndWarnings, path string) VerificationErrorsAndWarnings {
+	verifier := manager.signatures
+	if verifier == nil || verifier.Policy() == config.SignaturePolicyOff {
+		return eaw
+	}
+	if _, err := verifier.Verify(path); err != nil {
+		if verifier.Policy() == config.SignaturePolicyEnforce {
+			return eaw.AddError("%v", err)
+		}
+		return eaw.AddWarning("%v", err)
+	}
+	return eaw
+}
+
 func addWarningIfAlreadySeen(eaw VerificationErrorsAndWarnings, seenPlugins map[string]string, path string) VerificationErrorsAndWarnings {
 	fileName := filepath.Base(path)
 	if existingPath, ok := seenPlugins[fileName]; ok {
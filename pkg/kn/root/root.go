@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -30,12 +31,16 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/backup"
 	"knative.dev/client/pkg/kn/commands/broker"
 	"knative.dev/client/pkg/kn/commands/channel"
 	"knative.dev/client/pkg/kn/commands/completion"
+	configcmd "knative.dev/client/pkg/kn/commands/config"
 	"knative.dev/client/pkg/kn/commands/container"
 	"knative.dev/client/pkg/kn/commands/domain"
 	"knative.dev/client/pkg/kn/commands/eventtype"
+	commandsflags "knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/commands/lint"
 	"knative.dev/client/pkg/kn/commands/options"
 	"knative.dev/client/pkg/kn/commands/plugin"
 	"knative.dev/client/pkg/kn/commands/revision"
@@ -44,9 +49,11 @@ import (
 	"knative.dev/client/pkg/kn/commands/source"
 	"knative.dev/client/pkg/kn/commands/subscription"
 	"knative.dev/client/pkg/kn/commands/trigger"
+	"knative.dev/client/pkg/kn/commands/validate"
 	"knative.dev/client/pkg/kn/commands/version"
 	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/kn/flags"
+	"knative.dev/client/pkg/printers"
 	"knative.dev/client/pkg/templates"
 )
 
@@ -72,8 +79,16 @@ Find more information about Knative at: https://knative.dev`, rootName),
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
-		// Validate our boolean configs
+		// Apply configured flag defaults, validate our boolean configs and switch on colors for terminals
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			command := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+			if err := flags.SetFlagDefaults(cmd.Flags(), config.GlobalConfig.CommandDefaults(command)); err != nil {
+				return fmt.Errorf("invalid flags configured for command '%s': %w", command, err)
+			}
+			if err := commands.ValidateProgressMode(p.Progress); err != nil {
+				return err
+			}
+			printers.EnableColor(config.ColorEnabled(config.GlobalConfig.Color(), cmd.OutOrStdout()))
 			return flags.ReconcileBoolFlags(cmd.Flags())
 		},
 	}
@@ -92,6 +107,7 @@ Find more information about Knative at: https://knative.dev`, rootName),
 	rootCmd.PersistentFlags().StringVar(&p.KubeAsUID, "as-uid", "", "uid to impersonate for the operation")
 	rootCmd.PersistentFlags().StringArrayVar(&p.KubeAsGroup, "as-group", []string{}, "group to impersonate for the operation, this flag can be repeated to specify multiple groups")
 	flags.AddBothBoolFlags(rootCmd.PersistentFlags(), &p.LogHTTP, "log-http", "", false, "log http traffic")
+	rootCmd.PersistentFlags().StringVar(&p.Progress, "progress", commands.ProgressText, fmt.Sprintf("format for reporting progress of create, update, delete and wait operations (%s)", strings.Join(commands.ProgressModes, "|")))
 
 	// Grouped commands
 	groups := templates.CommandGroups{
@@ -121,6 +137,10 @@ Find more information about Knative at: https://knative.dev`, rootName),
 			Commands: []*cobra.Command{
 				plugin.NewPluginCommand(p),
 				secret.NewSecretCommand(p),
+				backup.NewBackupCommand(p),
+				validate.NewValidateCommand(p),
+				lint.NewLintCommand(p),
+				configcmd.NewConfigCommand(p),
 				completion.NewCompletionCommand(p),
 				version.NewVersionCommand(p),
 			},
@@ -129,12 +149,25 @@ Find more information about Knative at: https://knative.dev`, rootName),
 	// Add all commands to the root command, flat
 	groups.AddTo(rootCmd)
 
+	// Show user-defined aliases in the help message of the root command
+	templateFuncs := template.FuncMap{
+		"listAliases": listAliasesHelpMessage(),
+	}
+	if helpFuncs != nil {
+		for name, function := range *helpFuncs {
+			templateFuncs[name] = function
+		}
+	}
+
 	// Initialize default `help` cmd early to prevent unknown command errors
-	groups.SetRootUsage(rootCmd, helpFuncs)
+	groups.SetRootUsage(rootCmd, &templateFuncs)
 
 	// Add the "options" commands for showing all global options
 	rootCmd.AddCommand(options.NewOptionsCommand())
 
+	// Complete the values of flags referring to cluster resources or configuration
+	commandsflags.RegisterFlagCompletions(rootCmd, p)
+
 	// Check that command groups can't execute and that leaf commands don't h
 	err := validateCommandStructure(rootCmd)
 	if err != nil {
@@ -180,6 +213,42 @@ func validateCommandStructure(cmd *cobra.Command) error {
 	return nil
 }
 
+// listAliasesHelpMessage returns a function which returns all user-defined aliases
+// as a properly formatted string, which are only shown for the root command
+func listAliasesHelpMessage() func(cmd *cobra.Command) string {
+	return func(cmd *cobra.Command) string {
+		aliases := config.GlobalConfig.Aliases()
+		if cmd.HasParent() || len(aliases) == 0 {
+			return ""
+		}
+		names := make([]string, 0, len(aliases))
+		padding := cmd.NamePadding()
+		for name := range aliases {
+			// Aliases shadowing a built-in command are ignored
+			if IsBuiltinCommand(cmd, name) {
+				continue
+			}
+			names = append(names, name)
+			if len(name) > padding {
+				padding = len(name)
+			}
+		}
+		sort.Strings(names)
+		lines := make([]string, 0, len(names))
+		for _, name := range names {
+			lines = append(lines, fmt.Sprintf("  %-*s %s", padding, name, aliases[name]))
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+// IsBuiltinCommand checks whether a name is a built-in command of kn or an alias of one,
+// which always take precedence over user-defined aliases with the same name
+func IsBuiltinCommand(rootCmd *cobra.Command, name string) bool {
+	cmd, _, err := rootCmd.Find([]string{name})
+	return (err == nil && cmd != rootCmd) || name == "help"
+}
+
 // ExtractSubCommandNames extracts the names of all sub commands of a given command
 func ExtractSubCommandNames(cmds []*cobra.Command) []string {
 	ret := make([]string, 0, len(cmds))
@@ -15,6 +15,7 @@
 package root
 
 import (
+	"bytes"
 	"errors"
 	"os"
 	"strings"
@@ -23,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
 
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/util"
 )
 
@@ -65,6 +67,75 @@ func TestSubCommands(t *testing.T) {
 	checkLeafCommand(t, "version", rootCmd)
 }
 
+func TestConfiguredFlagDefaults(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{
+		TestLayers: []config.Layer{{Name: config.LayerUser, Path: "/home/user/.config/kn/config.yaml"}},
+		TestCommandDefaults: map[string]map[string][]string{
+			"config path": {"all": {"true"}},
+		},
+	}
+
+	rootCmd, err := NewRootCommand(nil)
+	assert.NilError(t, err)
+	out := new(bytes.Buffer)
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"config", "path"})
+	assert.NilError(t, rootCmd.Execute())
+	assert.Equal(t, out.String(), "user\t/home/user/.config/kn/config.yaml\n")
+
+	// Flags given on the command line win
+	out.Reset()
+	rootCmd.SetArgs([]string{"config", "path", "--all=false"})
+	assert.NilError(t, rootCmd.Execute())
+	assert.Equal(t, out.String(), "\n")
+
+	config.GlobalConfig = &config.TestConfig{
+		TestCommandDefaults: map[string]map[string][]string{
+			"config path": {"unknown": {"true"}},
+		},
+	}
+	rootCmd, err = NewRootCommand(nil)
+	assert.NilError(t, err)
+	rootCmd.SetArgs([]string{"config", "path"})
+	err = rootCmd.Execute()
+	assert.ErrorContains(t, err, "invalid flags configured for command 'config path': unknown flag --unknown")
+
+	// Configured global flags are validated, too
+	config.GlobalConfig = &config.TestConfig{
+		TestCommandDefaults: map[string]map[string][]string{
+			"config path": {"progress": {"fancy"}},
+		},
+	}
+	rootCmd, err = NewRootCommand(nil)
+	assert.NilError(t, err)
+	rootCmd.SetArgs([]string{"config", "path"})
+	err = rootCmd.Execute()
+	assert.ErrorContains(t, err, "invalid value 'fancy' for --progress")
+}
+
+func TestListAliasesHelpMessage(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{
+		TestAliases: map[string]string{"deploy": "service apply", "service": "version", "ksvc": "version"},
+	}
+
+	rootCmd, err := NewRootCommand(nil)
+	assert.NilError(t, err)
+	assert.Assert(t, IsBuiltinCommand(rootCmd, "service"))
+	assert.Assert(t, IsBuiltinCommand(rootCmd, "ksvc"))
+	assert.Assert(t, IsBuiltinCommand(rootCmd, "help"))
+	assert.Assert(t, !IsBuiltinCommand(rootCmd, "deploy"))
+
+	// Aliases shadowing built-in commands are not listed
+	assert.DeepEqual(t, strings.Fields(listAliasesHelpMessage()(rootCmd)), []string{"deploy", "service", "apply"})
+	service, _, err := rootCmd.Find([]string{"service"})
+	assert.NilError(t, err)
+	assert.Equal(t, listAliasesHelpMessage()(service), "")
+}
+
 func TestCommandGroup(t *testing.T) {
 	rootCmd, err := NewRootCommand(nil)
 	assert.NilError(t, err)
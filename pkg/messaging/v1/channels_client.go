@@ -93,7 +93,7 @@ func (c *channelsClient) DeleteChannel(ctx context.Context, name string) error {
 
 // ListChannel lists channels in configured namespace
 func (c *channelsClient) ListChannel(ctx context.Context) (*messagingv1.ChannelList, error) {
-	channelList, err := c.client.List(ctx, metav1.ListOptions{})
+	channelList, err := c.client.List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
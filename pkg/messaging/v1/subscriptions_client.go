@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"knative.dev/client/pkg/config"
+	"knative.dev/client/pkg/util"
 
 	"k8s.io/client-go/util/retry"
 
@@ -140,7 +141,7 @@ func (c *subscriptionsClient) DeleteSubscription(ctx context.Context, name strin
 
 // ListSubscription lists subscriptions in configured namespace
 func (c *subscriptionsClient) ListSubscription(ctx context.Context) (*messagingv1.SubscriptionList, error) {
-	subscriptionList, err := c.client.List(ctx, metav1.ListOptions{})
+	subscriptionList, err := c.client.List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
@@ -0,0 +1,206 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"io"
+	"text/tabwriter"
+)
+
+// Color is an ANSI escape sequence switching the foreground color.
+// All colors have the same length so that colored table cells stay aligned.
+type Color string
+
+const (
+	ColorRed    Color = "\x1b[31m"
+	ColorGreen  Color = "\x1b[32m"
+	ColorYellow Color = "\x1b[33m"
+	// ColorDefault switches to the terminal's default color
+	ColorDefault Color = "\x1b[39m"
+
+	colorReset = "\x1b[0m"
+)
+
+// colorEnabled is switched on when output should be colored, see EnableColor
+var colorEnabled = false
+
+// EnableColor switches colored output on or off
+func EnableColor(enabled bool) {
+	colorEnabled = enabled
+}
+
+// ColorEnabled returns true if output should be colored
+func ColorEnabled() bool {
+	return colorEnabled
+}
+
+// Colorize returns the text in the given color, if colored output is enabled
+func Colorize(text string, color Color) string {
+	if !colorEnabled {
+		return text
+	}
+	return color.Apply(text)
+}
+
+// Apply returns the text in this color, regardless of whether colored output is enabled
+func (c Color) Apply(text string) string {
+	return string(c) + text + colorReset
+}
+
+// StatusColor returns the color for the status of a condition as shown
+// in the READY column of tables: green for True, red for False and yellow otherwise
+func StatusColor(status string) Color {
+	switch status {
+	case "True":
+		return ColorGreen
+	case "False":
+		return ColorRed
+	default:
+		return ColorYellow
+	}
+}
+
+// colorPadChar is the padding character of a colorTabWriter's tabwriter. It can't be part of
+// the text, so that the padding can be told apart from the text in the tabwriter's output.
+const colorPadChar = '\x00'
+
+// colorTabWriter aligns columns like a tabwriter, but doesn't count the escape sequences of
+// colored text as part of the width of a cell. The escape sequences are removed before the
+// text is laid out by the tabwriter, and inserted into its output again at the same position
+// of the text.
+type colorTabWriter struct {
+	tabWriter *tabwriter.Writer
+	out       io.Writer
+	// number of text bytes passed to the tabwriter, without the cell terminators
+	written int
+	// number of text bytes written by the tabwriter, without the padding
+	read int
+	// escape sequences not yet inserted into the output, ordered by position
+	colors []positionedColor
+	// start of an escape sequence which is continued by the next call to Write
+	partial []byte
+}
+
+// positionedColor is an escape sequence and the number of text bytes preceding it
+type positionedColor struct {
+	pos      int
+	sequence []byte
+}
+
+// newColorTabWriter returns a colorTabWriter laying out columns like a tabwriter with the given settings
+func newColorTabWriter(out io.Writer, minwidth, tabwidth, padding int, flags uint) *colorTabWriter {
+	w := &colorTabWriter{out: out}
+	w.tabWriter = tabwriter.NewWriter(colorOutput{w}, minwidth, tabwidth, padding, colorPadChar, flags)
+	return w
+}
+
+func (w *colorTabWriter) Write(p []byte) (int, error) {
+	data := append(w.partial, p...)
+	w.partial = nil
+	text := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		if data[i] == '\x1b' {
+			n, complete := colorSequenceLength(data[i:])
+			if !complete {
+				w.partial = append([]byte(nil), data[i:]...)
+				break
+			}
+			if n > 0 {
+				w.colors = append(w.colors, positionedColor{w.written, append([]byte(nil), data[i:i+n]...)})
+				i += n - 1
+				continue
+			}
+		}
+		// Cell terminators are not part of the tabwriter's output
+		if data[i] != '\t' && data[i] != '\v' {
+			w.written++
+		}
+		text = append(text, data[i])
+	}
+	if _, err := w.tabWriter.Write(text); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush flushes the tabwriter, writing escape sequences at the end of the text as well
+func (w *colorTabWriter) Flush() error {
+	if len(w.partial) > 0 {
+		partial := w.partial
+		w.partial = nil
+		if _, err := w.tabWriter.Write(partial); err != nil {
+			return err
+		}
+		w.written += len(partial)
+	}
+	if err := w.tabWriter.Flush(); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	w.insertColors(&buf)
+	_, err := w.out.Write(buf.Bytes())
+	return err
+}
+
+// insertColors writes the escape sequences positioned before the next byte of text
+func (w *colorTabWriter) insertColors(buf *bytes.Buffer) {
+	for len(w.colors) > 0 && w.colors[0].pos <= w.read {
+		buf.Write(w.colors[0].sequence)
+		w.colors = w.colors[1:]
+	}
+}
+
+// colorOutput receives the output of a colorTabWriter's tabwriter
+type colorOutput struct {
+	w *colorTabWriter
+}
+
+func (o colorOutput) Write(p []byte) (int, error) {
+	var buf bytes.Buffer
+	o.w.insertColors(&buf)
+	for _, b := range p {
+		if b == colorPadChar {
+			buf.WriteByte(' ')
+			continue
+		}
+		buf.WriteByte(b)
+		o.w.read++
+		// Insert the sequence ending colored text before the padding
+		o.w.insertColors(&buf)
+	}
+	if _, err := o.w.out.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// colorSequenceLength returns the length of the SGR escape sequence, like the ones switching
+// colors, at the start of data, or 0 if data doesn't start with such a sequence. The second
+// return value is false if data ends before it is known.
+func colorSequenceLength(data []byte) (int, bool) {
+	for i := 1; i < len(data); i++ {
+		switch {
+		case i == 1 && data[i] != '[':
+			return 0, true
+		case i == 1:
+		case data[i] == 'm':
+			return i + 1, true
+		case (data[i] < '0' || data[i] > '9') && data[i] != ';':
+			return 0, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,107 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+var ansiRegexp = regexp.MustCompile("\x1b\\[[0-9]+m")
+
+func withColor(t *testing.T) {
+	EnableColor(true)
+	t.Cleanup(func() { EnableColor(false) })
+}
+
+func TestColorize(t *testing.T) {
+	assert.Equal(t, Colorize("ok", ColorGreen), "ok")
+
+	withColor(t)
+	assert.Equal(t, Colorize("ok", ColorGreen), "\x1b[32mok\x1b[0m")
+	assert.Equal(t, ColorRed.Apply("Error:"), "\x1b[31mError:\x1b[0m")
+	assert.Equal(t, StatusColor("True"), ColorGreen)
+	assert.Equal(t, StatusColor("False"), ColorRed)
+	assert.Equal(t, StatusColor("Unknown"), ColorYellow)
+}
+
+func TestPrefixWriterColorAlignment(t *testing.T) {
+	withColor(t)
+	buf := &bytes.Buffer{}
+	w := NewPrefixWriter(buf)
+	section := w.WriteAttribute("Revisions", "")
+	sub := section.WriteColsLn(Colorize("100%", ColorGreen), "rev-1")
+	sub.WriteAttribute("Image", "gcr.io/foo")
+	section.WriteColsLn(Colorize("  !", ColorRed), Colorize("rev-2", ColorRed))
+	assert.NilError(t, w.Flush())
+
+	assert.Assert(t, strings.Contains(buf.String(), "\x1b[32m100%\x1b[0m"))
+	lines := strings.Split(ansiRegexp.ReplaceAllString(buf.String(), ""), "\n")
+	assert.Equal(t, strings.Index(lines[1], "rev-1"), strings.Index(lines[2], "Image:"))
+	assert.Equal(t, strings.Index(lines[1], "rev-1"), strings.Index(lines[3], "rev-2"))
+	// No padding after colored text at the end of a line
+	assert.Assert(t, strings.HasSuffix(lines[3], "rev-2"))
+}
+
+func TestColorTabWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := newColorTabWriter(buf, 0, 8, 2, 0)
+	// Cells and escape sequences split across several writes
+	for _, text := range []string{
+		"\x1b[32mab", "c\x1b[0m\tx\n",
+		"abcdef\ty\n",
+		"a\x1b[3", "1mb\x1b[0mc\tz\x1b[33m", "\n",
+	} {
+		_, err := w.Write([]byte(text))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, w.Flush())
+
+	assert.Equal(t, buf.String(), "\x1b[32mabc\x1b[0m     x\nabcdef  y\na\x1b[31mb\x1b[0mc     z\x1b[33m\n")
+}
+
+func TestTablePrinterColorsReadyColumn(t *testing.T) {
+	withColor(t)
+	columns := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Priority: 1},
+		{Name: "Ready", Priority: 1},
+		{Name: "Reason", Priority: 1},
+	}
+	printService := func(service *servingv1.Service, options PrintOptions) ([]metav1beta1.TableRow, error) {
+		return []metav1beta1.TableRow{{Cells: []interface{}{service.Name, service.Labels["ready"], "reason"}}}, nil
+	}
+	printer := NewTablePrinter(PrintOptions{})
+	assert.NilError(t, printer.TableHandler(columns, printService))
+
+	buf := &bytes.Buffer{}
+	for i, ready := range []string{"True", "False"} {
+		service := &servingv1.Service{}
+		service.Name = "svc"
+		service.Labels = map[string]string{"ready": ready}
+		printer.options.NoHeaders = i > 0
+		assert.NilError(t, printer.PrintObj(service, buf))
+	}
+
+	assert.Assert(t, strings.Contains(buf.String(), "\x1b[32mTrue\x1b[0m"))
+	assert.Assert(t, strings.Contains(buf.String(), "\x1b[31mFalse\x1b[0m"))
+	lines := strings.Split(ansiRegexp.ReplaceAllString(buf.String(), ""), "\n")
+	assert.Equal(t, strings.Index(lines[0], "REASON"), strings.Index(lines[1], "reason"))
+}
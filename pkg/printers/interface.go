@@ -36,9 +36,20 @@ func (fn ResourcePrinterFunc) PrintObj(obj runtime.Object, w io.Writer) error {
 	return fn(obj, w)
 }
 
+// WideColumnPriority is the priority of columns which are only printed with "-o wide".
+// Columns with priority 0 are only printed when listing across all namespaces.
+const WideColumnPriority = 2
+
 // PrintOptions for different table printing options
 type PrintOptions struct {
 	NoHeaders bool
-	//TODO: Add options for eg: with-kind, server-printing, wide etc
+	//TODO: Add options for eg: with-kind, server-printing etc
 	AllNamespaces bool
+	// Wide adds the columns with WideColumnPriority
+	Wide bool
+	// SortBy is a column name or a JSONPath expression to sort the rows by
+	SortBy string
+	// Columns are the names of the columns to print, in this order. All columns
+	// of a table can be picked, regardless of AllNamespaces and Wide.
+	Columns []string
 }
@@ -31,6 +31,9 @@ func NewBarePrefixWriter(out io.Writer) PrefixWriter {
 
 // NewPrefixWriter creates a new PrefixWriter.
 func NewPrefixWriter(out io.Writer) PrefixWriter {
+	if colorEnabled {
+		return &prefixWriter{out: newColorTabWriter(out, 0, 8, 2, 0), nested: nil, colIndent: 0, spaceIndent: 0}
+	}
 	tabWriter := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
 	return &prefixWriter{out: tabWriter, nested: nil, colIndent: 0, spaceIndent: 0}
 }
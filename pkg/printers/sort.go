@@ -0,0 +1,154 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// columnPaths maps the names of columns shared by all tables to the field they show.
+// Sorting by these columns uses the field so that e.g. ages are compared as timestamps.
+var columnPaths = map[string]string{
+	"name":      "{.metadata.name}",
+	"namespace": "{.metadata.namespace}",
+	"age":       "{.metadata.creationTimestamp}",
+}
+
+// SortUnstructured sorts the given objects by a JSONPath expression or by the
+// name of a column shared by all tables, i.e. NAME, NAMESPACE or AGE
+func SortUnstructured(items []unstructured.Unstructured, sortBy string) error {
+	path, descending, err := sortPath(sortBy)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, len(items))
+	for i := range items {
+		if keys[i], err = jsonPathValue(path, items[i].Object); err != nil {
+			return err
+		}
+	}
+	sort.Stable(keyedSort{keys, func(i, j int) { items[i], items[j] = items[j], items[i] }, descending})
+	return nil
+}
+
+// sortRows sorts table rows by a column name or a JSONPath expression evaluated on each row's object
+func sortRows(rows []metav1beta1.TableRow, columns []metav1beta1.TableColumnDefinition, sortBy string) error {
+	keys := make([]string, len(rows))
+	i := columnIndex(columns, sortBy)
+	if _, shared := columnPaths[strings.ToLower(sortBy)]; i >= 0 && !shared {
+		for r := range rows {
+			if i < len(rows[r].Cells) {
+				keys[r] = fmt.Sprint(rows[r].Cells[i])
+			}
+		}
+		sort.Stable(keyedSort{keys, func(i, j int) { rows[i], rows[j] = rows[j], rows[i] }, false})
+		return nil
+	}
+
+	path, descending, err := sortPath(sortBy)
+	if err != nil {
+		return err
+	}
+	for r := range rows {
+		obj := rows[r].Object.Object
+		if obj == nil {
+			continue
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		if keys[r], err = jsonPathValue(path, content); err != nil {
+			return err
+		}
+	}
+	sort.Stable(keyedSort{keys, func(i, j int) { rows[i], rows[j] = rows[j], rows[i] }, descending})
+	return nil
+}
+
+// sortPath returns the JSONPath to sort by and whether to sort in descending order, which
+// is the case for AGE so that the youngest resources come first
+func sortPath(sortBy string) (*jsonpath.JSONPath, bool, error) {
+	expression, shared := columnPaths[strings.ToLower(sortBy)]
+	if !shared {
+		expression = sortBy
+		if !strings.HasPrefix(expression, "{") {
+			if !strings.HasPrefix(expression, ".") {
+				return nil, false, fmt.Errorf("invalid --sort-by '%s': not a column name or a JSONPath expression like '.metadata.name'", sortBy)
+			}
+			expression = "{" + expression + "}"
+		}
+	}
+	path := jsonpath.New("sort-by").AllowMissingKeys(true)
+	if err := path.Parse(expression); err != nil {
+		return nil, false, fmt.Errorf("invalid --sort-by '%s': %w", sortBy, err)
+	}
+	return path, strings.EqualFold(sortBy, "age"), nil
+}
+
+// jsonPathValue returns the first value found for the given path, or an empty string
+func jsonPathValue(path *jsonpath.JSONPath, content interface{}) (string, error) {
+	results, err := path.FindResults(content)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return "", nil
+	}
+	value := results[0][0]
+	if !value.IsValid() || !value.CanInterface() || value.Interface() == nil {
+		return "", nil
+	}
+	return fmt.Sprint(value.Interface()), nil
+}
+
+// keyedSort stable sorts a slice by the keys given for each element,
+// comparing them as numbers if both are numeric
+type keyedSort struct {
+	keys       []string
+	swap       func(i, j int)
+	descending bool
+}
+
+func (s keyedSort) Len() int { return len(s.keys) }
+
+func (s keyedSort) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.swap(i, j)
+}
+
+func (s keyedSort) Less(i, j int) bool {
+	if s.descending {
+		return lessValue(s.keys[j], s.keys[i])
+	}
+	return lessValue(s.keys[i], s.keys[j])
+}
+
+func lessValue(a, b string) bool {
+	af, aErr := strconv.ParseFloat(a, 64)
+	bf, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return af < bf
+	}
+	return a < b
+}
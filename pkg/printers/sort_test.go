@@ -0,0 +1,150 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+var sortColumnDefs = []metav1beta1.TableColumnDefinition{
+	{Name: "Namespace", Priority: 0},
+	{Name: "Name", Priority: 1},
+	{Name: "Generation", Priority: 1},
+	{Name: "Age", Priority: 1},
+	{Name: "Image", Priority: WideColumnPriority},
+}
+
+func printSortTestService(service *servingv1.Service, options PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{Object: runtime.RawExtension{Object: service}}
+	if options.AllNamespaces {
+		row.Cells = append(row.Cells, service.Namespace)
+	}
+	row.Cells = append(row.Cells, service.Name, service.Generation, service.CreationTimestamp.String())
+	if options.Wide {
+		row.Cells = append(row.Cells, service.Name+"-image")
+	}
+	return []metav1beta1.TableRow{row}, nil
+}
+
+func printSortTestServiceList(list *servingv1.ServiceList, options PrintOptions) ([]metav1beta1.TableRow, error) {
+	var rows []metav1beta1.TableRow
+	for i := range list.Items {
+		r, _ := printSortTestService(&list.Items[i], options)
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func newSortTestServiceList() *servingv1.ServiceList {
+	now := time.Now()
+	newService := func(name string, generation int64, age time.Duration) servingv1.Service {
+		return servingv1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Generation:        generation,
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}}
+	}
+	return &servingv1.ServiceList{Items: []servingv1.Service{
+		newService("bar", 10, time.Hour),
+		newService("foo", 9, time.Minute),
+		newService("baz", 100, 2*time.Hour),
+	}}
+}
+
+func printSortTest(t *testing.T, options PrintOptions) []string {
+	h := NewTablePrinter(options)
+	assert.NilError(t, h.TableHandler(sortColumnDefs, printSortTestServiceList))
+	var out bytes.Buffer
+	assert.NilError(t, h.PrintObj(newSortTestServiceList(), &out))
+	return strings.Split(strings.TrimSpace(out.String()), "\n")
+}
+
+func firstFields(lines []string) []string {
+	var fields []string
+	for _, line := range lines {
+		fields = append(fields, strings.Fields(line)[0])
+	}
+	return fields
+}
+
+func TestPrintSortBy(t *testing.T) {
+	for _, tc := range []struct {
+		sortBy   string
+		expected []string
+	}{
+		{"", []string{"NAME", "bar", "foo", "baz"}},
+		{"name", []string{"NAME", "bar", "baz", "foo"}},
+		{"GENERATION", []string{"NAME", "foo", "bar", "baz"}},
+		{"age", []string{"NAME", "foo", "bar", "baz"}},
+		{".metadata.creationTimestamp", []string{"NAME", "baz", "bar", "foo"}},
+		{"{.metadata.generation}", []string{"NAME", "foo", "bar", "baz"}},
+	} {
+		t.Run(tc.sortBy, func(t *testing.T) {
+			assert.DeepEqual(t, firstFields(printSortTest(t, PrintOptions{SortBy: tc.sortBy})), tc.expected)
+		})
+	}
+}
+
+func TestPrintSortByInvalid(t *testing.T) {
+	h := NewTablePrinter(PrintOptions{SortBy: "unknown"})
+	assert.NilError(t, h.TableHandler(sortColumnDefs, printSortTestServiceList))
+	assert.ErrorContains(t, h.PrintObj(newSortTestServiceList(), &bytes.Buffer{}), "invalid --sort-by 'unknown'")
+}
+
+func TestPrintWide(t *testing.T) {
+	lines := printSortTest(t, PrintOptions{})
+	assert.Equal(t, len(strings.Fields(lines[0])), 3)
+
+	lines = printSortTest(t, PrintOptions{Wide: true})
+	assert.DeepEqual(t, strings.Fields(lines[0]), []string{"NAME", "GENERATION", "AGE", "IMAGE"})
+	assert.Assert(t, strings.HasSuffix(lines[1], "bar-image"))
+}
+
+func TestPrintColumns(t *testing.T) {
+	lines := printSortTest(t, PrintOptions{Columns: []string{"image", "NAMESPACE", "name"}})
+	assert.DeepEqual(t, strings.Fields(lines[0]), []string{"IMAGE", "NAMESPACE", "NAME"})
+	assert.DeepEqual(t, strings.Fields(lines[1]), []string{"bar-image", "default", "bar"})
+
+	h := NewTablePrinter(PrintOptions{Columns: []string{"NAME", "URL"}})
+	assert.NilError(t, h.TableHandler(sortColumnDefs, printSortTestServiceList))
+	err := h.PrintObj(newSortTestServiceList(), &bytes.Buffer{})
+	assert.ErrorContains(t, err, "unknown column 'URL', available columns are: NAMESPACE, NAME, GENERATION, AGE, IMAGE")
+}
+
+func TestSortUnstructured(t *testing.T) {
+	items := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "b"}, "spec": map[string]interface{}{"replicas": int64(10)}}},
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "c"}, "spec": map[string]interface{}{"replicas": int64(2)}}},
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "a"}}},
+	}
+	assert.NilError(t, SortUnstructured(items, "NAME"))
+	assert.DeepEqual(t, []string{items[0].GetName(), items[1].GetName(), items[2].GetName()}, []string{"a", "b", "c"})
+
+	assert.NilError(t, SortUnstructured(items, ".spec.replicas"))
+	assert.DeepEqual(t, []string{items[0].GetName(), items[1].GetName(), items[2].GetName()}, []string{"a", "c", "b"})
+
+	assert.ErrorContains(t, SortUnstructured(items, "READY"), "invalid --sort-by")
+}
@@ -71,29 +71,124 @@ func (h *HumanReadablePrinter) PrintObj(obj runtime.Object, output io.Writer) er
 func printRowsForHandlerEntry(output io.Writer, handler *handlerEntry, obj runtime.Object, options PrintOptions) error {
 	var results []reflect.Value
 
-	args := []reflect.Value{reflect.ValueOf(obj), reflect.ValueOf(options)}
+	// Let the print function fill in all cells if columns are picked explicitly
+	printOptions := options
+	if len(options.Columns) > 0 {
+		printOptions.AllNamespaces = true
+		printOptions.Wide = true
+	}
+	args := []reflect.Value{reflect.ValueOf(obj), reflect.ValueOf(printOptions)}
 	results = handler.printFunc.Call(args)
 	if !results[1].IsNil() {
 		return results[1].Interface().(error)
 	}
 
+	columns := visibleColumns(handler.columnDefinitions, printOptions)
+	rows := results[0].Interface().([]metav1beta1.TableRow)
+	if options.SortBy != "" {
+		if err := sortRows(rows, columns, options.SortBy); err != nil {
+			return err
+		}
+	}
+	if len(options.Columns) > 0 {
+		var err error
+		columns, rows, err = selectColumns(columns, rows, options.Columns)
+		if err != nil {
+			return err
+		}
+	}
+
+	ready := -1
+	if colorEnabled {
+		ready = columnIndex(columns, "Ready")
+		colorReadyCells(rows, ready)
+	}
 	if !options.NoHeaders {
 		var headers []string
-		for _, column := range handler.columnDefinitions {
-			if !options.AllNamespaces && column.Priority == 0 {
-				continue
+		for i, column := range columns {
+			header := strings.ToUpper(column.Name)
+			if i == ready {
+				// Pad the header like the colored cells to keep the column aligned
+				header = Colorize(header, ColorDefault)
 			}
-			headers = append(headers, strings.ToUpper(column.Name))
+			headers = append(headers, header)
 		}
 		printHeader(headers, output)
 	}
+	printRows(output, rows)
+	return nil
+}
 
-	if results[1].IsNil() {
-		rows := results[0].Interface().([]metav1beta1.TableRow)
-		printRows(output, rows)
-		return nil
+// colorReadyCells colors the cells of the READY column by their status
+func colorReadyCells(rows []metav1beta1.TableRow, ready int) {
+	if ready < 0 {
+		return
+	}
+	for r := range rows {
+		if ready < len(rows[r].Cells) {
+			status := fmt.Sprint(rows[r].Cells[ready])
+			rows[r].Cells[ready] = Colorize(status, StatusColor(status))
+		}
+	}
+}
+
+// visibleColumns returns the columns for which the print functions add cells with the given options
+func visibleColumns(columnDefinitions []metav1beta1.TableColumnDefinition, options PrintOptions) []metav1beta1.TableColumnDefinition {
+	columns := make([]metav1beta1.TableColumnDefinition, 0, len(columnDefinitions))
+	for _, column := range columnDefinitions {
+		if !options.AllNamespaces && column.Priority == 0 {
+			continue
+		}
+		if !options.Wide && column.Priority >= WideColumnPriority {
+			continue
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// selectColumns reduces the columns and the cells of all rows to the given column names
+func selectColumns(columns []metav1beta1.TableColumnDefinition, rows []metav1beta1.TableRow, names []string) ([]metav1beta1.TableColumnDefinition, []metav1beta1.TableRow, error) {
+	indexes := make([]int, 0, len(names))
+	selected := make([]metav1beta1.TableColumnDefinition, 0, len(names))
+	for _, name := range names {
+		i := columnIndex(columns, name)
+		if i < 0 {
+			return nil, nil, fmt.Errorf("unknown column '%s', available columns are: %s", name, strings.Join(columnNames(columns), ", "))
+		}
+		indexes = append(indexes, i)
+		selected = append(selected, columns[i])
+	}
+	for r := range rows {
+		cells := make([]interface{}, 0, len(indexes))
+		for _, i := range indexes {
+			if i < len(rows[r].Cells) {
+				cells = append(cells, rows[r].Cells[i])
+			} else {
+				cells = append(cells, "")
+			}
+		}
+		rows[r].Cells = cells
+	}
+	return selected, rows, nil
+}
+
+// columnIndex returns the index of the column with the given name, ignoring case, or -1
+func columnIndex(columns []metav1beta1.TableColumnDefinition, name string) int {
+	for i, column := range columns {
+		if strings.EqualFold(column.Name, strings.TrimSpace(name)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func columnNames(columns []metav1beta1.TableColumnDefinition) []string {
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, strings.ToUpper(column.Name))
 	}
-	return results[1].Interface().(error)
+	return names
 }
 
 func printHeader(columnNames []string, w io.Writer) error {
@@ -0,0 +1,171 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	asconfig "knative.dev/serving/pkg/autoscaler/config"
+	"knative.dev/serving/pkg/autoscaler/config/autoscalerconfig"
+)
+
+// ServingSystemNamespace is the namespace holding the configuration of Knative Serving
+const ServingSystemNamespace = "knative-serving"
+
+// RevisionScale is the autoscaling state of a revision
+type RevisionScale struct {
+	// Number of pods the autoscaler wants and actually has, nil if unknown
+	Desired *int32
+	Actual  *int32
+	// Whether the revision has been scaled to zero as it didn't get traffic
+	ScaledToZero bool
+
+	// Effective scale bounds after merging the revision annotations with the
+	// cluster wide defaults. A Max of 0 means that there is no upper bound.
+	Min int32
+	Max int32
+
+	// Metric and effective target the autoscaler scales on. Target is 0 if the
+	// metric has no default target, e.g. for "cpu" without a target annotation.
+	Metric            string
+	Target            float64
+	TargetUtilization float64
+}
+
+// RevisionScaleLookup looks up the autoscaling state of revisions. The autoscaler
+// configuration and the PodAutoscalers of a namespace are read once and reused for
+// all lookups.
+type RevisionScaleLookup struct {
+	kubeClient    kubernetes.Interface
+	dynamicClient dynamic.Interface
+	config        *autoscalerconfig.Config
+
+	// PodAutoscalers by namespace and name of the revision they are scaling
+	podAutoscalers map[string]map[string]*autoscalingv1alpha1.PodAutoscaler
+}
+
+// NewRevisionScaleLookup creates a lookup reading PodAutoscalers with the dynamic client and
+// the config-autoscaler ConfigMap with the Kubernetes client. If the ConfigMap can't be read,
+// Knative's built-in defaults are used.
+func NewRevisionScaleLookup(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface) *RevisionScaleLookup {
+	return &RevisionScaleLookup{kubeClient: kubeClient, dynamicClient: dynamicClient}
+}
+
+// Lookup returns the autoscaling state of the given revision. If its PodAutoscaler can't be
+// read, the replica counts are taken from the revision's status instead.
+func (l *RevisionScaleLookup) Lookup(ctx context.Context, revision *servingv1.Revision) *RevisionScale {
+	return NewRevisionScale(revision, l.podAutoscaler(ctx, revision), l.autoscalerConfig(ctx))
+}
+
+// podAutoscaler returns the PodAutoscaler of the given revision or nil if there's none. The
+// PodAutoscalers are listed once per namespace instead of fetching them one by one.
+func (l *RevisionScaleLookup) podAutoscaler(ctx context.Context, revision *servingv1.Revision) *autoscalingv1alpha1.PodAutoscaler {
+	if l.dynamicClient == nil {
+		return nil
+	}
+	if l.podAutoscalers == nil {
+		l.podAutoscalers = map[string]map[string]*autoscalingv1alpha1.PodAutoscaler{}
+	}
+	byRevision, ok := l.podAutoscalers[revision.Namespace]
+	if !ok {
+		byRevision = map[string]*autoscalingv1alpha1.PodAutoscaler{}
+		if paList, err := ListPodAutoscalers(ctx, l.dynamicClient, revision.Namespace); err == nil {
+			for i := range paList.Items {
+				pa := &paList.Items[i]
+				revisionName := pa.Labels[serving.RevisionLabelKey]
+				if revisionName == "" {
+					revisionName = pa.Name
+				}
+				byRevision[revisionName] = pa
+			}
+		}
+		l.podAutoscalers[revision.Namespace] = byRevision
+	}
+	return byRevision[revision.Name]
+}
+
+func (l *RevisionScaleLookup) autoscalerConfig(ctx context.Context) *autoscalerconfig.Config {
+	if l.config != nil {
+		return l.config
+	}
+	l.config, _ = asconfig.NewConfigFromMap(nil)
+	if l.kubeClient != nil {
+		cm, err := l.kubeClient.CoreV1().ConfigMaps(ServingSystemNamespace).Get(ctx, asconfig.ConfigName, metav1.GetOptions{})
+		if err == nil {
+			if config, err := asconfig.NewConfigFromConfigMap(cm); err == nil {
+				l.config = config
+			}
+		}
+	}
+	return l.config
+}
+
+// NewRevisionScale calculates the autoscaling state of a revision from its PodAutoscaler, which
+// can be nil, and the autoscaler configuration. Annotations on the revision take precedence
+// over the configured defaults, the same way as the autoscaler itself resolves them.
+func NewRevisionScale(revision *servingv1.Revision, pa *autoscalingv1alpha1.PodAutoscaler, config *autoscalerconfig.Config) *RevisionScale {
+	scale := &RevisionScale{
+		Desired: revision.Status.DesiredReplicas,
+		Actual:  revision.Status.ActualReplicas,
+	}
+
+	// The PodAutoscaler carries the revision's annotations, so use the revision's if there's none
+	effective := &autoscalingv1alpha1.PodAutoscaler{ObjectMeta: metav1.ObjectMeta{Annotations: revision.Annotations}}
+	if pa != nil {
+		effective.Spec.Reachability = pa.Spec.Reachability
+		if pa.Status.DesiredScale != nil {
+			scale.Desired = pa.Status.DesiredScale
+		}
+		if pa.Status.ActualScale != nil {
+			scale.Actual = pa.Status.ActualScale
+		}
+		scale.ScaledToZero = pa.Status.IsInactive()
+	}
+	if scale.Desired != nil && scale.Actual != nil && *scale.Desired == 0 && *scale.Actual == 0 {
+		scale.ScaledToZero = true
+	}
+
+	scale.Min, scale.Max = effective.ScaleBounds(config)
+	if scale.Min == 0 && !config.EnableScaleToZero {
+		scale.Min = 1
+	}
+
+	scale.Metric = effective.Metric()
+	scale.TargetUtilization = config.TargetUtilization
+	if tu, ok := effective.TargetUtilization(); ok {
+		scale.TargetUtilization = tu
+	}
+	if target, ok := effective.Target(); ok {
+		scale.Target = target
+		return scale
+	}
+	switch scale.Metric {
+	case autoscaling.Concurrency:
+		scale.Target = config.ContainerConcurrencyTargetDefault
+		if cc := revision.Spec.ContainerConcurrency; cc != nil && *cc > 0 && float64(*cc) < scale.Target {
+			scale.Target = float64(*cc)
+		}
+	case autoscaling.RPS:
+		scale.Target = config.RPSTargetDefault
+	}
+	return scale
+}
@@ -0,0 +1,136 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestRevisionScaleLookup(t *testing.T) {
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{
+		Name:        "foo-00001",
+		Namespace:   "default",
+		Annotations: map[string]string{"autoscaling.knative.dev/max-scale": "5"},
+	}}
+	revision.Status.DesiredReplicas = ptr.Int32(7)
+	revision.Status.ActualReplicas = ptr.Int32(7)
+
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config-autoscaler", Namespace: ServingSystemNamespace},
+		Data: map[string]string{
+			"min-scale":                            "1",
+			"container-concurrency-target-default": "50",
+		},
+	})
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, autoscalingv1alpha1.AddToScheme(scheme))
+	pa := &autoscalingv1alpha1.PodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling.internal.knative.dev/v1alpha1", Kind: "PodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: "default"},
+	}
+	pa.Status.DesiredScale = ptr.Int32(3)
+	pa.Status.ActualScale = ptr.Int32(2)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, pa)
+
+	scale := NewRevisionScaleLookup(kubeClient, dynamicClient).Lookup(context.Background(), revision)
+	assert.Equal(t, *scale.Desired, int32(3))
+	assert.Equal(t, *scale.Actual, int32(2))
+	assert.Equal(t, scale.ScaledToZero, false)
+	assert.Equal(t, scale.Min, int32(1))
+	assert.Equal(t, scale.Max, int32(5))
+	assert.Equal(t, scale.Metric, "concurrency")
+	assert.Equal(t, scale.Target, 50.0)
+	assert.Equal(t, scale.TargetUtilization, 0.7)
+
+	// Without clients, the status of the revision and Knative's defaults are used
+	scale = NewRevisionScaleLookup(nil, nil).Lookup(context.Background(), revision)
+	assert.Equal(t, *scale.Desired, int32(7))
+	assert.Equal(t, scale.Min, int32(0))
+	assert.Equal(t, scale.Target, 100.0)
+}
+
+func TestRevisionScaleLookupListsOncePerNamespace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, autoscalingv1alpha1.AddToScheme(scheme))
+	newPA := func(name string, desired int32) *autoscalingv1alpha1.PodAutoscaler {
+		pa := &autoscalingv1alpha1.PodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling.internal.knative.dev/v1alpha1", Kind: "PodAutoscaler"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		}
+		pa.Status.DesiredScale = ptr.Int32(desired)
+		return pa
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, newPA("foo-00001", 1), newPA("foo-00002", 2))
+
+	lookup := NewRevisionScaleLookup(nil, dynamicClient)
+	for _, tc := range []struct {
+		revision string
+		desired  int32
+	}{{"foo-00001", 1}, {"foo-00002", 2}, {"foo-00003", 0}} {
+		revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: tc.revision, Namespace: "default"}}
+		revision.Status.DesiredReplicas = ptr.Int32(0)
+		assert.Equal(t, *lookup.Lookup(context.Background(), revision).Desired, tc.desired)
+	}
+
+	actions := dynamicClient.Actions()
+	assert.Equal(t, len(actions), 1)
+	assert.Equal(t, actions[0].GetVerb(), "list")
+}
+
+func TestNewRevisionScale(t *testing.T) {
+	lookup := NewRevisionScaleLookup(nil, nil)
+	config := lookup.autoscalerConfig(context.Background())
+
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		"autoscaling.knative.dev/metric":                        "rps",
+		"autoscaling.knative.dev/target-utilization-percentage": "80",
+	}}}
+	pa := &autoscalingv1alpha1.PodAutoscaler{}
+	pa.Status.DesiredScale = ptr.Int32(0)
+	pa.Status.ActualScale = ptr.Int32(0)
+	pa.Status.MarkInactive("NoTraffic", "The target is not receiving traffic.")
+	scale := NewRevisionScale(revision, pa, config)
+	assert.Equal(t, scale.ScaledToZero, true)
+	assert.Equal(t, scale.Metric, "rps")
+	assert.Equal(t, scale.Target, 200.0)
+	assert.Equal(t, scale.TargetUtilization, 0.8)
+
+	// A container concurrency below the default target caps the target
+	revision = &servingv1.Revision{Spec: servingv1.RevisionSpec{ContainerConcurrency: ptr.Int64(10)}}
+	scale = NewRevisionScale(revision, nil, config)
+	assert.Equal(t, scale.Target, 10.0)
+
+	// No default target for cpu based scaling
+	revision = &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		"autoscaling.knative.dev/class":  "hpa.autoscaling.knative.dev",
+		"autoscaling.knative.dev/metric": "cpu",
+	}}}
+	config.EnableScaleToZero = false
+	scale = NewRevisionScale(revision, nil, config)
+	assert.Equal(t, scale.Target, 0.0)
+	assert.Equal(t, scale.Min, int32(1))
+}
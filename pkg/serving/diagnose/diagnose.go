@@ -0,0 +1,365 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	apiserving "knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// Severity ranks how likely a finding is the root cause of a service not becoming ready
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityLow
+	SeverityHigh
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityCritical:
+		return "critical"
+	case SeverityHigh:
+		return "high"
+	case SeverityLow:
+		return "low"
+	default:
+		return "info"
+	}
+}
+
+// Finding is a single likely cause for a service not becoming ready
+type Finding struct {
+	Severity Severity
+	// Resource is the kind and name of the object the finding is about, e.g. "Pod foo-00001-deployment-abc"
+	Resource string
+	Cause    string
+	Hint     string
+}
+
+// Diagnoser inspects a service and the resources created for it by Knative Serving
+// and Kubernetes to find out why it is not ready
+type Diagnoser struct {
+	serving clientservingv1.KnServingClient
+	kube    kubernetes.Interface
+	dynamic dynamic.Interface
+}
+
+// NewDiagnoser creates a diagnoser using the given clients. The kube and dynamic
+// client are optional, the corresponding checks are skipped if they are nil.
+func NewDiagnoser(servingClient clientservingv1.KnServingClient, kubeClient kubernetes.Interface, dynamicClient dynamic.Interface) *Diagnoser {
+	return &Diagnoser{
+		serving: servingClient,
+		kube:    kubeClient,
+		dynamic: dynamicClient,
+	}
+}
+
+// Diagnose returns the findings for the service with the given name, ranked by severity
+func (d *Diagnoser) Diagnose(ctx context.Context, name string) ([]Finding, error) {
+	service, err := d.serving.GetService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	findings = append(findings, conditionFindings("Service "+name, service.Status.Conditions, SeverityInfo)...)
+
+	revisionName := service.Status.LatestCreatedRevisionName
+	if revisionName == "" {
+		findings = append(findings, Finding{
+			Severity: SeverityHigh,
+			Resource: "Service " + name,
+			Cause:    "no revision has been created yet",
+			Hint:     "Check the service's 'ConfigurationsReady' condition and the Configuration of the same name for validation errors",
+		})
+	} else {
+		findings = append(findings, d.revisionFindings(ctx, revisionName)...)
+	}
+	findings = append(findings, d.routeFindings(ctx, name)...)
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+	return findings, nil
+}
+
+// DiagnoseError appends the diagnosis for the given service to err. If the
+// diagnosis itself fails, the original error is returned unchanged.
+func (d *Diagnoser) DiagnoseError(ctx context.Context, name string, err error) error {
+	findings, diagErr := d.Diagnose(ctx, name)
+	if diagErr != nil || len(findings) == 0 {
+		return err
+	}
+	buf := &strings.Builder{}
+	WriteFindings(buf, findings)
+	return fmt.Errorf("%w\n\nDiagnosis:\n%s", err, strings.TrimRight(buf.String(), "\n"))
+}
+
+// WriteFindings prints the given findings as a ranked list
+func WriteFindings(out io.Writer, findings []Finding) {
+	for i, f := range findings {
+		fmt.Fprintf(out, "%2d. [%s] %s: %s\n", i+1, f.Severity, f.Resource, f.Cause)
+		if f.Hint != "" {
+			fmt.Fprintf(out, "    Hint: %s\n", f.Hint)
+		}
+	}
+}
+
+// ===================================================================================
+
+func (d *Diagnoser) revisionFindings(ctx context.Context, revisionName string) []Finding {
+	resource := "Revision " + revisionName
+	revision, err := d.serving.GetRevision(ctx, revisionName)
+	if err != nil {
+		return []Finding{inaccessible(resource, err)}
+	}
+	findings := conditionFindings(resource, revision.Status.Conditions, SeverityHigh)
+	if d.kube != nil {
+		findings = append(findings, d.workloadFindings(ctx, revision)...)
+	}
+	if d.dynamic != nil {
+		findings = append(findings, d.autoscalerFindings(ctx, revisionName)...)
+	}
+	return findings
+}
+
+func (d *Diagnoser) workloadFindings(ctx context.Context, revision *servingv1.Revision) []Finding {
+	namespace := d.serving.Namespace()
+	selector := metav1.ListOptions{
+		LabelSelector: labels.Set{apiserving.RevisionLabelKey: revision.Name}.String(),
+	}
+	var findings []Finding
+
+	deployments, err := d.kube.AppsV1().Deployments(namespace).List(ctx, selector)
+	if err != nil {
+		findings = append(findings, inaccessible("Deployments of revision "+revision.Name, err))
+	} else {
+		for _, deployment := range deployments.Items {
+			findings = append(findings, deploymentFindings(&deployment)...)
+		}
+	}
+
+	replicaSets, err := d.kube.AppsV1().ReplicaSets(namespace).List(ctx, selector)
+	if err != nil {
+		findings = append(findings, inaccessible("ReplicaSets of revision "+revision.Name, err))
+	} else {
+		for _, rs := range replicaSets.Items {
+			findings = append(findings, replicaSetFindings(&rs)...)
+		}
+	}
+
+	pods, err := d.kube.CoreV1().Pods(namespace).List(ctx, selector)
+	if err != nil {
+		findings = append(findings, inaccessible("Pods of revision "+revision.Name, err))
+	} else {
+		for _, pod := range pods.Items {
+			findings = append(findings, podFindings(&pod)...)
+		}
+	}
+	return findings
+}
+
+func deploymentFindings(deployment *appsv1.Deployment) []Finding {
+	resource := "Deployment " + deployment.Name
+	var findings []Finding
+	for _, cond := range deployment.Status.Conditions {
+		switch {
+		case cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse:
+			findings = append(findings, Finding{
+				Severity: SeverityHigh,
+				Resource: resource,
+				Cause:    fmt.Sprintf("deployment is not progressing (%s): %s", cond.Reason, cond.Message),
+				Hint:     "The pods did not become ready within the progress deadline, see the pod findings for details",
+			})
+		case cond.Type == appsv1.DeploymentReplicaFailure && cond.Status == corev1.ConditionTrue:
+			findings = append(findings, Finding{
+				Severity: SeverityHigh,
+				Resource: resource,
+				Cause:    fmt.Sprintf("pods cannot be created (%s): %s", cond.Reason, cond.Message),
+				Hint:     "Check resource quotas and limit ranges in the namespace",
+			})
+		}
+	}
+	return findings
+}
+
+func replicaSetFindings(rs *appsv1.ReplicaSet) []Finding {
+	var findings []Finding
+	for _, cond := range rs.Status.Conditions {
+		if cond.Type == appsv1.ReplicaSetReplicaFailure && cond.Status == corev1.ConditionTrue {
+			findings = append(findings, Finding{
+				Severity: SeverityCritical,
+				Resource: "ReplicaSet " + rs.Name,
+				Cause:    fmt.Sprintf("pods cannot be created (%s): %s", cond.Reason, cond.Message),
+				Hint:     "Check resource quotas, limit ranges and pod security admission for the namespace",
+			})
+		}
+	}
+	return findings
+}
+
+func podFindings(pod *corev1.Pod) []Finding {
+	resource := "Pod " + pod.Name
+	var findings []Finding
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse {
+			findings = append(findings, Finding{
+				Severity: SeverityCritical,
+				Resource: resource,
+				Cause:    fmt.Sprintf("pod cannot be scheduled (%s): %s", cond.Reason, cond.Message),
+				Hint:     "Lower the resource requests or check node selectors, affinities and tolerations",
+			})
+		}
+	}
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		findings = append(findings, containerFindings(resource, status)...)
+	}
+	return findings
+}
+
+func containerFindings(resource string, status corev1.ContainerStatus) []Finding {
+	var findings []Finding
+	container := fmt.Sprintf("container '%s'", status.Name)
+	if waiting := status.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case "ImagePullBackOff", "ErrImagePull", "InvalidImageName":
+			findings = append(findings, Finding{
+				Severity: SeverityCritical,
+				Resource: resource,
+				Cause:    fmt.Sprintf("%s cannot pull image (%s): %s", container, waiting.Reason, waiting.Message),
+				Hint:     "Verify the image name and tag and that the image pull secrets grant access to the registry",
+			})
+		case "CrashLoopBackOff":
+			findings = append(findings, Finding{
+				Severity: SeverityCritical,
+				Resource: resource,
+				Cause:    fmt.Sprintf("%s is crashing repeatedly (%s, %d restarts)", container, waiting.Reason, status.RestartCount),
+				Hint:     "Inspect the logs of the previous container run, e.g. with 'kubectl logs --previous'",
+			})
+		case "CreateContainerConfigError", "CreateContainerError":
+			findings = append(findings, Finding{
+				Severity: SeverityCritical,
+				Resource: resource,
+				Cause:    fmt.Sprintf("%s cannot be created (%s): %s", container, waiting.Reason, waiting.Message),
+				Hint:     "Check that referenced config maps, secrets and keys exist",
+			})
+		}
+	}
+	if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+		findings = append(findings, Finding{
+			Severity: SeverityCritical,
+			Resource: resource,
+			Cause:    fmt.Sprintf("%s has been killed because it ran out of memory (OOMKilled)", container),
+			Hint:     "Increase the memory limit with --limit memory=... or reduce the memory usage of the application",
+		})
+	}
+	if status.State.Running != nil && !status.Ready {
+		findings = append(findings, Finding{
+			Severity: SeverityHigh,
+			Resource: resource,
+			Cause:    fmt.Sprintf("%s is running but not ready", container),
+			Hint:     "Check that the application listens on the configured port and that the readiness probe succeeds",
+		})
+	}
+	return findings
+}
+
+func (d *Diagnoser) autoscalerFindings(ctx context.Context, revisionName string) []Finding {
+	resource := "PodAutoscaler " + revisionName
+	pa, err := serving.GetPodAutoscaler(ctx, d.dynamic, d.serving.Namespace(), revisionName)
+	if err != nil {
+		return []Finding{inaccessible(resource, err)}
+	}
+	return conditionFindings(resource, pa.Status.Conditions, SeverityLow)
+}
+
+func (d *Diagnoser) routeFindings(ctx context.Context, name string) []Finding {
+	resource := "Route " + name
+	route, err := d.serving.GetRoute(ctx, name)
+	if err != nil {
+		return []Finding{inaccessible(resource, err)}
+	}
+	findings := conditionFindings(resource, route.Status.Conditions, SeverityLow)
+	if d.dynamic != nil {
+		ingress, err := serving.GetIngress(ctx, d.dynamic, d.serving.Namespace(), name)
+		if err != nil {
+			findings = append(findings, inaccessible("Ingress "+name, err))
+		} else {
+			for _, f := range conditionFindings("Ingress "+name, ingress.Status.Conditions, SeverityLow) {
+				f.Hint = "Check the status of the networking layer (e.g. Kourier or Istio) and its load balancer"
+				findings = append(findings, f)
+			}
+		}
+	}
+	return findings
+}
+
+// conditionFindings creates a finding for every condition which is not true.
+// Conditions which are unknown are ranked one level lower than false ones.
+func conditionFindings(resource string, conditions duckv1.Conditions, severity Severity) []Finding {
+	var findings []Finding
+	for _, cond := range conditions {
+		if cond.Status == corev1.ConditionTrue || cond.Severity != apis.ConditionSeverityError {
+			continue
+		}
+		s := severity
+		if cond.Status == corev1.ConditionUnknown && s > SeverityInfo {
+			s--
+		}
+		cause := fmt.Sprintf("condition %s is %s", cond.Type, cond.Status)
+		if cond.Reason != "" {
+			cause += fmt.Sprintf(" (%s)", cond.Reason)
+		}
+		if cond.Message != "" {
+			cause += ": " + cond.Message
+		}
+		findings = append(findings, Finding{Severity: s, Resource: resource, Cause: cause})
+	}
+	return findings
+}
+
+func inaccessible(resource string, err error) Finding {
+	hint := ""
+	if apierrors.IsForbidden(err) {
+		hint = "Your account is not allowed to read this resource, ask your cluster administrator for a more detailed analysis"
+	}
+	return Finding{
+		Severity: SeverityInfo,
+		Resource: resource,
+		Cause:    fmt.Sprintf("cannot be inspected: %v", err),
+		Hint:     hint,
+	}
+}
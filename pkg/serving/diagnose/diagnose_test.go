@@ -0,0 +1,159 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestDiagnoseImagePullAndOOM(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", newService("foo", "foo-00001"), nil)
+	r.GetRevision("foo-00001", newRevision("foo-00001"), nil)
+	r.GetRoute("foo", &servingv1.Route{}, nil)
+
+	labels := map[string]string{"serving.knative.dev/revision": "foo-00001"}
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-00001-deployment-abc", Namespace: "default", Labels: labels},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  "user-container",
+						State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+					},
+					{
+						Name:                 "sidecar",
+						State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+						Ready:                true,
+					},
+				},
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-00001-deployment", Namespace: "default", Labels: labels},
+			Status: appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: "timed out"},
+				},
+			},
+		},
+	)
+
+	findings, err := NewDiagnoser(client, kubeClient, newDynamicClient(t)).Diagnose(context.Background(), "foo")
+	assert.NilError(t, err)
+
+	assert.Assert(t, len(findings) >= 4)
+	assert.Equal(t, findings[0].Severity, SeverityCritical)
+	assert.Assert(t, util.ContainsAll(findings[0].Cause, "user-container", "ImagePullBackOff"))
+	assert.Assert(t, util.ContainsAll(findings[1].Cause, "sidecar", "OOMKilled"))
+	assert.Equal(t, findings[2].Severity, SeverityHigh)
+	for i := 1; i < len(findings); i++ {
+		assert.Assert(t, findings[i-1].Severity >= findings[i].Severity, "findings not ranked: %v", findings)
+	}
+
+	out := &strings.Builder{}
+	WriteFindings(out, findings)
+	assert.Assert(t, util.ContainsAll(out.String(), " 1. [critical] Pod foo-00001-deployment-abc", "Hint:", "Deployment foo-00001-deployment", "PodAutoscaler foo-00001"))
+	client.Recorder().Validate()
+}
+
+func TestDiagnoseNoRevision(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", newService("foo", ""), nil)
+	r.GetRoute("foo", nil, apierrors.NewForbidden(schema.GroupResource{Resource: "routes"}, "foo", errors.New("denied")))
+
+	findings, err := NewDiagnoser(client, nil, nil).Diagnose(context.Background(), "foo")
+	assert.NilError(t, err)
+	assert.Equal(t, findings[0].Cause, "no revision has been created yet")
+	last := findings[len(findings)-1]
+	assert.Equal(t, last.Resource, "Route foo")
+	assert.Assert(t, util.ContainsAll(last.Hint, "not allowed"))
+	client.Recorder().Validate()
+}
+
+func TestDiagnoseError(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", newService("foo", ""), nil)
+	r.GetRoute("foo", &servingv1.Route{}, nil)
+	r.GetService("bar", nil, errors.New("not found"))
+
+	timeoutErr := fmt.Errorf("%w: service 'foo' not ready after 60 seconds", wait.ErrTimeout)
+	d := NewDiagnoser(client, nil, nil)
+	err := d.DiagnoseError(context.Background(), "foo", timeoutErr)
+	assert.Assert(t, errors.Is(err, wait.ErrTimeout))
+	assert.Assert(t, util.ContainsAll(err.Error(), "Diagnosis:", "no revision has been created yet"))
+
+	// Errors during diagnosis leave the original error untouched
+	assert.Equal(t, d.DiagnoseError(context.Background(), "bar", timeoutErr), timeoutErr)
+	client.Recorder().Validate()
+}
+
+func newService(name string, latestCreated string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	service.Status.LatestCreatedRevisionName = latestCreated
+	service.Status.Conditions = duckv1.Conditions{
+		{Type: apis.ConditionReady, Status: corev1.ConditionFalse, Reason: "RevisionMissing", Message: "Configuration does not have any ready Revision."},
+	}
+	return service
+}
+
+func newRevision(name string) *servingv1.Revision {
+	revision := &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	revision.Status.Conditions = duckv1.Conditions{
+		{Type: servingv1.RevisionConditionReady, Status: corev1.ConditionUnknown, Reason: "Deploying"},
+		{Type: servingv1.RevisionConditionResourcesAvailable, Status: corev1.ConditionTrue},
+	}
+	return revision
+}
+
+func newDynamicClient(t *testing.T) *dynamicfake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, autoscalingv1alpha1.AddToScheme(scheme))
+	pa := &autoscalingv1alpha1.PodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling.internal.knative.dev/v1alpha1", Kind: "PodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: "default"},
+	}
+	pa.Status.Conditions = duckv1.Conditions{
+		{Type: autoscalingv1alpha1.PodAutoscalerConditionReady, Status: corev1.ConditionFalse, Reason: "NoTraffic"},
+	}
+	return dynamicfake.NewSimpleDynamicClient(scheme, pa)
+}
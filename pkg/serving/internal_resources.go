@@ -0,0 +1,97 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	networkingv1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+)
+
+// GVRs of the internal resources Knative Serving creates for a service.
+// These are not part of the public API, so they are accessed via the dynamic client.
+var (
+	PodAutoscalerGVR = schema.GroupVersionResource{
+		Group:    "autoscaling.internal.knative.dev",
+		Version:  "v1alpha1",
+		Resource: "podautoscalers",
+	}
+	ServerlessServiceGVR = schema.GroupVersionResource{
+		Group:    "networking.internal.knative.dev",
+		Version:  "v1alpha1",
+		Resource: "serverlessservices",
+	}
+	IngressGVR = schema.GroupVersionResource{
+		Group:    "networking.internal.knative.dev",
+		Version:  "v1alpha1",
+		Resource: "ingresses",
+	}
+	CertificateGVR = schema.GroupVersionResource{
+		Group:    "networking.internal.knative.dev",
+		Version:  "v1alpha1",
+		Resource: "certificates",
+	}
+)
+
+// GetPodAutoscaler returns the PodAutoscaler with the given name, which is the
+// name of the revision it is scaling
+func GetPodAutoscaler(ctx context.Context, client dynamic.Interface, namespace string, name string) (*autoscalingv1alpha1.PodAutoscaler, error) {
+	obj, err := client.Resource(PodAutoscalerGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pa := &autoscalingv1alpha1.PodAutoscaler{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pa)
+	if err != nil {
+		return nil, err
+	}
+	return pa, nil
+}
+
+// ListPodAutoscalers returns the PodAutoscalers in the given namespace
+func ListPodAutoscalers(ctx context.Context, client dynamic.Interface, namespace string) (*autoscalingv1alpha1.PodAutoscalerList, error) {
+	list, err := client.Resource(PodAutoscalerGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	paList := &autoscalingv1alpha1.PodAutoscalerList{Items: make([]autoscalingv1alpha1.PodAutoscaler, len(list.Items))}
+	for i := range list.Items {
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].UnstructuredContent(), &paList.Items[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paList, nil
+}
+
+// GetIngress returns the Ingress with the given name, which is the name of
+// the route it is exposing
+func GetIngress(ctx context.Context, client dynamic.Interface, namespace string, name string) (*networkingv1alpha1.Ingress, error) {
+	obj, err := client.Resource(IngressGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ingress := &networkingv1alpha1.Ingress{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), ingress)
+	if err != nil {
+		return nil, err
+	}
+	return ingress, nil
+}
@@ -0,0 +1,218 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// OwnedResource is a node in the ownership tree of a service
+type OwnedResource struct {
+	Kind    string
+	Name    string
+	Ready   string
+	Reason  string
+	Created time.Time
+	// Owned resources, sorted by kind and name
+	Children []*OwnedResource
+
+	uid types.UID
+}
+
+// ownedResourceType describes a kind of resource which is part of a service's ownership tree
+type ownedResourceType struct {
+	kind string
+	gvr  schema.GroupVersionResource
+	// label selecting the resources belonging to a service, the value is the service name
+	labelKey string
+}
+
+// ownedResourceTypes lists the resource types which are looked up, in the order
+// in which they appear below their owner
+var ownedResourceTypes = []ownedResourceType{
+	{"Configuration", servingv1.SchemeGroupVersion.WithResource("configurations"), serving.ServiceLabelKey},
+	{"Route", servingv1.SchemeGroupVersion.WithResource("routes"), serving.ServiceLabelKey},
+	{"Revision", servingv1.SchemeGroupVersion.WithResource("revisions"), serving.ServiceLabelKey},
+	{"Deployment", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, serving.ServiceLabelKey},
+	{"PodAutoscaler", PodAutoscalerGVR, serving.ServiceLabelKey},
+	{"ServerlessService", ServerlessServiceGVR, serving.ServiceLabelKey},
+	{"Ingress", IngressGVR, serving.RouteLabelKey},
+	{"Certificate", CertificateGVR, serving.RouteLabelKey},
+	{"ReplicaSet", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}, serving.ServiceLabelKey},
+	{"Pod", schema.GroupVersionResource{Version: "v1", Resource: "pods"}, serving.ServiceLabelKey},
+}
+
+// BuildOwnerTree discovers the resources owned directly or indirectly by the given
+// service by following their owner references. ReplicaSets are left out of the tree,
+// the pods they own are shown below their deployment instead. Resource types which are
+// not installed in the cluster or which can't be listed are skipped.
+func BuildOwnerTree(ctx context.Context, client dynamic.Interface, namespace string, service *servingv1.Service) (*OwnedResource, error) {
+	root := &OwnedResource{
+		Kind:    "Service",
+		Name:    service.Name,
+		Created: service.CreationTimestamp.Time,
+		uid:     service.UID,
+	}
+	if c := service.Status.GetCondition(servingv1.ServiceConditionReady); c != nil {
+		root.Ready, root.Reason = string(c.Status), c.Reason
+	}
+
+	nodes := map[types.UID]*OwnedResource{service.UID: root}
+	var owned []*unstructured.Unstructured
+	for _, t := range ownedResourceTypes {
+		selector := labels.Set{t.labelKey: service.Name}.String()
+		list, err := client.Resource(t.gvr).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			nodes[obj.GetUID()] = newOwnedResource(obj)
+			owned = append(owned, obj)
+		}
+	}
+
+	// Link each resource to its controlling owner, replica sets are replaced by their owner
+	for _, obj := range owned {
+		owner := controllerOf(obj, nodes)
+		if owner == nil || obj.GetKind() == "ReplicaSet" {
+			continue
+		}
+		if owner.Kind == "ReplicaSet" {
+			if rs := findObject(owned, owner.uid); rs != nil {
+				owner = controllerOf(rs, nodes)
+			}
+			if owner == nil {
+				continue
+			}
+		}
+		owner.Children = append(owner.Children, nodes[obj.GetUID()])
+	}
+	sortOwnedResources(root)
+	return root, nil
+}
+
+// Walk calls fn for the given resource and all resources it owns, depth first.
+// depth is 0 for the resource itself and last tells whether a resource is the last child of its owner.
+func (r *OwnedResource) Walk(fn func(resource *OwnedResource, depth int, last []bool)) {
+	r.walk(fn, nil)
+}
+
+func (r *OwnedResource) walk(fn func(resource *OwnedResource, depth int, last []bool), last []bool) {
+	fn(r, len(last), last)
+	for i, child := range r.Children {
+		child.walk(fn, append(append([]bool{}, last...), i == len(r.Children)-1))
+	}
+}
+
+func newOwnedResource(obj *unstructured.Unstructured) *OwnedResource {
+	resource := &OwnedResource{
+		Kind:    obj.GetKind(),
+		Name:    obj.GetName(),
+		Created: obj.GetCreationTimestamp().Time,
+		uid:     obj.GetUID(),
+	}
+	resource.Ready, resource.Reason = readiness(obj)
+	return resource
+}
+
+// readiness returns status and reason of the "Ready" condition, or of the
+// "Available" condition for resources like deployments which don't have one
+func readiness(obj *unstructured.Unstructured) (string, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	var status, reason string
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch condition["type"] {
+		case "Ready":
+			s, _ := condition["status"].(string)
+			r, _ := condition["reason"].(string)
+			return s, r
+		case "Available":
+			status, _ = condition["status"].(string)
+			reason, _ = condition["reason"].(string)
+		}
+	}
+	return status, reason
+}
+
+// controllerOf returns the known controlling owner of the given object, or any
+// known owner if none of its owner references is marked as controller
+func controllerOf(obj *unstructured.Unstructured, nodes map[types.UID]*OwnedResource) *OwnedResource {
+	var candidate *OwnedResource
+	for _, ref := range obj.GetOwnerReferences() {
+		node, ok := nodes[ref.UID]
+		if !ok {
+			continue
+		}
+		if ref.Controller != nil && *ref.Controller {
+			return node
+		}
+		if candidate == nil {
+			candidate = node
+		}
+	}
+	return candidate
+}
+
+func findObject(objects []*unstructured.Unstructured, uid types.UID) *unstructured.Unstructured {
+	for _, obj := range objects {
+		if obj.GetUID() == uid {
+			return obj
+		}
+	}
+	return nil
+}
+
+// kindOrder returns the position of a kind below its owner, following ownedResourceTypes
+func kindOrder(kind string) int {
+	for i, t := range ownedResourceTypes {
+		if t.kind == kind {
+			return i
+		}
+	}
+	return len(ownedResourceTypes)
+}
+
+func sortOwnedResources(r *OwnedResource) {
+	sort.SliceStable(r.Children, func(i, j int) bool {
+		ki, kj := kindOrder(r.Children[i].Kind), kindOrder(r.Children[j].Kind)
+		if ki != kj {
+			return ki < kj
+		}
+		return r.Children[i].Name < r.Children[j].Name
+	})
+	for _, child := range r.Children {
+		sortOwnedResources(child)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestBuildOwnerTree(t *testing.T) {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", UID: "svc"}}
+	client := newOwnerTreeFakeClient(
+		newOwnedObject("serving.knative.dev/v1", "Route", "foo", "route", "svc", "True"),
+		newOwnedObject("serving.knative.dev/v1", "Configuration", "foo", "config", "svc", "True"),
+		newOwnedObject("serving.knative.dev/v1", "Revision", "foo-00002", "rev2", "config", "False"),
+		newOwnedObject("serving.knative.dev/v1", "Revision", "foo-00001", "rev1", "config", "True"),
+		newOwnedObject("apps/v1", "Deployment", "foo-00001-deployment", "deploy", "rev1", ""),
+		newOwnedObject("apps/v1", "ReplicaSet", "foo-00001-deployment-abc", "rs", "deploy", ""),
+		newOwnedObject("v1", "Pod", "foo-00001-deployment-abc-xyz", "pod", "rs", "True"),
+		newOwnedObject("autoscaling.internal.knative.dev/v1alpha1", "PodAutoscaler", "foo-00001", "pa", "rev1", "True"),
+		newOwnedObject("networking.internal.knative.dev/v1alpha1", "ServerlessService", "foo-00001", "sks", "pa", "True"),
+		newOwnedObject("networking.internal.knative.dev/v1alpha1", "Ingress", "foo", "ingress", "route", "True"),
+		// Not part of the tree as owned by another service
+		newOwnedObject("serving.knative.dev/v1", "Revision", "bar-00001", "other", "bar", "True"),
+	)
+
+	root, err := BuildOwnerTree(context.Background(), client, "default", service)
+	assert.NilError(t, err)
+
+	var names []string
+	var depths []int
+	root.Walk(func(resource *OwnedResource, depth int, last []bool) {
+		names = append(names, resource.Kind+"/"+resource.Name)
+		depths = append(depths, depth)
+	})
+	assert.DeepEqual(t, names, []string{
+		"Service/foo",
+		"Configuration/foo",
+		"Revision/foo-00001",
+		"Deployment/foo-00001-deployment",
+		"Pod/foo-00001-deployment-abc-xyz",
+		"PodAutoscaler/foo-00001",
+		"ServerlessService/foo-00001",
+		"Revision/foo-00002",
+		"Route/foo",
+		"Ingress/foo",
+	})
+	assert.DeepEqual(t, depths, []int{0, 1, 2, 3, 4, 3, 4, 2, 1, 2})
+	assert.Equal(t, root.Children[0].Children[1].Ready, "False")
+	assert.Equal(t, root.Children[0].Children[0].Children[0].Children[0].Ready, "True")
+}
+
+// newOwnerTreeFakeClient returns a fake dynamic client which can list all resource types of an owner tree
+func newOwnerTreeFakeClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, t := range ownedResourceTypes {
+		listKinds[t.gvr] = t.kind + "List"
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+// newOwnedObject returns an object labeled as belonging to service 'foo' and owned by the object with the given uid
+func newOwnedObject(apiVersion string, kind string, name string, uid string, ownerUID string, ready string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	obj.SetUID(types.UID(uid))
+	service := "foo"
+	if ownerUID == "bar" {
+		service = "bar"
+	}
+	obj.SetLabels(map[string]string{serving.ServiceLabelKey: service, serving.RouteLabelKey: service})
+	controller := true
+	obj.SetOwnerReferences([]metav1.OwnerReference{{UID: types.UID(ownerUID), Controller: &controller}})
+	if ready != "" {
+		obj.Object["status"] = map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": ready},
+			},
+		}
+	}
+	return obj
+}
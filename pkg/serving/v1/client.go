@@ -51,6 +51,9 @@ type ServiceUpdateFunc func(origService *servingv1.Service) (*servingv1.Service,
 type WaitConfig struct {
 	Timeout     time.Duration
 	ErrorWindow time.Duration
+
+	// ConditionCallback is called instead of the message callback, if set
+	ConditionCallback wait.ConditionCallback
 }
 
 // Kn interface to serving. All methods are relative to the
@@ -138,8 +141,8 @@ type ListConfig func(config *listConfigCollector)
 
 type ListConfigs []ListConfig
 
-// add selectors to a list options
-func (opts ListConfigs) toListOptions() v1.ListOptions {
+// ToListOptions adds the selectors of all configs to list options
+func (opts ListConfigs) ToListOptions() v1.ListOptions {
 	listConfig := listConfigCollector{labels.Set{}, fields.Set{}}
 	for _, f := range opts {
 		f(&listConfig)
@@ -154,6 +157,14 @@ func (opts ListConfigs) toListOptions() v1.ListOptions {
 	return options
 }
 
+// listOptions returns the list options of the given configs, restricted further by the list
+// options the context carries
+func listOptions(ctx context.Context, configs []ListConfig) v1.ListOptions {
+	options := ListConfigs(configs).ToListOptions()
+	util.ApplyListOptions(ctx, &options)
+	return options
+}
+
 // Filter list on the provided name
 func WithName(name string) ListConfig {
 	return func(lo *listConfigCollector) {
@@ -216,7 +227,7 @@ func (cl *knServingClient) WatchRevisionWithVersion(ctx context.Context, name st
 
 // List services
 func (cl *knServingClient) ListServices(ctx context.Context, config ...ListConfig) (*servingv1.ServiceList, error) {
-	serviceList, err := cl.client.Services(cl.namespace).List(ctx, ListConfigs(config).toListOptions())
+	serviceList, err := cl.client.Services(cl.namespace).List(ctx, listOptions(ctx, config))
 	if err != nil {
 		return nil, clienterrors.GetError(err)
 	}
@@ -362,7 +373,7 @@ func (cl *knServingClient) WaitForService(ctx context.Context, name string, wcon
 	service, err := cl.GetService(ctx, name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return waitForReady.Wait(ctx, name, "", wait.Options{Timeout: &wconfig.Timeout, ErrorWindow: &wconfig.ErrorWindow}, msgCallback)
+			return waitForReady.Wait(ctx, name, "", wait.Options{Timeout: &wconfig.Timeout, ErrorWindow: &wconfig.ErrorWindow, ConditionCallback: wconfig.ConditionCallback}, msgCallback)
 		}
 		return err, 0
 	}
@@ -370,7 +381,7 @@ func (cl *knServingClient) WaitForService(ctx context.Context, name string, wcon
 	if service.IsReady() {
 		return nil, 0
 	}
-	return waitForReady.Wait(ctx, name, service.ResourceVersion, wait.Options{Timeout: &wconfig.Timeout, ErrorWindow: &wconfig.ErrorWindow}, msgCallback)
+	return waitForReady.Wait(ctx, name, service.ResourceVersion, wait.Options{Timeout: &wconfig.Timeout, ErrorWindow: &wconfig.ErrorWindow, ConditionCallback: wconfig.ConditionCallback}, msgCallback)
 }
 
 // Get the configuration for a service
@@ -500,7 +511,7 @@ func (cl *knServingClient) deleteRevision(ctx context.Context, name string) erro
 
 // List revisions
 func (cl *knServingClient) ListRevisions(ctx context.Context, config ...ListConfig) (*servingv1.RevisionList, error) {
-	revisionList, err := cl.client.Revisions(cl.namespace).List(ctx, ListConfigs(config).toListOptions())
+	revisionList, err := cl.client.Revisions(cl.namespace).List(ctx, listOptions(ctx, config))
 	if err != nil {
 		return nil, clienterrors.GetError(err)
 	}
@@ -522,7 +533,7 @@ func (cl *knServingClient) GetRoute(ctx context.Context, name string) (*servingv
 
 // List routes
 func (cl *knServingClient) ListRoutes(ctx context.Context, config ...ListConfig) (*servingv1.RouteList, error) {
-	routeList, err := cl.client.Routes(cl.namespace).List(ctx, ListConfigs(config).toListOptions())
+	routeList, err := cl.client.Routes(cl.namespace).List(ctx, listOptions(ctx, config))
 	if err != nil {
 		return nil, err
 	}
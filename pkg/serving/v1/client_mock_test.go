@@ -64,8 +64,8 @@ func TestMockKnClient(t *testing.T) {
 	client.ApplyService(ctx, &servingv1.Service{})
 	client.DeleteService(ctx, "hello", time.Duration(10)*time.Second)
 	client.WaitForService(ctx, "hello", WaitConfig{
-		time.Duration(10) * time.Second,
-		time.Duration(2) * time.Second,
+		Timeout:     time.Duration(10) * time.Second,
+		ErrorWindow: time.Duration(2) * time.Second,
 	}, wait.NoopMessageCallback())
 	client.GetRevision(ctx, "hello")
 	client.ListRevisions(ctx, WithName("blub"))
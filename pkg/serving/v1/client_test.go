@@ -137,6 +137,22 @@ func TestListService(t *testing.T) {
 
 }
 
+func TestListServiceWithListOptions(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddReactor("list", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			restrictions := a.(clienttesting.ListAction).GetListRestrictions()
+			assert.Equal(t, restrictions.Labels.String(), "serving.knative.dev/service=foo,tier!=cache")
+			assert.Equal(t, restrictions.Fields.String(), "metadata.name!=bar,metadata.name=baz")
+			return true, &servingv1.ServiceList{}, nil
+		})
+
+	ctx := util.WithListOptions(context.Background(), util.WithSelectors("tier!=cache", "metadata.name!=bar"))
+	_, err := client.ListServices(ctx, WithService("foo"), WithName("baz"))
+	assert.NilError(t, err)
+}
+
 func TestListServiceError(t *testing.T) {
 	serving, client := setup()
 
@@ -148,7 +148,7 @@ func (cl *knServingClient) DeleteDomainMapping(ctx context.Context, name string)
 
 // ListDomainMappings lists all DomainMappings
 func (cl *knServingClient) ListDomainMappings(ctx context.Context) (*servingv1beta1.DomainMappingList, error) {
-	domainMappingList, err := cl.client.DomainMappings(cl.namespace).List(ctx, v1.ListOptions{})
+	domainMappingList, err := cl.client.DomainMappings(cl.namespace).List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
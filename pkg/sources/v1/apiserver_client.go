@@ -117,7 +117,7 @@ func (c *apiServerSourcesClient) Namespace() string {
 
 // ListAPIServerSource returns the available ApiServer type sources
 func (c *apiServerSourcesClient) ListAPIServerSource(ctx context.Context) (*v1.ApiServerSourceList, error) {
-	sourceList, err := c.client.List(ctx, metav1.ListOptions{})
+	sourceList, err := c.client.List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
@@ -97,7 +97,7 @@ func (c *knBindingClient) GetSinkBinding(ctx context.Context, name string) (*v1.
 }
 
 func (c *knBindingClient) ListSinkBindings(ctx context.Context) (*v1.SinkBindingList, error) {
-	bindingList, err := c.client.List(ctx, apisv1.ListOptions{})
+	bindingList, err := c.client.List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
@@ -149,7 +149,7 @@ func (c *containerSourcesClient) Namespace() string {
 
 // ListContainerSource returns the available container sources
 func (c *containerSourcesClient) ListContainerSources(ctx context.Context) (*v1.ContainerSourceList, error) {
-	sourceList, err := c.client.List(ctx, metav1.ListOptions{})
+	sourceList, err := c.client.List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
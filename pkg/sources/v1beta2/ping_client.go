@@ -154,7 +154,7 @@ func (c *pingSourcesClient) GetPingSource(ctx context.Context, name string) (*so
 
 // ListPingSource returns the available Ping sources
 func (c *pingSourcesClient) ListPingSource(ctx context.Context) (*sourcesv1beta2.PingSourceList, error) {
-	sourceList, err := c.client.List(ctx, metav1.ListOptions{})
+	sourceList, err := c.client.List(ctx, util.ToListOptions(ctx))
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
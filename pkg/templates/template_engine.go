@@ -102,6 +102,7 @@ func (e templateEngine) templateFunctions() template.FuncMap {
 		"trim":              strings.TrimSpace,
 		"trimRight":         func(s string) string { return strings.TrimRightFunc(s, unicode.IsSpace) },
 		"trimLeft":          func(s string) string { return strings.TrimLeftFunc(s, unicode.IsSpace) },
+		"listAliases":       func(c *cobra.Command) string { return "" },
 	}
 }
 
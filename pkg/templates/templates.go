@@ -59,6 +59,12 @@ const (
 {{end}}
 `
 
+	// sectionUserAliases lists all user-defined command aliases (if any)
+	sectionUserAliases = `{{$aliases := listAliases .}}{{ if ne (len $aliases) 0}}Command Aliases:
+{{trimRight $aliases}}
+
+{{end}}`
+
 	// sectionFlags is the help template section that displays the command's flags.
 	sectionFlags = `{{$visibleFlags := visibleFlags .}}{{ if $visibleFlags.HasFlags}}Options:
 {{trimRight (flagsUsages $visibleFlags)}}
@@ -83,6 +89,7 @@ func usageTemplate() string {
 		sectionCommandGroups,
 		sectionSubCommands,
 		sectionPlugins,
+		sectionUserAliases,
 		sectionFlags,
 		sectionTipsHelp,
 		sectionTipsGlobalOptions,
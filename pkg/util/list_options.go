@@ -0,0 +1,78 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListOption configures the options of a request listing resources
+type ListOption func(options *metav1.ListOptions)
+
+// listOptionsKey is the key of the list options carried by a context
+type listOptionsKey struct{}
+
+// WithSelectors restricts a list to the resources matching the given label and field
+// selectors. Empty selectors are ignored. Note that the API server only supports a few
+// fields in field selectors, usually metadata.name and metadata.namespace.
+func WithSelectors(labelSelector string, fieldSelector string) ListOption {
+	return func(options *metav1.ListOptions) {
+		options.LabelSelector = JoinSelectors(options.LabelSelector, labelSelector)
+		options.FieldSelector = JoinSelectors(options.FieldSelector, fieldSelector)
+	}
+}
+
+// WithListOptions returns a context carrying the given list options in addition to the ones
+// the context already carries. The clients apply them to every list they request with this
+// context, so that lists can be restricted without changing the methods of the clients.
+func WithListOptions(ctx context.Context, opts ...ListOption) context.Context {
+	existing, _ := ctx.Value(listOptionsKey{}).([]ListOption)
+	combined := make([]ListOption, 0, len(existing)+len(opts))
+	combined = append(append(combined, existing...), opts...)
+	return context.WithValue(ctx, listOptionsKey{}, combined)
+}
+
+// ToListOptions returns the list options configured by the options the context carries
+func ToListOptions(ctx context.Context) metav1.ListOptions {
+	options := metav1.ListOptions{}
+	ApplyListOptions(ctx, &options)
+	return options
+}
+
+// ApplyListOptions applies the list options the context carries to the given options. A nil
+// context carries no options.
+func ApplyListOptions(ctx context.Context, options *metav1.ListOptions) {
+	if ctx == nil {
+		return
+	}
+	opts, _ := ctx.Value(listOptionsKey{}).([]ListOption)
+	for _, opt := range opts {
+		opt(options)
+	}
+}
+
+// JoinSelectors combines selectors, so that all of them must match. Empty selectors are skipped.
+func JoinSelectors(selectors ...string) string {
+	nonEmpty := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		if selector != "" {
+			nonEmpty = append(nonEmpty, selector)
+		}
+	}
+	return strings.Join(nonEmpty, ",")
+}
@@ -0,0 +1,44 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToListOptions(t *testing.T) {
+	ctx := context.Background()
+	assert.DeepEqual(t, ToListOptions(ctx), metav1.ListOptions{})
+	assert.DeepEqual(t, ToListOptions(WithListOptions(ctx, WithSelectors("", ""))), metav1.ListOptions{})
+
+	ctx = WithListOptions(ctx, WithSelectors("app=web", ""))
+	nested := WithListOptions(ctx, WithSelectors("tier!=cache", "metadata.name=web"))
+	assert.DeepEqual(t, ToListOptions(nested),
+		metav1.ListOptions{LabelSelector: "app=web,tier!=cache", FieldSelector: "metadata.name=web"})
+	assert.DeepEqual(t, ToListOptions(ctx), metav1.ListOptions{LabelSelector: "app=web"})
+
+	options := metav1.ListOptions{LabelSelector: "serving.knative.dev/service=foo"}
+	ApplyListOptions(nested, &options)
+	assert.Equal(t, options.LabelSelector, "serving.knative.dev/service=foo,app=web,tier!=cache")
+}
+
+func TestJoinSelectors(t *testing.T) {
+	assert.Equal(t, JoinSelectors(), "")
+	assert.Equal(t, JoinSelectors("", "a=b", "", "c!=d"), "a=b,c!=d")
+}
@@ -0,0 +1,45 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CopyObjectMeta returns the name, labels and annotations of the given metadata, without the
+// namespace and the fields populated by the server, for creating a copy of a resource. The
+// given annotations are left out. The maps are copied, so that the original is not modified.
+func CopyObjectMeta(m metav1.ObjectMeta, ignoredAnnotations ...string) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{
+		Name:        m.Name,
+		Labels:      copyStringMap(m.Labels),
+		Annotations: copyStringMap(m.Annotations),
+	}
+	for _, annotation := range ignoredAnnotations {
+		delete(meta.Annotations, annotation)
+	}
+	return meta
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(m))
+	for key, value := range m {
+		copied[key] = value
+	}
+	return copied
+}
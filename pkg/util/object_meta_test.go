@@ -0,0 +1,50 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCopyObjectMeta(t *testing.T) {
+	original := metav1.ObjectMeta{
+		Name:            "foo",
+		Namespace:       "prod",
+		UID:             "uid",
+		ResourceVersion: "42",
+		Generation:      3,
+		Labels:          map[string]string{"app": "foo"},
+		Annotations: map[string]string{
+			"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			"eventing.knative.dev/broker.class":                "MTChannelBasedBroker",
+		},
+	}
+	meta := CopyObjectMeta(original, "kubectl.kubernetes.io/last-applied-configuration")
+	assert.DeepEqual(t, meta, metav1.ObjectMeta{
+		Name:        "foo",
+		Labels:      map[string]string{"app": "foo"},
+		Annotations: map[string]string{"eventing.knative.dev/broker.class": "MTChannelBasedBroker"},
+	})
+
+	// The original metadata is not modified
+	meta.Labels["app"] = "bar"
+	assert.Equal(t, original.Labels["app"], "foo")
+	assert.Equal(t, len(original.Annotations), 2)
+
+	assert.DeepEqual(t, CopyObjectMeta(metav1.ObjectMeta{Name: "foo"}), metav1.ObjectMeta{Name: "foo"})
+}
@@ -0,0 +1,166 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"sync"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// ListFunc returns the current items of a list of resources
+type ListFunc func(ctx context.Context, opts v1.ListOptions) ([]runtime.Object, error)
+
+type pollingListWatcher struct {
+	list   ListFunc
+	opts   v1.ListOptions
+	done   chan struct{}
+	result chan watch.Event
+	wg     *sync.WaitGroup
+	// we can mock the interface for testing.
+	pollInterval PollInterval
+}
+
+// NewListWatcher makes a watch.Interface on all resources matching the given list options,
+// falling back to polling the list if the server does not support Watch. Only changes after
+// opts.ResourceVersion or, if it is empty, after the watch has been created are reported.
+func NewListWatcher(ctx context.Context, watchFunc watchF, listFunc ListFunc, opts v1.ListOptions) (watch.Interface, error) {
+	nativeOpts := opts
+	nativeOpts.Watch = true
+	native, err := watchFunc(ctx, nativeOpts)
+	if err == nil {
+		return native, nil
+	}
+	polling := &pollingListWatcher{
+		listFunc, opts, make(chan struct{}), make(chan watch.Event), &sync.WaitGroup{},
+		newTickerPollInterval(pollInterval)}
+	polling.start(ctx)
+	return polling, nil
+}
+
+func (w *pollingListWatcher) start(ctx context.Context) {
+	// Changes are reported against the list the watch starts from, the first poll
+	// establishes it only if the resources can't be listed now
+	known := w.initialItems(ctx)
+	pollOpts := w.opts
+	pollOpts.ResourceVersion = ""
+	pollOpts.ResourceVersionMatch = ""
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer w.pollInterval.Stop()
+		for {
+			select {
+			case <-w.pollInterval.PollChan():
+				items, err := w.list(ctx, pollOpts)
+				if err != nil {
+					// Try again with the next poll
+					continue
+				}
+				current := itemsByUID(items)
+				var events []watch.Event
+				if known != nil {
+					events = changeEvents(known, current, items)
+				}
+				known = current
+				for _, event := range events {
+					select {
+					case w.result <- event:
+					case <-w.done:
+						return
+					case <-ctx.Done():
+						return
+					}
+				}
+			case <-w.done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// initialItems lists the resources at the resource version of the list options, or the
+// current resources if the version is not given or is not available anymore. It returns
+// nil if the resources can't be listed.
+func (w *pollingListWatcher) initialItems(ctx context.Context) map[types.UID]runtime.Object {
+	if w.opts.ResourceVersion != "" {
+		opts := w.opts
+		opts.ResourceVersionMatch = v1.ResourceVersionMatchExact
+		if items, err := w.list(ctx, opts); err == nil {
+			return itemsByUID(items)
+		}
+	}
+	opts := w.opts
+	opts.ResourceVersion = ""
+	opts.ResourceVersionMatch = ""
+	items, err := w.list(ctx, opts)
+	if err != nil {
+		return nil
+	}
+	return itemsByUID(items)
+}
+
+// itemsByUID returns the listed resources by their UID
+func itemsByUID(items []runtime.Object) map[types.UID]runtime.Object {
+	byUID := make(map[types.UID]runtime.Object, len(items))
+	for _, item := range items {
+		if obj, ok := item.(v1.Object); ok {
+			byUID[obj.GetUID()] = item
+		}
+	}
+	return byUID
+}
+
+// changeEvents returns the events for the resources which have been added or modified in
+// the current list, in its order, followed by the ones which have been deleted from it
+func changeEvents(known map[types.UID]runtime.Object, current map[types.UID]runtime.Object, items []runtime.Object) []watch.Event {
+	var events []watch.Event
+	for _, item := range items {
+		obj, ok := item.(v1.Object)
+		if !ok {
+			continue
+		}
+		old, exists := known[obj.GetUID()]
+		switch {
+		case !exists:
+			events = append(events, watch.Event{Type: watch.Added, Object: item})
+		case old.(v1.Object).GetResourceVersion() != obj.GetResourceVersion():
+			events = append(events, watch.Event{Type: watch.Modified, Object: item})
+		}
+	}
+	for uid, old := range known {
+		if _, exists := current[uid]; !exists {
+			events = append(events, watch.Event{Type: watch.Deleted, Object: old})
+		}
+	}
+	return events
+}
+
+func (w *pollingListWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *pollingListWatcher) Stop() {
+	close(w.done)
+	w.wg.Wait()
+	close(w.result)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func newListItem(uid string, resourceVersion string) runtime.Object {
+	return &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc-" + uid, UID: types.UID(uid), ResourceVersion: resourceVersion}}
+}
+
+func TestListWatcherNative(t *testing.T) {
+	fake := watch.NewFake()
+	var gotOpts metav1.ListOptions
+	watchFunc := func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+		gotOpts = opts
+		return fake, nil
+	}
+	w, err := NewListWatcher(context.Background(), watchFunc, nil, metav1.ListOptions{LabelSelector: "a=b", ResourceVersion: "42"})
+	assert.NilError(t, err)
+	assert.Equal(t, w, watch.Interface(fake))
+	assert.Equal(t, gotOpts.LabelSelector, "a=b")
+	assert.Equal(t, gotOpts.ResourceVersion, "42")
+	assert.Assert(t, gotOpts.Watch)
+}
+
+func TestListWatcherPolling(t *testing.T) {
+	polls := [][]runtime.Object{
+		{newListItem("1", "1"), newListItem("2", "1")},
+		{newListItem("1", "1"), newListItem("2", "2"), newListItem("3", "1")},
+		nil,
+		{newListItem("2", "2"), newListItem("3", "1")},
+	}
+	i := 0
+	listFunc := func(ctx context.Context, opts metav1.ListOptions) ([]runtime.Object, error) {
+		defer func() { i++ }()
+		if polls[i] == nil {
+			return nil, errors.New("temporary failure")
+		}
+		return polls[i], nil
+	}
+	// The first list is the initial one when the watch starts
+	w := &pollingListWatcher{listFunc, metav1.ListOptions{}, make(chan struct{}), make(chan watch.Event), &sync.WaitGroup{}, newFakePollInterval(len(polls) - 1)}
+	w.start(context.Background())
+
+	type change struct {
+		EventType watch.EventType
+		UID       types.UID
+	}
+	var changes []change
+	for len(changes) < 3 {
+		event := <-w.ResultChan()
+		changes = append(changes, change{event.Type, event.Object.(metav1.Object).GetUID()})
+	}
+	w.Stop()
+	assert.DeepEqual(t, changes, []change{
+		{watch.Modified, "2"},
+		{watch.Added, "3"},
+		{watch.Deleted, "1"},
+	})
+}
+
+func TestListWatcherPollingFromResourceVersion(t *testing.T) {
+	var gotOpts []metav1.ListOptions
+	listFunc := func(ctx context.Context, opts metav1.ListOptions) ([]runtime.Object, error) {
+		gotOpts = append(gotOpts, opts)
+		if opts.ResourceVersion != "" {
+			return []runtime.Object{newListItem("1", "1")}, nil
+		}
+		return []runtime.Object{newListItem("1", "2")}, nil
+	}
+	opts := metav1.ListOptions{LabelSelector: "a=b", ResourceVersion: "42"}
+	w := &pollingListWatcher{listFunc, opts, make(chan struct{}), make(chan watch.Event), &sync.WaitGroup{}, newFakePollInterval(1)}
+	w.start(context.Background())
+
+	// A change between the initial list and the first poll is reported
+	event := <-w.ResultChan()
+	w.Stop()
+	assert.Equal(t, event.Type, watch.Modified)
+	assert.Equal(t, event.Object.(metav1.Object).GetResourceVersion(), "2")
+	assert.DeepEqual(t, gotOpts, []metav1.ListOptions{
+		{LabelSelector: "a=b", ResourceVersion: "42", ResourceVersionMatch: metav1.ResourceVersionMatchExact},
+		{LabelSelector: "a=b"},
+	})
+}
+
+func TestListWatcherPollingContextDone(t *testing.T) {
+	listFunc := func(ctx context.Context, opts metav1.ListOptions) ([]runtime.Object, error) {
+		return nil, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &pollingListWatcher{listFunc, metav1.ListOptions{}, make(chan struct{}), make(chan watch.Event), &sync.WaitGroup{}, newFakePollInterval(0)}
+	w.start(ctx)
+	cancel()
+	// Polling stops without stopping the watch
+	w.wg.Wait()
+	w.Stop()
+}
@@ -0,0 +1,100 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"knative.dev/pkg/apis"
+)
+
+// Phases used in structured progress records
+const (
+	PhaseWaiting = "waiting"
+	PhaseDone    = "done"
+	PhaseFailed  = "failed"
+)
+
+// ProgressEvent is a single, machine-readable progress record which is
+// emitted for every message received while waiting
+type ProgressEvent struct {
+	Resource  string  `json:"resource"`
+	Name      string  `json:"name"`
+	Phase     string  `json:"phase"`
+	Condition string  `json:"condition,omitempty"`
+	Reason    string  `json:"reason,omitempty"`
+	Message   string  `json:"message,omitempty"`
+	Elapsed   float64 `json:"elapsed"`
+}
+
+// ProgressSummary is the final machine-readable record of an operation
+type ProgressSummary struct {
+	Resource  string  `json:"resource"`
+	Name      string  `json:"name"`
+	Namespace string  `json:"namespace,omitempty"`
+	Operation string  `json:"operation"`
+	Phase     string  `json:"phase"`
+	Error     string  `json:"error,omitempty"`
+	Elapsed   float64 `json:"elapsed"`
+}
+
+// JSONMessageCallback returns a callback which writes every event message as a single
+// JSON line (see ProgressEvent) to the given writer
+func JSONMessageCallback(out io.Writer, resource string, name string) MessageCallback {
+	return func(duration time.Duration, message string) {
+		WriteProgressJSON(out, ProgressEvent{
+			Resource:  resource,
+			Name:      name,
+			Phase:     PhaseWaiting,
+			Condition: string(apis.ConditionReady),
+			Message:   message,
+			Elapsed:   seconds(duration),
+		})
+	}
+}
+
+// JSONConditionCallback returns a callback which writes every event message as a single
+// JSON line (see ProgressEvent) to the given writer, together with the type and the
+// reason of the condition carrying it
+func JSONConditionCallback(out io.Writer, resource string, name string) ConditionCallback {
+	return func(duration time.Duration, condition apis.Condition) {
+		WriteProgressJSON(out, ProgressEvent{
+			Resource:  resource,
+			Name:      name,
+			Phase:     PhaseWaiting,
+			Condition: string(condition.Type),
+			Reason:    condition.Reason,
+			Message:   condition.Message,
+			Elapsed:   seconds(duration),
+		})
+	}
+}
+
+// WriteProgressJSON writes the given progress record as a single JSON line
+func WriteProgressJSON(out io.Writer, record interface{}) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		// Records are plain structs, so this can't happen
+		return
+	}
+	out.Write(append(data, '\n'))
+}
+
+// seconds converts a duration to fractional seconds with millisecond precision
+func seconds(duration time.Duration) float64 {
+	return float64(duration.Round(time.Millisecond)) / float64(time.Second)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2024 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/apis"
+)
+
+func TestJSONMessageCallback(t *testing.T) {
+	buf := &bytes.Buffer{}
+	callback := JSONMessageCallback(buf, "service", "foo")
+	callback(1500*time.Millisecond, "Configuration \"foo\" is waiting for a Revision to become ready.")
+	callback(2*time.Second, "Ingress has not yet been reconciled.")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 2)
+
+	var event ProgressEvent
+	assert.NilError(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.DeepEqual(t, event, ProgressEvent{
+		Resource:  "service",
+		Name:      "foo",
+		Phase:     PhaseWaiting,
+		Condition: "Ready",
+		Message:   "Configuration \"foo\" is waiting for a Revision to become ready.",
+		Elapsed:   1.5,
+	})
+	assert.NilError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.Equal(t, event.Message, "Ingress has not yet been reconciled.")
+	assert.Equal(t, event.Elapsed, 2.0)
+}
+
+func TestJSONConditionCallback(t *testing.T) {
+	buf := &bytes.Buffer{}
+	callback := JSONConditionCallback(buf, "service", "foo")
+	callback(1500*time.Millisecond, apis.Condition{
+		Type:    apis.ConditionReady,
+		Reason:  "RevisionMissing",
+		Message: "Configuration \"foo\" is waiting for a Revision to become ready.",
+	})
+
+	var event ProgressEvent
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &event))
+	assert.DeepEqual(t, event, ProgressEvent{
+		Resource:  "service",
+		Name:      "foo",
+		Phase:     PhaseWaiting,
+		Condition: "Ready",
+		Reason:    "RevisionMissing",
+		Message:   "Configuration \"foo\" is waiting for a Revision to become ready.",
+		Elapsed:   1.5,
+	})
+}
+
+func TestWriteProgressJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	WriteProgressJSON(buf, ProgressSummary{Resource: "broker", Name: "default", Operation: "delete", Phase: PhaseFailed, Error: "boom", Elapsed: 0.25})
+	assert.Equal(t, buf.String(), `{"resource":"broker","name":"default","operation":"delete","phase":"failed","error":"boom","elapsed":0.25}`+"\n")
+}
@@ -16,6 +16,7 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -40,6 +41,9 @@ type waitForEvent struct {
 	kind       string
 }
 
+// ErrTimeout is wrapped by the error returned when a wait did not finish within its timeout
+var ErrTimeout = errors.New("timeout")
+
 // EventDone is a marker to stop actual waiting on given event state
 type EventDone func(ev *watch.Event) bool
 
@@ -60,6 +64,10 @@ type Options struct {
 
 	// Timeout for how long to wait at maximum
 	Timeout *time.Duration
+
+	// ConditionCallback is called instead of the message callback for event messages
+	// if set, and receives the Ready condition carrying the message
+	ConditionCallback ConditionCallback
 }
 
 // Create watch which is used when waiting for Ready condition
@@ -71,6 +79,9 @@ type ConditionsExtractor func(obj runtime.Object) (apis.Conditions, error)
 // Callback for event messages
 type MessageCallback func(durationSinceState time.Duration, message string)
 
+// Callback for event messages, which receives the Ready condition carrying the message
+type ConditionCallback func(durationSinceState time.Duration, condition apis.Condition)
+
 // NewWaitForReady waits until the condition is set to Ready == True
 func NewWaitForReady(kind string, watchMaker WatchMaker, extractor ConditionsExtractor) Wait {
 	return &waitForReadyConfig{
@@ -125,7 +136,7 @@ func (w *waitForReadyConfig) Wait(ctx context.Context, name string, initialVersi
 			return err, time.Since(start)
 		}
 		if timeoutReached {
-			return fmt.Errorf("timeout: %s '%s' not ready after %d seconds", w.kind, name, int(timeout/time.Second)), time.Since(start)
+			return fmt.Errorf("%w: %s '%s' not ready after %d seconds", ErrTimeout, w.kind, name, int(timeout/time.Second)), time.Since(start)
 		}
 
 		if retry {
@@ -237,7 +248,11 @@ func (w *waitForReadyConfig) waitForReadyCondition(ctx context.Context, name str
 						}
 					}
 					if cond.Message != "" {
-						msgCallback(time.Since(start), cond.Message)
+						if options.ConditionCallback != nil {
+							options.ConditionCallback(time.Since(start), cond)
+						} else {
+							msgCallback(time.Since(start), cond.Message)
+						}
 					}
 				}
 			}
@@ -263,7 +278,7 @@ func (w *waitForEvent) Wait(ctx context.Context, name string, initialVersion str
 		case <-ctx.Done():
 			return ctx.Err(), time.Since(start)
 		case <-timer.C:
-			return fmt.Errorf("timeout: %s '%s' not ready after %d seconds", w.kind, name, int(timeout/time.Second)), time.Since(start)
+			return fmt.Errorf("%w: %s '%s' not ready after %d seconds", ErrTimeout, w.kind, name, int(timeout/time.Second)), time.Since(start)
 		case event := <-watcher.ResultChan():
 			if w.eventDone(&event) {
 				return nil, time.Since(start)
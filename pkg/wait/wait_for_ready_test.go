@@ -126,6 +126,33 @@ func TestAddWaitForReady(t *testing.T) {
 	}
 }
 
+func TestAddWaitForReadyWithConditionCallback(t *testing.T) {
+	for _, tc := range prepareTestCases(t, "test-service") {
+		tc := tc
+		t.Run(tc.testcase, func(t *testing.T) {
+			fakeWatchApi := NewFakeWatch(tc.events)
+			waitForReady := NewWaitForReady(
+				"blub",
+				func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+					return fakeWatchApi, nil
+				},
+				conditionsFor)
+			fakeWatchApi.Start()
+			msgs := make([]string, 0)
+			options := Options{Timeout: &tc.timeout, ConditionCallback: func(_ time.Duration, cond apis.Condition) {
+				assert.Equal(t, cond.Type, apis.ConditionReady)
+				msgs = append(msgs, cond.Message)
+			}}
+			waitForReady.Wait(context.Background(), "foobar", "", options, func(_ time.Duration, msg string) {
+				t.Errorf("Message callback called with %s although a condition callback is given", msg)
+			})
+			close(fakeWatchApi.eventChan)
+
+			assert.Assert(t, cmp.DeepEqual(tc.messagesExpected, msgs), "Messages expected to be equal")
+		})
+	}
+}
+
 func TestAddWaitForReadyWithChannelClose(t *testing.T) {
 	for _, tc := range prepareTestCases(t, "test-service") {
 		tc := tc